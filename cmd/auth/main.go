@@ -1,20 +1,35 @@
 package main
 
 import (
+	"backend/internal/errcode"
+	"backend/internal/microservice/auth/oauth"
 	protoAuth "backend/internal/microservice/auth/proto"
+	identityRepo "backend/internal/microservice/auth/repository/identity"
+	loginLimitRepo "backend/internal/microservice/auth/repository/loginlimit"
+	oauthStateRepo "backend/internal/microservice/auth/repository/oauthstate"
+	preAuthRepo "backend/internal/microservice/auth/repository/preauth"
+	resetRepo "backend/internal/microservice/auth/repository/reset"
 	sessionRepo "backend/internal/microservice/auth/repository/session"
+	twoFactorRepo "backend/internal/microservice/auth/repository/twofactor"
 	userRepo "backend/internal/microservice/auth/repository/user"
 	"backend/internal/microservice/auth/usecase"
+	"backend/internal/migrate"
 	"backend/internal/utils"
+	"backend/pkg/healthcheck"
 	log "backend/pkg/logger"
+	"context"
 	"github.com/sirupsen/logrus"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 )
 
 const logMessage = "cmd:auth:"
@@ -45,6 +60,12 @@ func main() {
 	postDB, err := utils.InitPostgresDB()
 	if err != nil {
 		log.Error(logMessage+"err = ", err)
+		os.Exit(1)
+	}
+	err = migrate.CheckVersion(postDB)
+	if err != nil {
+		log.Error(logMessage+"err = ", err)
+		os.Exit(1)
 	}
 	redisDB, err := utils.InitRedisDB()
 	if err != nil {
@@ -55,18 +76,53 @@ func main() {
 		log.Error(logMessage+"err = ", err)
 	}
 
-	server := grpc.NewServer()
+	server := grpc.NewServer(grpc.UnaryInterceptor(errcode.UnaryServerInterceptor))
 
 	authUserRepository := userRepo.NewRepository(postDB)
 	authSessionRepository := sessionRepo.NewRepository(redisDB)
+	authResetRepository := resetRepo.NewRepository(redisDB)
+	authTwoFactorRepository := twoFactorRepo.NewRepository(postDB)
+	authPreAuthRepository := preAuthRepo.NewRepository(redisDB)
+	authIdentityRepository := identityRepo.NewRepository(postDB)
+	authOAuthStateRepository := oauthStateRepo.NewRepository(redisDB)
+	authLoginLimitRepository := loginLimitRepo.NewRepository(redisDB)
 
-	authService := usecase.NewService(authUserRepository, authSessionRepository)
-	protoAuth.RegisterAuthServer(server, authService)
-
-	log.Info("started auth microservice on ", port)
-	err = server.Serve(authListener)
+	oauthProviders, err := oauth.LoadProviders()
 	if err != nil {
 		log.Error(logMessage+"err = ", err)
+		os.Exit(1)
+	}
+	for name := range oauthProviders {
+		log.Info(logMessage+"oauth provider enabled: ", name)
 	}
 
+	authService := usecase.NewService(authUserRepository, authSessionRepository, authResetRepository,
+		authTwoFactorRepository, authPreAuthRepository, authIdentityRepository, authOAuthStateRepository, oauthProviders,
+		authLoginLimitRepository)
+	protoAuth.RegisterAuthServer(server, authService)
+
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	checker := healthcheck.NewChecker(viper.GetDuration("health.timeout"))
+	checker.Add("postgres", healthcheck.Postgres(postDB))
+	checker.Add("redis", healthcheck.Redis(redisDB))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go healthcheck.Watch(ctx, healthServer, checker, viper.GetDuration("health.interval"))
+
+	go func() {
+		log.Info("started auth microservice on ", port)
+		err := server.Serve(authListener)
+		if err != nil {
+			log.Error(logMessage+"err = ", err)
+		}
+		stop()
+	}()
+	<-ctx.Done()
+
+	log.Info(logMessage + "shutting down")
+	healthServer.Shutdown()
+	healthcheck.StopGRPC(server, viper.GetDuration("shutdown.timeout"))
+	_ = postDB.Close()
+	_ = redisDB.Close()
 }
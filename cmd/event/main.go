@@ -1,18 +1,27 @@
 package main
 
 import (
+	"backend/internal/errcode"
 	"backend/internal/microservice/event/client"
 	proto "backend/internal/microservice/event/proto"
+	"backend/internal/migrate"
 	repository "backend/internal/service/event/repository/postgres"
+	"backend/internal/service/event/usecase"
 	"backend/internal/utils"
+	"backend/pkg/healthcheck"
 	log "backend/pkg/logger"
+	"context"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 )
 
 func env() {
@@ -43,6 +52,11 @@ func main() {
 		log.Error(logMessage+"err =", err)
 		os.Exit(1)
 	}
+	err = migrate.CheckVersion(db)
+	if err != nil {
+		log.Error(logMessage+"err =", err)
+		os.Exit(1)
+	}
 
 	port := viper.GetString("event_port")
 
@@ -52,17 +66,33 @@ func main() {
 		os.Exit(1)
 	}
 
-	server := grpc.NewServer()
+	server := grpc.NewServer(grpc.UnaryInterceptor(errcode.UnaryServerInterceptor))
 
 	eventRepository := repository.NewRepository(db)
 	eventService := client.NewEventService(eventRepository)
 	proto.RegisterEventServiceServer(server, eventService)
 
-	log.Info(logMessage+"started on port = ", port)
-	err = server.Serve(listener)
-	if err != nil {
-		log.Error(logMessage+"err =", err)
-		os.Exit(1)
-	}
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	checker := healthcheck.NewChecker(viper.GetDuration("health.timeout"))
+	checker.Add("postgres", healthcheck.Postgres(db))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go healthcheck.Watch(ctx, healthServer, checker, viper.GetDuration("health.interval"))
+	go usecase.WatchSeries(ctx, eventRepository, viper.GetDuration("series.extend_interval"))
+
+	go func() {
+		log.Info(logMessage+"started on port = ", port)
+		err := server.Serve(listener)
+		if err != nil {
+			log.Error(logMessage+"err =", err)
+		}
+		stop()
+	}()
+	<-ctx.Done()
 
+	log.Info(logMessage + "shutting down")
+	healthServer.Shutdown()
+	healthcheck.StopGRPC(server, viper.GetDuration("shutdown.timeout"))
+	_ = db.Close()
 }
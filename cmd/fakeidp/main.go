@@ -0,0 +1,37 @@
+package main
+
+import (
+	"backend/internal/microservice/auth/oauth/fakeidp"
+	log "backend/pkg/logger"
+	"flag"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logMessage = "cmd:fakeidp:"
+
+// Identity provider for trying the social login locally, it logs in the user from the flags without asking
+func main() {
+	addr := flag.String("addr", "localhost:8090", "address to listen on")
+	subject := flag.String("sub", "1", "user id at the provider")
+	mail := flag.String("email", "user@mail.ru", "mail of the user")
+	verified := flag.Bool("email-verified", true, "whether the provider has confirmed the mail")
+	name := flag.String("name", "Иван", "name of the user")
+	surname := flag.String("surname", "Иванов", "surname of the user")
+	flag.Parse()
+
+	log.Init(logrus.DebugLevel)
+	server := fakeidp.New("http://"+*addr, fakeidp.User{
+		Subject:       *subject,
+		Email:         *mail,
+		EmailVerified: *verified,
+		Name:          *name,
+		Surname:       *surname,
+	})
+	log.Info(logMessage+"started on ", *addr)
+	err := http.ListenAndServe(*addr, server)
+	if err != nil {
+		log.Error(logMessage+"err = ", err)
+	}
+}
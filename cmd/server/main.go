@@ -28,6 +28,14 @@ func main() {
 		log.Error(logMessage+"err = ", err)
 		os.Exit(1)
 	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = runMigrate(os.Args[2:])
+		if err != nil {
+			log.Error(logMessage+"err = ", err)
+			os.Exit(1)
+		}
+		return
+	}
 	opts := &app.Options{
 		LogLevel: log.DebugLevel,
 		Testing:  false,
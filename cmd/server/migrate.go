@@ -0,0 +1,70 @@
+package main
+
+import (
+	"backend/internal/migrate"
+	"backend/internal/utils"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const migrateUsage = "usage: server migrate up | down | status | to <version> | force <version>"
+
+var errMigrateUsage = errors.New(migrateUsage)
+
+// runMigrate is the migrate subcommand, the replicas may run it together on start
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+	db, err := utils.InitPostgresDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	m, err := migrate.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		err = m.Up()
+	case "down":
+		err = m.Down()
+	case "status":
+		var statuses []migrate.Status
+		statuses, err = m.Status()
+		for _, status := range statuses {
+			mark := " "
+			if status.Applied {
+				mark = "x"
+			}
+			fmt.Printf("[%s] %06d %s\n", mark, status.Version, status.Name)
+		}
+	case "to", "force":
+		if len(args) != 2 {
+			return errMigrateUsage
+		}
+		version, atoiErr := strconv.Atoi(args[1])
+		if atoiErr != nil {
+			return errMigrateUsage
+		}
+		if args[0] == "to" {
+			err = m.To(version)
+		} else {
+			err = m.Force(version)
+		}
+	default:
+		return errMigrateUsage
+	}
+	if err != nil {
+		return err
+	}
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("schema version %d, latest %d\n", version, m.Latest())
+	return nil
+}
@@ -1,14 +1,21 @@
 package app
 
 import (
+	"backend/internal/errcode"
 	protoAuth "backend/internal/microservice/auth/proto"
 	eventRepository "backend/internal/microservice/event/proto"
 	userRepository "backend/internal/microservice/user/proto"
 	"backend/internal/middleware"
+	"backend/internal/migrate"
 	"backend/internal/register"
+	"backend/internal/response"
 	authDelivery "backend/internal/service/auth/delivery/http"
 	authUseCase "backend/internal/service/auth/usecase"
+	"backend/internal/service/event"
 	eventDelivery "backend/internal/service/event/delivery/http"
+	geocoderCache "backend/internal/service/event/geocoder/cache"
+	geocoderDadata "backend/internal/service/event/geocoder/dadata"
+	geocoderStub "backend/internal/service/event/geocoder/stub"
 	eventGrpc "backend/internal/service/event/repository/grpc"
 	eventUseCase "backend/internal/service/event/usecase"
 	"backend/internal/service/notification/delivery/websocket"
@@ -17,14 +24,20 @@ import (
 	userGrpc "backend/internal/service/user/repository/grpc"
 	userUseCase "backend/internal/service/user/usecase"
 	"backend/internal/utils"
+	"backend/pkg/healthcheck"
 	log "backend/pkg/logger"
 	"backend/pkg/notificator"
 	"backend/pkg/prometheus"
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"github.com/go-redis/redis"
 	"github.com/gorilla/mux"
 	sql "github.com/jmoiron/sqlx"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -48,6 +61,12 @@ type App struct {
 	wsPool              *websocket.Pool
 	notificationManager notificator.NotificationManager
 	db                  *sql.DB
+	grpcConns           []*grpc.ClientConn
+	checker             *healthcheck.Checker
+	// Only with the redis cache of the geocoder
+	redisDB *redis.Client
+	// Set on shutdown, so that /readyz takes the instance out of the balancer
+	shuttingDown int32
 }
 
 func getGrpcAddress(portKey string, hostKey string) string {
@@ -56,10 +75,46 @@ func getGrpcAddress(portKey string, hostKey string) string {
 	return host + ":" + port
 }
 
+// newGeocoder also gives the Redis client of the cache, it is nil unless geocoder.cache is redis
+func newGeocoder() (event.Geocoder, *redis.Client, error) {
+	var geocoder event.Geocoder
+	switch viper.GetString("geocoder.provider") {
+	case "stub":
+		stubGeocoder, err := geocoderStub.NewGeocoderFromFile(viper.GetString("geocoder.stub_file"))
+		if err != nil {
+			return nil, nil, err
+		}
+		geocoder = stubGeocoder
+	default:
+		geocoder = geocoderDadata.NewGeocoder(os.Getenv("MAPS_TOKEN"), viper.GetDuration("geocoder.timeout"))
+	}
+
+	ttl := viper.GetDuration("geocoder.cache_ttl")
+	precision := viper.GetInt("geocoder.cache_precision")
+	var redisDB *redis.Client
+	switch viper.GetString("geocoder.cache") {
+	case "redis":
+		var err error
+		redisDB, err = utils.InitRedisDB()
+		if err != nil {
+			return nil, nil, err
+		}
+		storage := geocoderCache.NewRedisStorage(redisDB, ttl)
+		geocoder = geocoderCache.NewGeocoder(geocoder, storage, precision)
+	case "memory":
+		storage := geocoderCache.NewMemoryStorage(ttl, viper.GetInt("geocoder.cache_size"))
+		geocoder = geocoderCache.NewGeocoder(geocoder, storage, precision)
+	}
+	return geocoder, redisDB, nil
+}
+
 func NewApp(opts *Options) (*App, error) {
 	message := logMessage + "NewApp:"
 	log.Init(opts.LogLevel)
 	log.Info(fmt.Sprintf(message+"started, log level = %s", opts.LogLevel))
+	if len(viper.GetStringSlice("trusted_proxies")) == 0 {
+		log.Error(message + "trusted_proxies is empty, behind a proxy every client gets its address and they share one IP login limit")
+	}
 
 	db, err := utils.InitPostgresDB()
 	if err != nil {
@@ -68,22 +123,32 @@ func NewApp(opts *Options) (*App, error) {
 			return nil, err
 		}
 	}
+	if !opts.Testing {
+		err = migrate.CheckVersion(db)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return nil, err
+		}
+	}
 
-	grpcConnAuth, err := grpc.Dial(getGrpcAddress("auth_port", "auth_host"), grpc.WithInsecure())
+	grpcConnAuth, err := grpc.Dial(getGrpcAddress("auth_port", "auth_host"), grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errcode.UnaryClientInterceptor))
 	if err != nil {
 		log.Error(message+"err = ", err)
 		if !opts.Testing {
 			return nil, err
 		}
 	}
-	userGrpcConn, err := grpc.Dial(getGrpcAddress("user_port", "user_host"), grpc.WithInsecure())
+	userGrpcConn, err := grpc.Dial(getGrpcAddress("user_port", "user_host"), grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errcode.UnaryClientInterceptor))
 	if err != nil {
 		log.Error(message+"err = ", err)
 		if !opts.Testing {
 			return nil, err
 		}
 	}
-	eventGrpcConn, err := grpc.Dial(getGrpcAddress("event_port", "event_host"), grpc.WithInsecure())
+	eventGrpcConn, err := grpc.Dial(getGrpcAddress("event_port", "event_host"), grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errcode.UnaryClientInterceptor))
 	if err != nil {
 		log.Error(message+"err = ", err)
 		if !opts.Testing {
@@ -91,6 +156,25 @@ func NewApp(opts *Options) (*App, error) {
 		}
 	}
 
+	geocoder, redisDB, err := newGeocoder()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		if !opts.Testing {
+			return nil, err
+		}
+	}
+
+	checker := healthcheck.NewChecker(viper.GetDuration("health.timeout"))
+	if db != nil {
+		checker.Add("postgres", healthcheck.Postgres(db))
+	}
+	checker.Add("auth", healthcheck.GRPC(grpcConnAuth))
+	checker.Add("user", healthcheck.GRPC(userGrpcConn))
+	checker.Add("event", healthcheck.GRPC(eventGrpcConn))
+	if redisDB != nil {
+		checker.Add("redis", healthcheck.Redis(redisDB))
+	}
+
 	authClient := protoAuth.NewAuthClient(grpcConnAuth)
 	userRClient := userRepository.NewUserServiceClient(userGrpcConn)
 	eventRClient := eventRepository.NewEventServiceClient(eventGrpcConn)
@@ -101,13 +185,13 @@ func NewApp(opts *Options) (*App, error) {
 
 	authService := authUseCase.NewUseCase(authClient)
 	userUC := userUseCase.NewUseCase(userR)
-	eventUC := eventUseCase.NewUseCase(eventR)
+	eventUC := eventUseCase.NewUseCase(eventR, geocoder)
 
 	pool := websocket.NewPool()
 	notificationManager := notificator.NewNotificator(pool, notificationR, userR, eventR)
 
 	authD := authDelivery.NewDelivery(authService)
-	userD := userDelivery.NewDelivery(userUC, notificationManager)
+	userD := userDelivery.NewDelivery(userUC, authService, notificationManager)
 	eventD := eventDelivery.NewDelivery(eventUC, notificationManager)
 
 	return &App{
@@ -118,6 +202,9 @@ func NewApp(opts *Options) (*App, error) {
 		wsPool:              pool,
 		notificationManager: notificationManager,
 		db:                  db,
+		redisDB:             redisDB,
+		grpcConns:           []*grpc.ClientConn{grpcConnAuth, userGrpcConn, eventGrpcConn},
+		checker:             checker,
 	}, nil
 }
 
@@ -126,35 +213,71 @@ func newRouterWithEndpoints(app *App) *mux.Router {
 	mm := prometheus.NewMetricsMiddleware()
 
 	r := mux.NewRouter()
-	rApi := r.PathPrefix("/api").Subrouter()
-	rApi.Use(mw.GetVars)
-	rApi.Use(mw.Logging)
-	rApi.Use(mw.CORS)
-	rApi.Use(mw.Recovery)
+	r.Use(mw.APIVersion)
 	r.Use(mm.Metrics)
-	rApi.Methods("OPTIONS").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
-	authRouter := rApi.PathPrefix("/auth").Subrouter()
-	register.AuthHTTPEndpoints(authRouter, app.AuthManager, mw)
-	eventRouter := rApi.PathPrefix("/events").Subrouter()
-	eventRouter.Methods("POST").Subrouter().Use(mw.CSRF)
-	register.EventHTTPEndpoints(eventRouter, app.EventManager, mw)
-	userRouter := rApi.PathPrefix("/user").Subrouter()
-	userRouter.Methods("POST").Subrouter().Use(mw.CSRF)
-	register.UserHTTPEndpoints(userRouter, app.UserManager, app.EventManager, mw)
+	// /api/v2 goes first, otherwise /api catches it
+	rApiV2 := r.PathPrefix("/api/v2").Subrouter()
+	rApi := r.PathPrefix("/api").Subrouter()
+	for _, router := range []*mux.Router{rApiV2, rApi} {
+		router.Use(mw.GetVars)
+		router.Use(mw.Logging)
+		router.Use(mw.CORS)
+		router.Use(mw.Recovery)
+		router.Methods("OPTIONS").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+		register.APIEndpoints(router, app.AuthManager, app.UserManager, app.EventManager, mw)
+	}
+	rApi.HandleFunc("/openapi.json", register.OpenAPI).Methods("GET")
 
 	websocketHandlerFunc := mw.Auth(http.HandlerFunc(app.wsPool.WebsocketHandler))
 	r.Handle("/ws", websocketHandlerFunc).Methods("GET")
 
 	r.Handle("/metrics", promhttp.Handler())
+	r.HandleFunc("/healthz", app.Healthz).Methods("GET")
+	r.HandleFunc("/readyz", app.Readyz).Methods("GET")
 
 	return r
 }
 
-//Reborn
-func (app *App) Run() error {
+// Healthz only tells that the process is alive
+func (app *App) Healthz(w http.ResponseWriter, r *http.Request) {
+	response.SendResponse(w, response.OkResponse())
+}
+
+// Readyz checks Postgres and the health services of the microservices
+func (app *App) Readyz(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "Readyz:"
+	if atomic.LoadInt32(&app.shuttingDown) == 1 {
+		response.SendResponse(w, response.ErrorResponse(http.StatusServiceUnavailable, errcode.CodeUnavailable))
+		return
+	}
+	failed := app.checker.Run(r.Context())
+	if len(failed) != 0 {
+		for name, err := range failed {
+			log.Error(message+name+" err = ", err)
+		}
+		response.SendResponse(w, response.HealthResponse(failed))
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+}
+
+func (app *App) close() {
+	for _, conn := range app.grpcConns {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}
 	if app.db != nil {
-		defer app.db.Close()
+		_ = app.db.Close()
+	}
+	if app.redisDB != nil {
+		_ = app.redisDB.Close()
 	}
+}
+
+// Reborn
+func (app *App) Run() error {
+	defer app.close()
 	message := logMessage + "Run:"
 	log.Info(message + "start")
 	port := os.Getenv("PORT")
@@ -166,6 +289,8 @@ func (app *App) Run() error {
 		port = "test port"
 	}
 	r := newRouterWithEndpoints(app)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	/*
 		go func() {
 			for {
@@ -176,15 +301,47 @@ func (app *App) Run() error {
 	*/
 	go func() {
 		for {
-			time.Sleep(time.Minute)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Minute):
+			}
 			err := app.notificationManager.EventTomorrowNotification()
 			if err != nil {
 				return
 			}
-			time.Sleep(time.Hour - time.Minute)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Hour - time.Minute):
+			}
 		}
 	}()
-	err := http.ListenAndServe(":"+port, r)
+
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: r,
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+	select {
+	case err := <-serveErr:
+		log.Error(message+"err = ", err)
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info(message + "shutting down")
+	atomic.StoreInt32(&app.shuttingDown, 1)
+	// The balancer has to see the failing /readyz before the listener is closed
+	time.Sleep(viper.GetDuration("shutdown.drain_delay"))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("shutdown.timeout"))
+	defer cancel()
+	// Shutdown does not wait for the hijacked websocket connections
+	app.wsPool.Close()
+	err := server.Shutdown(shutdownCtx)
 	if err != nil {
 		log.Error(message+"err = ", err)
 		return err
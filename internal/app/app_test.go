@@ -1,9 +1,15 @@
 package app
 
 import (
+	"backend/pkg/healthcheck"
+	"context"
+	"errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestApp(t *testing.T) {
@@ -16,3 +22,29 @@ func TestApp(t *testing.T) {
 	err = app.Run()
 	require.Error(t, err)
 }
+
+func TestHealth(t *testing.T) {
+	checker := healthcheck.NewChecker(time.Second)
+	app := &App{checker: checker}
+
+	w := httptest.NewRecorder()
+	app.Healthz(w, httptest.NewRequest("GET", "/healthz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	app.Readyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	checker.Add("postgres", func(ctx context.Context) error {
+		return errors.New("test_err")
+	})
+	w = httptest.NewRecorder()
+	app.Readyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.JSONEq(t, `{"status":503,"code":"service_unavailable","body":{"failed":{"postgres":"test_err"}}}`, w.Body.String())
+
+	app = &App{checker: healthcheck.NewChecker(time.Second), shuttingDown: 1}
+	w = httptest.NewRecorder()
+	app.Readyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,167 @@
+package errcode
+
+import (
+	authError "backend/internal/service/auth/error"
+	eventError "backend/internal/service/event/error"
+	notificationError "backend/internal/service/notification/error"
+	userError "backend/internal/service/user/error"
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Code is a machine-readable error code, the same in the gRPC status details and in the HTTP response
+type Code string
+
+const (
+	CodeBadRequest         Code = "bad_request"
+	CodeUnavailable        Code = "service_unavailable"
+	CodeEmptyData          Code = "empty_data"
+	CodeDatabase           Code = "database_error"
+	CodeNotNumber          Code = "not_a_number"
+	CodeNotAllowed         Code = "not_allowed"
+	CodeNotFound           Code = "not_found"
+	CodeUserNotFound       Code = "user_not_found"
+	CodeUserExists         Code = "user_exists"
+	CodeNoCookie           Code = "no_session_cookie"
+	CodeSessionNotFound    Code = "session_not_found"
+	CodeUnknownSession     Code = "unknown_session"
+	CodeRole               Code = "unknown_role"
+	CodeCursor             Code = "invalid_cursor"
+	CodeDateFormat         Code = "invalid_date_format"
+	CodeTimeZone           Code = "unknown_time_zone"
+	CodeDateRange          Code = "invalid_date_range"
+	CodeStartDate          Code = "empty_start_date"
+	CodeGeo                Code = "invalid_coordinates"
+	CodeBBox               Code = "invalid_bounding_box"
+	CodeGeocoder           Code = "geocoder_failed"
+	CodeCapacity           Code = "invalid_capacity"
+	CodeRSVPStatus         Code = "unknown_rsvp_status"
+	CodeInvitationExists   Code = "invitation_exists"
+	CodeInvitationExpired  Code = "invitation_expired"
+	CodeInvitationAnswered Code = "invitation_answered"
+	CodeInvitationStatus   Code = "unknown_invitation_status"
+	CodeRRule              Code = "invalid_rrule"
+	CodeNotSeries          Code = "not_series"
+	CodeSeriesTooLong      Code = "series_too_long"
+	CodeCalendarToken      Code = "invalid_calendar_token"
+	CodeResetToken         Code = "invalid_reset_token"
+	CodeTooManyRequests    Code = "too_many_requests"
+	CodeLoginLocked        Code = "login_locked"
+	CodeVerificationToken  Code = "invalid_verification_token"
+	CodeNotVerified        Code = "email_not_verified"
+	CodeAlreadyVerified    Code = "email_already_verified"
+	CodeTwoFactorCode      Code = "invalid_two_factor_code"
+	CodePreAuthToken       Code = "invalid_pre_auth_token"
+	CodeTwoFactorEnabled   Code = "two_factor_enabled"
+	CodeTwoFactorDisabled  Code = "two_factor_disabled"
+	CodeOAuthProvider      Code = "unknown_oauth_provider"
+	CodeOAuthState         Code = "invalid_oauth_state"
+	CodeOAuthFailed        Code = "oauth_failed"
+	CodeOAuthNoEmail       Code = "oauth_no_email"
+	CodeOAuthEmailExists   Code = "oauth_email_exists"
+	CodeOAuthLinked        Code = "oauth_linked"
+	CodeOAuthNotLinked     Code = "oauth_not_linked"
+	CodeCSRFToken          Code = "invalid_csrf_token"
+)
+
+// ErrUnavailable is returned instead of the transport errors of gRPC
+var ErrUnavailable = errors.New("service is unavailable")
+
+type domainError struct {
+	err  error
+	code Code
+	grpc codes.Code
+}
+
+// The first error of a code is the one the gateway gets back from the gRPC status
+var domainErrors = []domainError{
+	{ErrUnavailable, CodeUnavailable, codes.Unavailable},
+	{authError.ErrEmptyData, CodeEmptyData, codes.InvalidArgument},
+	{userError.ErrEmptyData, CodeEmptyData, codes.InvalidArgument},
+	{eventError.ErrEmptyData, CodeEmptyData, codes.InvalidArgument},
+	{authError.ErrPostgres, CodeDatabase, codes.Internal},
+	{userError.ErrPostgres, CodeDatabase, codes.Internal},
+	{eventError.ErrPostgres, CodeDatabase, codes.Internal},
+	{notificationError.ErrPostgres, CodeDatabase, codes.Internal},
+	{userError.ErrAtoi, CodeNotNumber, codes.InvalidArgument},
+	{eventError.ErrAtoi, CodeNotNumber, codes.InvalidArgument},
+	{authError.ErrNotAllowed, CodeNotAllowed, codes.PermissionDenied},
+	{userError.ErrNotAllowed, CodeNotAllowed, codes.PermissionDenied},
+	{eventError.ErrNotAllowed, CodeNotAllowed, codes.PermissionDenied},
+	{eventError.ErrNoRows, CodeNotFound, codes.NotFound},
+	{notificationError.ErrNoRows, CodeNotFound, codes.NotFound},
+	{authError.ErrUserNotFound, CodeUserNotFound, codes.NotFound},
+	{userError.ErrUserNotFound, CodeUserNotFound, codes.NotFound},
+	{authError.ErrUserExists, CodeUserExists, codes.AlreadyExists},
+	{authError.ErrCookie, CodeNoCookie, codes.Unauthenticated},
+	{http.ErrNoCookie, CodeNoCookie, codes.Unauthenticated},
+	{authError.ErrSessionNotFound, CodeSessionNotFound, codes.Unauthenticated},
+	{authError.ErrEmptySessionId, CodeSessionNotFound, codes.Unauthenticated},
+	{authError.ErrUnknownSession, CodeUnknownSession, codes.NotFound},
+	{userError.ErrUnknownRole, CodeRole, codes.InvalidArgument},
+	{eventError.ErrCursor, CodeCursor, codes.InvalidArgument},
+	{eventError.ErrDateFormat, CodeDateFormat, codes.InvalidArgument},
+	{eventError.ErrTimeZone, CodeTimeZone, codes.InvalidArgument},
+	{eventError.ErrDateRange, CodeDateRange, codes.InvalidArgument},
+	{eventError.ErrStartDate, CodeStartDate, codes.InvalidArgument},
+	{eventError.ErrGeo, CodeGeo, codes.InvalidArgument},
+	{eventError.ErrBBox, CodeBBox, codes.InvalidArgument},
+	{eventError.ErrGeocoder, CodeGeocoder, codes.Unavailable},
+	{eventError.ErrCapacity, CodeCapacity, codes.InvalidArgument},
+	{eventError.ErrRSVPStatus, CodeRSVPStatus, codes.InvalidArgument},
+	{eventError.ErrInvitationExists, CodeInvitationExists, codes.AlreadyExists},
+	{eventError.ErrInvitationExpired, CodeInvitationExpired, codes.FailedPrecondition},
+	{eventError.ErrInvitationAnswered, CodeInvitationAnswered, codes.FailedPrecondition},
+	{eventError.ErrInvitationStatus, CodeInvitationStatus, codes.InvalidArgument},
+	{eventError.ErrRRule, CodeRRule, codes.InvalidArgument},
+	{eventError.ErrNotSeries, CodeNotSeries, codes.FailedPrecondition},
+	{eventError.ErrSeriesTooLong, CodeSeriesTooLong, codes.InvalidArgument},
+	{eventError.ErrCalendarToken, CodeCalendarToken, codes.PermissionDenied},
+	{authError.ErrResetToken, CodeResetToken, codes.InvalidArgument},
+	{authError.ErrTooManyRequests, CodeTooManyRequests, codes.ResourceExhausted},
+	{authError.ErrLoginLocked, CodeLoginLocked, codes.ResourceExhausted},
+	{authError.ErrVerificationToken, CodeVerificationToken, codes.InvalidArgument},
+	{authError.ErrNotVerified, CodeNotVerified, codes.PermissionDenied},
+	{authError.ErrAlreadyVerified, CodeAlreadyVerified, codes.FailedPrecondition},
+	{authError.ErrTwoFactorCode, CodeTwoFactorCode, codes.InvalidArgument},
+	{authError.ErrPreAuthToken, CodePreAuthToken, codes.Unauthenticated},
+	{authError.ErrTwoFactorEnabled, CodeTwoFactorEnabled, codes.FailedPrecondition},
+	{authError.ErrTwoFactorDisabled, CodeTwoFactorDisabled, codes.FailedPrecondition},
+	{authError.ErrOAuthProvider, CodeOAuthProvider, codes.NotFound},
+	{authError.ErrOAuthState, CodeOAuthState, codes.InvalidArgument},
+	{authError.ErrOAuthFailed, CodeOAuthFailed, codes.Unavailable},
+	{authError.ErrOAuthNoEmail, CodeOAuthNoEmail, codes.FailedPrecondition},
+	{authError.ErrOAuthEmailExists, CodeOAuthEmailExists, codes.AlreadyExists},
+	{authError.ErrOAuthLinked, CodeOAuthLinked, codes.AlreadyExists},
+	{authError.ErrOAuthNotLinked, CodeOAuthNotLinked, codes.NotFound},
+	{authError.ErrCSRFToken, CodeCSRFToken, codes.PermissionDenied},
+}
+
+func lookup(err error) (domainError, bool) {
+	for _, d := range domainErrors {
+		if errors.Is(err, d.err) {
+			return d, true
+		}
+	}
+	return domainError{}, false
+}
+
+// Of returns the code of a domain error and CodeBadRequest for any other error
+func Of(err error) Code {
+	d, ok := lookup(err)
+	if !ok {
+		return CodeBadRequest
+	}
+	return d.code
+}
+
+func errorOf(code Code) (error, bool) {
+	for _, d := range domainErrors {
+		if d.code == code {
+			return d.err, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,90 @@
+package errcode
+
+import (
+	authError "backend/internal/service/auth/error"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errorDomain = "bmstusa.ru"
+	// Metadata key of the time left until the next login, in seconds
+	retryAfterKey = "retry_after"
+)
+
+// ToStatus turns a domain error into a gRPC status with the code in the details,
+// any other error keeps its message with codes.Unknown
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	d, ok := lookup(err)
+	if !ok {
+		return status.Error(codes.Unknown, err.Error())
+	}
+	info := &errdetails.ErrorInfo{
+		Reason: string(d.code),
+		Domain: errorDomain,
+	}
+	var locked *authError.LoginLockedError
+	if errors.As(err, &locked) {
+		info.Metadata = map[string]string{
+			retryAfterKey: strconv.FormatInt(int64(locked.RetryAfter/time.Second), 10),
+		}
+	}
+	st, detailsErr := status.New(d.grpc, err.Error()).WithDetails(info)
+	if detailsErr != nil {
+		return status.Error(d.grpc, err.Error())
+	}
+	return st.Err()
+}
+
+// FromStatus turns a gRPC status back into the domain error it was made of
+func FromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != errorDomain {
+			continue
+		}
+		code := Code(info.Reason)
+		if code == CodeLoginLocked {
+			seconds, _ := strconv.ParseInt(info.Metadata[retryAfterKey], 10, 64)
+			return authError.LoginLocked(time.Duration(seconds) * time.Second)
+		}
+		if domainErr, ok := errorOf(code); ok {
+			return domainErr
+		}
+	}
+	if st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded {
+		return ErrUnavailable
+	}
+	return errors.New(st.Message())
+}
+
+// UnaryServerInterceptor is installed in the microservices, so the handlers return plain domain errors
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, ToStatus(err)
+}
+
+// UnaryClientInterceptor is installed in the gateway, so the gRPC repositories get the domain errors back
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return FromStatus(invoker(ctx, method, req, reply, cc, opts...))
+}
@@ -0,0 +1,66 @@
+package errcode
+
+import (
+	authError "backend/internal/service/auth/error"
+	eventError "backend/internal/service/event/error"
+	userError "backend/internal/service/user/error"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var statusTests = []struct {
+	id   int
+	err  error
+	code codes.Code
+	out  error
+}{
+	{1, userError.ErrUserNotFound, codes.NotFound, authError.ErrUserNotFound},
+	{2, userError.ErrAtoi, codes.InvalidArgument, userError.ErrAtoi},
+	{3, eventError.ErrCalendarToken, codes.PermissionDenied, eventError.ErrCalendarToken},
+	{4, authError.ErrCSRFToken, codes.PermissionDenied, authError.ErrCSRFToken},
+	{5, authError.ErrEmptySessionId, codes.Unauthenticated, authError.ErrSessionNotFound},
+}
+
+func TestStatusRoundTrip(t *testing.T) {
+	for _, test := range statusTests {
+		st := ToStatus(test.err)
+		require.Equal(t, test.code, status.Code(st), test.id)
+
+		err := FromStatus(st)
+		require.True(t, errors.Is(err, test.out), test.id)
+		require.Equal(t, Of(test.err), Of(err), test.id)
+	}
+}
+
+func TestLoginLockedRoundTrip(t *testing.T) {
+	err := FromStatus(ToStatus(authError.LoginLocked(90 * time.Second)))
+
+	var locked *authError.LoginLockedError
+	require.True(t, errors.As(err, &locked))
+	require.Equal(t, 90*time.Second, locked.RetryAfter)
+	require.True(t, errors.Is(err, authError.ErrLoginLocked))
+	require.Equal(t, CodeLoginLocked, Of(err))
+}
+
+func TestUnknownError(t *testing.T) {
+	st := ToStatus(errors.New("test_err"))
+	require.Equal(t, codes.Unknown, status.Code(st))
+
+	err := FromStatus(st)
+	require.EqualError(t, err, "test_err")
+	require.Equal(t, CodeBadRequest, Of(err))
+}
+
+func TestUnavailable(t *testing.T) {
+	err := FromStatus(status.Error(codes.Unavailable, "connection error: desc = \"transport: Error while dialing dial tcp\""))
+	require.Equal(t, ErrUnavailable, err)
+	require.Equal(t, CodeUnavailable, Of(err))
+
+	require.Nil(t, ToStatus(nil))
+	require.Nil(t, FromStatus(nil))
+}
@@ -1,16 +1,69 @@
 package error
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
-	ErrUserNotFound    = errors.New("Пользователь не найден")
-	ErrEmptyData       = errors.New("Отсутствуют необходимые данные")
-	ErrPostgres        = errors.New("Проблема с базой данных")
-	ErrAtoi            = errors.New("Введённая строка должна быть числовой")
-	ErrNotAllowed      = errors.New("Нет прав на совершение действия")
-	ErrNoRows          = errors.New("Запрашиваемые данные отсутствуют")
-	ErrCookie          = errors.New("Ошибка с получением cookie")
-	ErrUserExists      = errors.New("Пользователь уже зарегистрирован")
-	ErrInternal        = errors.New("Проблема на стороне сервера")
-	ErrSessionNotFound = errors.New("Сессия пользователя не найдена")
+	ErrUserNotFound       = errors.New("Пользователь не найден")
+	ErrEmptyData          = errors.New("Отсутствуют необходимые данные")
+	ErrPostgres           = errors.New("Проблема с базой данных")
+	ErrAtoi               = errors.New("Введённая строка должна быть числовой")
+	ErrNotAllowed         = errors.New("Нет прав на совершение действия")
+	ErrNoRows             = errors.New("Запрашиваемые данные отсутствуют")
+	ErrCookie             = errors.New("Ошибка с получением cookie")
+	ErrUserExists         = errors.New("Пользователь уже зарегистрирован")
+	ErrInternal           = errors.New("Проблема на стороне сервера")
+	ErrSessionNotFound    = errors.New("Сессия пользователя не найдена")
+	ErrCursor             = errors.New("Некорректный курсор страницы")
+	ErrDateFormat         = errors.New("Неверный формат даты")
+	ErrTimeZone           = errors.New("Неизвестный часовой пояс")
+	ErrDateRange          = errors.New("Мероприятие заканчивается раньше, чем начинается")
+	ErrStartDate          = errors.New("Не указано время начала мероприятия")
+	ErrGeo                = errors.New("Некорректные координаты")
+	ErrBBox               = errors.New("Некорректная область карты")
+	ErrGeocoder           = errors.New("Не удалось определить адрес по координатам")
+	ErrCapacity           = errors.New("Некорректное количество мест")
+	ErrRSVPStatus         = errors.New("Неизвестный статус участия")
+	ErrInvitationExists   = errors.New("Приглашение уже отправлено")
+	ErrInvitationExpired  = errors.New("Срок действия приглашения истёк")
+	ErrInvitationAnswered = errors.New("На приглашение уже дан ответ")
+	ErrInvitationStatus   = errors.New("Неизвестный ответ на приглашение")
+	ErrRRule              = errors.New("Некорректное правило повторения")
+	ErrNotSeries          = errors.New("Мероприятие не повторяется")
+	ErrSeriesTooLong      = errors.New("Слишком много повторений мероприятия")
+	ErrCalendarToken      = errors.New("Ссылка на календарь недействительна")
+	ErrResetToken         = errors.New("Ссылка для сброса пароля недействительна")
+	ErrTooManyRequests    = errors.New("Слишком много запросов, попробуйте позже")
+	ErrVerificationToken  = errors.New("Ссылка для подтверждения почты недействительна")
+	ErrNotVerified        = errors.New("Подтвердите почту, чтобы продолжить")
+	ErrAlreadyVerified    = errors.New("Почта уже подтверждена")
+	ErrUnknownSession     = errors.New("Сеанс не найден")
+	ErrTwoFactorCode      = errors.New("Неверный код подтверждения")
+	ErrPreAuthToken       = errors.New("Время на ввод кода истекло, войдите заново")
+	ErrTwoFactorEnabled   = errors.New("Двухфакторная аутентификация уже включена")
+	ErrTwoFactorDisabled  = errors.New("Двухфакторная аутентификация не включена")
+	ErrOAuthProvider      = errors.New("Вход через этот сервис недоступен")
+	ErrOAuthState         = errors.New("Время на вход истекло, попробуйте ещё раз")
+	ErrOAuthFailed        = errors.New("Не удалось войти через внешний сервис")
+	ErrOAuthNoEmail       = errors.New("Внешний сервис не сообщил адрес почты")
+	ErrOAuthEmailExists   = errors.New("Пользователь с этой почтой уже зарегистрирован, войдите и привяжите аккаунт в профиле")
+	ErrOAuthLinked        = errors.New("Аккаунт уже привязан к другому пользователю")
+	ErrOAuthNotLinked     = errors.New("Аккаунт не привязан")
+	ErrCSRFToken          = errors.New("Недействительный CSRF-токен, обновите страницу")
+	ErrRole               = errors.New("Неизвестная роль пользователя")
 )
+
+// LoginLockedError carries the time left until the next attempt, the frontend shows it to the user
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	if e.RetryAfter < time.Minute {
+		return fmt.Sprintf("Слишком много неудачных попыток входа, попробуйте через %d сек.", e.RetryAfter/time.Second)
+	}
+	return fmt.Sprintf("Слишком много неудачных попыток входа, попробуйте через %d мин.", (e.RetryAfter+time.Minute-1)/time.Minute)
+}
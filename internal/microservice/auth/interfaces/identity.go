@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"time"
+)
+
+type IdentityRepository interface {
+	Get(provider, subject string) (*authServiceModels.Identity, error)
+	Link(identity *authServiceModels.Identity) error
+	List(userId string) ([]*authServiceModels.Identity, error)
+	Unlink(userId, provider string) (bool, error)
+}
+
+type OAuthStateRepository interface {
+	Save(state string, data *authServiceModels.OAuthState, lifeTime time.Duration) error
+	Use(state string) (*authServiceModels.OAuthState, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "time"
+
+type LoginLimitRepository interface {
+	CountFailure(key string, window time.Duration) (int64, error)
+	CountLockout(key string, window time.Duration) (int64, error)
+	ResetFailures(key string) error
+	Lock(key string, lifeTime time.Duration) error
+	LockTime(key string) (time.Duration, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"time"
+)
+
+type ResetRepository interface {
+	CreateToken(token, userId string, lifeTime time.Duration) error
+	UseToken(token string) (string, error)
+	CountRequest(mail string, window time.Duration) (int64, error)
+}
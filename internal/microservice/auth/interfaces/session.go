@@ -2,10 +2,16 @@ package interfaces
 
 import (
 	authServiceModels "backend/internal/microservice/auth/models"
+	"time"
 )
 
 type SessionRepository interface {
 	Create(data *authServiceModels.SessionData) error
 	Check(sessionId string) (string, error)
+	Meta(sessionId string) (*authServiceModels.SessionData, error)
+	Touch(sessionId string, lastSeen time.Time, expiration time.Duration) error
+	List(userId string) ([]*authServiceModels.SessionData, error)
 	Delete(sessionId string) error
+	DeleteUserSessions(userId string) error
+	DeleteOtherSessions(userId, keep string) error
 }
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"time"
+)
+
+type TwoFactorRepository interface {
+	Get(userId string) (*authServiceModels.TwoFactor, error)
+	SetSecret(userId, secret string) (bool, error)
+	Enable(userId string, codeHashes []string) (bool, error)
+	Disable(userId string) error
+	ReplaceRecoveryCodes(userId string, codeHashes []string) error
+	UseRecoveryCode(userId, codeHash string) (bool, error)
+}
+
+type PreAuthRepository interface {
+	CreateToken(token, userId string, lifeTime time.Duration) error
+	CheckToken(token string) (string, error)
+	CountAttempt(token string, lifeTime time.Duration) (int64, error)
+	DeleteToken(token string) error
+	UseStep(userId string, step int64, lifeTime time.Duration) (bool, error)
+}
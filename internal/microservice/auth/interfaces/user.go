@@ -6,5 +6,10 @@ import (
 
 type UserRepository interface {
 	CreateUser(user *models.User) (string, error)
-	GetUser(mail, password string) (*models.User, error)
+	GetUser(mail string) (*models.User, error)
+	GetUserById(userId string) (*models.User, error)
+	GetUserRole(userId string) (string, error)
+	VerifyUser(userId, mail string) (bool, error)
+	UpdatePassword(userId, oldHash, newHash string) error
+	ResetPassword(userId, hash string) error
 }
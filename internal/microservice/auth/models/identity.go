@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Identity links an account of an identity provider to a user
+type Identity struct {
+	Provider  string
+	Subject   string
+	UserId    string
+	Mail      string
+	CreatedAt time.Time
+}
+
+// OAuthState is what the auth service remembers between the redirect to the provider and the callback
+type OAuthState struct {
+	Provider string
+	Nonce    string
+	Verifier string
+	// Set when a logged in user links an account, empty for a login
+	UserId string
+}
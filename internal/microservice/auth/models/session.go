@@ -8,4 +8,12 @@ type SessionData struct {
 	SessionId  string
 	UserId     string
 	Expiration time.Duration
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeen   time.Time
+	// Long lived session chosen with "remember me"
+	Remember bool
+	// The session ends at this time however active it is
+	ExpiresAt time.Time
 }
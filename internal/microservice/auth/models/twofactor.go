@@ -0,0 +1,7 @@
+package models
+
+type TwoFactor struct {
+	UserId  string
+	Secret  string
+	Enabled bool
+}
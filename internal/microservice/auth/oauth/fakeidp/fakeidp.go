@@ -0,0 +1,208 @@
+// Package fakeidp is an OpenID Connect provider that logs in a fixed user without asking,
+// it is used in tests and to try the social login locally
+package fakeidp
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/microservice/auth/oauth"
+)
+
+const ClientSecret = "fake-secret"
+
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Surname       string
+}
+
+type grant struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	user          User
+}
+
+type Server struct {
+	Issuer string
+	mu     sync.Mutex
+	user   User
+	codes  map[string]grant
+	tokens map[string]User
+}
+
+func New(issuer string, user User) *Server {
+	return &Server{
+		Issuer: issuer,
+		user:   user,
+		codes:  make(map[string]grant),
+		tokens: make(map[string]User),
+	}
+}
+
+// NewTestServer starts the provider on a local port, Issuer is its url
+func NewTestServer(user User) (*Server, *httptest.Server) {
+	s := New("", user)
+	ts := httptest.NewServer(s)
+	s.Issuer = ts.URL
+	return s, ts
+}
+
+// SetUser changes the user logged in by the next authorization
+func (s *Server) SetUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = user
+}
+
+// Config is the provider config of the server for the given client
+func (s *Server) Config(clientID, redirectURL string) oauth.Config {
+	return oauth.Config{
+		ClientID:     clientID,
+		ClientSecret: ClientSecret,
+		RedirectURL:  redirectURL,
+		AuthURL:      s.Issuer + "/authorize",
+		TokenURL:     s.Issuer + "/token",
+		UserInfoURL:  s.Issuer + "/userinfo",
+		Scopes:       []string{"openid", "email", "profile"},
+		Issuer:       s.Issuer,
+		Fields: oauth.Fields{
+			ID:            "sub",
+			Email:         "email",
+			EmailVerified: "email_verified",
+			Name:          "given_name",
+			Surname:       "family_name",
+		},
+	}
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/authorize":
+		s.authorize(w, r)
+	case "/token":
+		s.token(w, r)
+	case "/userinfo":
+		s.userInfo(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.String() == "" || query.Get("response_type") != "code" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		http.Error(w, "pkce required", http.StatusBadRequest)
+		return
+	}
+	code := randomString()
+	s.mu.Lock()
+	s.codes[code] = grant{
+		clientID:      query.Get("client_id"),
+		redirectURI:   redirectURI.String(),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		user:          s.user,
+	}
+	s.mu.Unlock()
+	values := redirectURI.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirectURI.RawQuery = values.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	s.mu.Lock()
+	g, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+	if !ok || g.clientID != r.PostForm.Get("client_id") || g.redirectURI != r.PostForm.Get("redirect_uri") ||
+		oauth.CodeChallenge(r.PostForm.Get("code_verifier")) != g.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.PostForm.Get("client_secret")), []byte(ClientSecret)) != 1 {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	accessToken := randomString()
+	s.mu.Lock()
+	s.tokens[accessToken] = g.user
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     s.idToken(g),
+	})
+}
+
+// idToken is not signed, the client does not check signatures of tokens from the token endpoint
+func (s *Server) idToken(g grant) string {
+	header, _ := json.Marshal(map[string]string{"alg": "none", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss":   s.Issuer,
+		"sub":   g.user.Subject,
+		"aud":   g.clientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": g.nonce,
+	})
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims) + "."
+}
+
+func (s *Server) userInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	user, ok := s.tokens[accessToken]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+	info := map[string]interface{}{
+		"sub":         user.Subject,
+		"given_name":  user.Name,
+		"family_name": user.Surname,
+	}
+	if user.Email != "" {
+		info["email"] = user.Email
+		info["email_verified"] = user.EmailVerified
+	}
+	writeJSON(w, http.StatusOK, info)
+}
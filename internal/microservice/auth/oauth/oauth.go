@@ -0,0 +1,306 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	// Responses of the identity providers are small, a bigger one is an error
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrExchange = errors.New("oauth code exchange failed")
+	ErrUserInfo = errors.New("oauth user info request failed")
+	ErrIDToken  = errors.New("invalid oauth id token")
+)
+
+// Fields maps the paths of the user data in the provider responses, e.g. "response.0.first_name"
+type Fields struct {
+	ID            string `mapstructure:"id"`
+	Email         string `mapstructure:"email"`
+	EmailVerified string `mapstructure:"email_verified"`
+	Name          string `mapstructure:"name"`
+	Surname       string `mapstructure:"surname"`
+}
+
+type Config struct {
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"-"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	AuthURL      string   `mapstructure:"auth_url"`
+	TokenURL     string   `mapstructure:"token_url"`
+	UserInfoURL  string   `mapstructure:"userinfo_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	// OpenID Connect providers send an id token, its issuer and nonce are checked
+	Issuer string `mapstructure:"issuer"`
+	// "Bearer" by default, Yandex uses "OAuth"
+	AuthScheme string `mapstructure:"auth_scheme"`
+	// The access token is also passed as ?access_token=, VK API needs it
+	TokenInQuery bool `mapstructure:"token_in_query"`
+	// The provider only gives out confirmed addresses and does not say so in the response
+	TrustEmail bool   `mapstructure:"trust_email"`
+	Fields     Fields `mapstructure:"fields"`
+}
+
+// Identity is the user as the provider knows them
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Surname       string
+}
+
+type Provider struct {
+	Name   string
+	config Config
+	client *http.Client
+}
+
+func NewProvider(name string, config Config) *Provider {
+	if config.AuthScheme == "" {
+		config.AuthScheme = "Bearer"
+	}
+	if config.Fields.ID == "" {
+		config.Fields.ID = "sub"
+	}
+	if config.Fields.Email == "" {
+		config.Fields.Email = "email"
+	}
+	return &Provider{
+		Name:   name,
+		config: config,
+		client: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// LoadProviders reads oauth.providers from the config, the client secret of a provider
+// is taken from OAUTH_<NAME>_SECRET. Providers without client_id are disabled.
+func LoadProviders() (map[string]*Provider, error) {
+	providers := make(map[string]*Provider)
+	for name := range viper.GetStringMap("oauth.providers") {
+		config := Config{}
+		err := viper.UnmarshalKey("oauth.providers."+name, &config)
+		if err != nil {
+			return nil, err
+		}
+		if config.ClientID == "" {
+			continue
+		}
+		config.ClientSecret = os.Getenv("OAUTH_" + strings.ToUpper(name) + "_SECRET")
+		providers[name] = NewProvider(name, config)
+	}
+	return providers, nil
+}
+
+func (p *Provider) IsOIDC() bool {
+	return p.config.Issuer != ""
+}
+
+// CodeChallenge is the PKCE S256 challenge of the verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (p *Provider) AuthCodeURL(state, nonce, verifier string) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("state", state)
+	query.Set("code_challenge", CodeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	if len(p.config.Scopes) != 0 {
+		query.Set("scope", strings.Join(p.config.Scopes, " "))
+	}
+	if p.IsOIDC() {
+		query.Set("nonce", nonce)
+	}
+	separator := "?"
+	if strings.Contains(p.config.AuthURL, "?") {
+		separator = "&"
+	}
+	return p.config.AuthURL + separator + query.Encode()
+}
+
+func readJSON(resp *http.Response) (map[string]interface{}, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	result := make(map[string]interface{})
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize))
+	decoder.UseNumber()
+	err := decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Authenticate exchanges the code for the tokens and returns the identity of the user
+func (p *Provider) Authenticate(code, verifier, nonce string) (*Identity, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("client_secret", p.config.ClientSecret)
+	form.Set("code_verifier", verifier)
+	resp, err := p.client.PostForm(p.config.TokenURL, form)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	token, err := readJSON(resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	accessToken, _ := token["access_token"].(string)
+	if accessToken == "" {
+		return nil, fmt.Errorf("%w: no access token", ErrExchange)
+	}
+	documents := []map[string]interface{}{token}
+	if p.IsOIDC() {
+		rawIDToken, _ := token["id_token"].(string)
+		claims, err := p.verifyIDToken(rawIDToken, nonce)
+		if err != nil {
+			return nil, err
+		}
+		documents = append([]map[string]interface{}{claims}, documents...)
+	}
+	if p.config.UserInfoURL != "" {
+		userInfo, err := p.userInfo(accessToken)
+		if err != nil {
+			return nil, err
+		}
+		documents = append([]map[string]interface{}{userInfo}, documents...)
+	}
+	return p.identity(documents), nil
+}
+
+func (p *Provider) userInfo(accessToken string) (map[string]interface{}, error) {
+	userInfoURL := p.config.UserInfoURL
+	if p.config.TokenInQuery {
+		separator := "?"
+		if strings.Contains(userInfoURL, "?") {
+			separator = "&"
+		}
+		userInfoURL += separator + "access_token=" + url.QueryEscape(accessToken)
+	}
+	req, err := http.NewRequest(http.MethodGet, userInfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUserInfo, err)
+	}
+	req.Header.Set("Authorization", p.config.AuthScheme+" "+accessToken)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUserInfo, err)
+	}
+	userInfo, err := readJSON(resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUserInfo, err)
+	}
+	return userInfo, nil
+}
+
+// verifyIDToken checks the claims of the id token. The signature is not checked: the token
+// comes straight from the token endpoint over TLS, which OpenID Connect Core 3.1.3.7 allows.
+func (p *Provider) verifyIDToken(rawIDToken, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrIDToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, ErrIDToken
+	}
+	claims := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(string(payload)))
+	decoder.UseNumber()
+	err = decoder.Decode(&claims)
+	if err != nil {
+		return nil, ErrIDToken
+	}
+	if claims["iss"] != p.config.Issuer || claims["nonce"] != nonce || !hasAudience(claims["aud"], p.config.ClientID) {
+		return nil, ErrIDToken
+	}
+	exp, err := strconv.ParseInt(fmt.Sprint(claims["exp"]), 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		return nil, ErrIDToken
+	}
+	return claims, nil
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lookup finds a dotted path in the first document that has it
+func lookup(path string, documents []map[string]interface{}) string {
+	if path == "" {
+		return ""
+	}
+	for _, document := range documents {
+		var value interface{} = document
+		for _, key := range strings.Split(path, ".") {
+			switch node := value.(type) {
+			case map[string]interface{}:
+				value = node[key]
+			case []interface{}:
+				i, err := strconv.Atoi(key)
+				if err != nil || i < 0 || i >= len(node) {
+					value = nil
+				} else {
+					value = node[i]
+				}
+			default:
+				value = nil
+			}
+		}
+		if value != nil {
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+func (p *Provider) identity(documents []map[string]interface{}) *Identity {
+	fields := p.config.Fields
+	identity := &Identity{
+		Subject: lookup(fields.ID, documents),
+		Email:   strings.TrimSpace(lookup(fields.Email, documents)),
+		Name:    lookup(fields.Name, documents),
+		Surname: lookup(fields.Surname, documents),
+	}
+	if identity.Email != "" {
+		verified, _ := strconv.ParseBool(lookup(fields.EmailVerified, documents))
+		identity.EmailVerified = p.config.TrustEmail || verified
+	}
+	return identity
+}
@@ -0,0 +1,209 @@
+package oauth_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"backend/internal/microservice/auth/oauth"
+	"backend/internal/microservice/auth/oauth/fakeidp"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const redirectURL = "https://bmstusa.ru/oauth/fake"
+
+var fakeUser = fakeidp.User{
+	Subject:       "42",
+	Email:         "user@mail.ru",
+	EmailVerified: true,
+	Name:          "Иван",
+	Surname:       "Иванов",
+}
+
+var noRedirect = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// authorize follows the authorization url and returns the code and state of the redirect
+func authorize(t *testing.T, authURL string) (string, string) {
+	resp, err := noRedirect.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestAuthenticateOIDC(t *testing.T) {
+	idp, ts := fakeidp.NewTestServer(fakeUser)
+	defer ts.Close()
+	provider := oauth.NewProvider("fake", idp.Config("client", redirectURL))
+
+	code, state := authorize(t, provider.AuthCodeURL("state", "nonce", "verifier"))
+	assert.Equal(t, "state", state)
+	identity, err := provider.Authenticate(code, "verifier", "nonce")
+	require.NoError(t, err)
+	assert.Equal(t, &oauth.Identity{
+		Subject:       "42",
+		Email:         "user@mail.ru",
+		EmailVerified: true,
+		Name:          "Иван",
+		Surname:       "Иванов",
+	}, identity)
+
+	_, err = provider.Authenticate(code, "verifier", "nonce")
+	assert.ErrorIs(t, err, oauth.ErrExchange)
+}
+
+func TestAuthenticateWrongVerifier(t *testing.T) {
+	idp, ts := fakeidp.NewTestServer(fakeUser)
+	defer ts.Close()
+	provider := oauth.NewProvider("fake", idp.Config("client", redirectURL))
+
+	code, _ := authorize(t, provider.AuthCodeURL("state", "nonce", "verifier"))
+	_, err := provider.Authenticate(code, "other", "nonce")
+	assert.ErrorIs(t, err, oauth.ErrExchange)
+}
+
+func TestAuthenticateWrongNonce(t *testing.T) {
+	idp, ts := fakeidp.NewTestServer(fakeUser)
+	defer ts.Close()
+	provider := oauth.NewProvider("fake", idp.Config("client", redirectURL))
+
+	code, _ := authorize(t, provider.AuthCodeURL("state", "nonce", "verifier"))
+	_, err := provider.Authenticate(code, "verifier", "other")
+	assert.ErrorIs(t, err, oauth.ErrIDToken)
+}
+
+func TestAuthenticateWrongSecret(t *testing.T) {
+	idp, ts := fakeidp.NewTestServer(fakeUser)
+	defer ts.Close()
+	config := idp.Config("client", redirectURL)
+	config.ClientSecret = "wrong"
+	provider := oauth.NewProvider("fake", config)
+
+	code, _ := authorize(t, provider.AuthCodeURL("state", "nonce", "verifier"))
+	_, err := provider.Authenticate(code, "verifier", "nonce")
+	assert.ErrorIs(t, err, oauth.ErrExchange)
+}
+
+func TestAuthenticateUnverifiedEmail(t *testing.T) {
+	user := fakeUser
+	user.EmailVerified = false
+	idp, ts := fakeidp.NewTestServer(user)
+	defer ts.Close()
+	provider := oauth.NewProvider("fake", idp.Config("client", redirectURL))
+
+	code, _ := authorize(t, provider.AuthCodeURL("state", "nonce", "verifier"))
+	identity, err := provider.Authenticate(code, "verifier", "nonce")
+	require.NoError(t, err)
+	assert.Equal(t, "user@mail.ru", identity.Email)
+	assert.False(t, identity.EmailVerified)
+}
+
+// A VK-like provider: no id token, the email comes with the access token, the name from the API
+func TestAuthenticateOAuth2(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/access_token":
+			assert.Equal(t, "code", r.FormValue("code"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "token",
+				"user_id":      12345,
+				"email":        "user@vk.com",
+			})
+		case "/method/users.get":
+			assert.Equal(t, "token", r.URL.Query().Get("access_token"))
+			_, _ = w.Write([]byte(`{"response":[{"id":12345,"first_name":"Иван","last_name":"Иванов"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+	provider := oauth.NewProvider("vk", oauth.Config{
+		ClientID:     "client",
+		AuthURL:      ts.URL + "/authorize",
+		TokenURL:     ts.URL + "/access_token",
+		UserInfoURL:  ts.URL + "/method/users.get?v=5.131",
+		TokenInQuery: true,
+		TrustEmail:   true,
+		Fields: oauth.Fields{
+			ID:      "user_id",
+			Email:   "email",
+			Name:    "response.0.first_name",
+			Surname: "response.0.last_name",
+		},
+	})
+	assert.False(t, provider.IsOIDC())
+
+	identity, err := provider.Authenticate("code", "verifier", "")
+	require.NoError(t, err)
+	assert.Equal(t, &oauth.Identity{
+		Subject:       "12345",
+		Email:         "user@vk.com",
+		EmailVerified: true,
+		Name:          "Иван",
+		Surname:       "Иванов",
+	}, identity)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	provider := oauth.NewProvider("fake", oauth.Config{
+		ClientID:    "client",
+		RedirectURL: redirectURL,
+		AuthURL:     "https://idp.ru/authorize",
+		Scopes:      []string{"openid", "email"},
+		Issuer:      "https://idp.ru",
+	})
+	authURL, err := url.Parse(provider.AuthCodeURL("state", "nonce", "verifier"))
+	require.NoError(t, err)
+	query := authURL.Query()
+	assert.Equal(t, "client", query.Get("client_id"))
+	assert.Equal(t, redirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, "openid email", query.Get("scope"))
+	assert.Equal(t, "state", query.Get("state"))
+	assert.Equal(t, "nonce", query.Get("nonce"))
+	assert.Equal(t, oauth.CodeChallenge("verifier"), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+const providersConfig = `
+oauth:
+    providers:
+        vk:
+            client_id: "client"
+            auth_url: "https://oauth.vk.com/authorize"
+            scopes: ["email"]
+            fields:
+                name: "response.0.first_name"
+        yandex:
+            client_id: ""
+`
+
+func TestLoadProviders(t *testing.T) {
+	viper.SetConfigType("yaml")
+	require.NoError(t, viper.ReadConfig(strings.NewReader(providersConfig)))
+	t.Cleanup(viper.Reset)
+	t.Setenv("OAUTH_VK_SECRET", "secret")
+
+	providers, err := oauth.LoadProviders()
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	require.Contains(t, providers, "vk")
+	authURL, err := url.Parse(providers["vk"].AuthCodeURL("state", "nonce", "verifier"))
+	require.NoError(t, err)
+	assert.Equal(t, "oauth.vk.com", authURL.Host)
+	assert.Equal(t, "client", authURL.Query().Get("client_id"))
+	assert.Equal(t, "email", authURL.Query().Get("scope"))
+	assert.Empty(t, authURL.Query().Get("nonce"))
+}
@@ -0,0 +1,119 @@
+package identity
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	sql2 "database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	logMessage       = "service:auth:repository:identity:"
+	getIdentityQuery = `select user_id, mail, created_at from oauth_identity where provider = $1 and subject = $2`
+	linkQuery        = `insert into oauth_identity (provider, subject, user_id, mail) values ($1, $2, $3, $4)`
+	listQuery        = `select provider, subject, mail, created_at from oauth_identity where user_id = $1 order by provider`
+	unlinkQuery      = `delete from oauth_identity where user_id = $1 and provider = $2`
+)
+
+type Identity struct {
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	UserId    int       `db:"user_id"`
+	Mail      string    `db:"mail"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(database *sqlx.DB) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// Get returns nil if the account of the provider is not linked to any user
+func (s *Repository) Get(provider, subject string) (*authServiceModels.Identity, error) {
+	message := logMessage + "Get:"
+	log.Debug(message + "started")
+	identity := Identity{}
+	err := s.db.Get(&identity, getIdentityQuery, provider, subject)
+	if err == sql2.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &authServiceModels.Identity{
+		Provider:  provider,
+		Subject:   subject,
+		UserId:    strconv.Itoa(identity.UserId),
+		Mail:      identity.Mail,
+		CreatedAt: identity.CreatedAt,
+	}, nil
+}
+
+// Link fails with ErrOAuthLinked if the account or another account of the provider is already linked
+func (s *Repository) Link(identity *authServiceModels.Identity) error {
+	message := logMessage + "Link:"
+	log.Debug(message + "started")
+	_, err := s.db.Exec(linkQuery, identity.Provider, identity.Subject, identity.UserId, identity.Mail)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates") {
+			return error2.ErrOAuthLinked
+		}
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+func (s *Repository) List(userId string) ([]*authServiceModels.Identity, error) {
+	message := logMessage + "List:"
+	log.Debug(message + "started")
+	var identities []Identity
+	err := s.db.Select(&identities, listQuery, userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	result := make([]*authServiceModels.Identity, len(identities))
+	for i, identity := range identities {
+		result[i] = &authServiceModels.Identity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			UserId:    userId,
+			Mail:      identity.Mail,
+			CreatedAt: identity.CreatedAt,
+		}
+	}
+	log.Debug(message + "ended")
+	return result, nil
+}
+
+// Unlink returns false if no account of the provider is linked to the user
+func (s *Repository) Unlink(userId, provider string) (bool, error) {
+	message := logMessage + "Unlink:"
+	log.Debug(message + "started")
+	res, err := s.db.Exec(unlinkQuery, userId, provider)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return rows == 1, nil
+}
@@ -0,0 +1,103 @@
+package identity
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	error2 "backend/internal/service/auth/error"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRepositoryTest(t *testing.T) (*Repository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return NewRepository(sqlx.NewDb(db, "sqlmock")), mock
+}
+
+func TestGet(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+	createdAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(getIdentityQuery).WithArgs("google", "42").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "mail", "created_at"}).AddRow(1, "user@mail.ru", createdAt))
+	identity, err := repositoryTest.Get("google", "42")
+	assert.NoError(t, err)
+	assert.Equal(t, &authServiceModels.Identity{
+		Provider:  "google",
+		Subject:   "42",
+		UserId:    "1",
+		Mail:      "user@mail.ru",
+		CreatedAt: createdAt,
+	}, identity)
+
+	mock.ExpectQuery(getIdentityQuery).WithArgs("google", "43").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "mail", "created_at"}))
+	identity, err = repositoryTest.Get("google", "43")
+	assert.NoError(t, err)
+	assert.Nil(t, identity)
+
+	mock.ExpectQuery(getIdentityQuery).WithArgs("google", "44").WillReturnError(errors.New("test_err"))
+	_, err = repositoryTest.Get("google", "44")
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLink(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+	identity := &authServiceModels.Identity{Provider: "vk", Subject: "42", UserId: "1", Mail: "user@mail.ru"}
+
+	mock.ExpectExec(linkQuery).WithArgs("vk", "42", "1", "user@mail.ru").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repositoryTest.Link(identity))
+
+	mock.ExpectExec(linkQuery).WithArgs("vk", "42", "1", "user@mail.ru").
+		WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "oauth_identity_pkey"`))
+	assert.Equal(t, error2.ErrOAuthLinked, repositoryTest.Link(identity))
+
+	mock.ExpectExec(linkQuery).WithArgs("vk", "42", "1", "user@mail.ru").WillReturnError(errors.New("test_err"))
+	assert.Equal(t, error2.ErrPostgres, repositoryTest.Link(identity))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestList(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+	createdAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(listQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"provider", "subject", "mail", "created_at"}).
+			AddRow("google", "42", "user@gmail.com", createdAt).
+			AddRow("vk", "43", "user@vk.com", createdAt))
+	identities, err := repositoryTest.List("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []*authServiceModels.Identity{
+		{Provider: "google", Subject: "42", UserId: "1", Mail: "user@gmail.com", CreatedAt: createdAt},
+		{Provider: "vk", Subject: "43", UserId: "1", Mail: "user@vk.com", CreatedAt: createdAt},
+	}, identities)
+
+	mock.ExpectQuery(listQuery).WithArgs("2").WillReturnError(errors.New("test_err"))
+	_, err = repositoryTest.List("2")
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnlink(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	for _, rows := range []int64{1, 0} {
+		mock.ExpectExec(unlinkQuery).WithArgs("1", "vk").WillReturnResult(sqlmock.NewResult(0, rows))
+		ok, err := repositoryTest.Unlink("1", "vk")
+		assert.NoError(t, err)
+		assert.Equal(t, rows == 1, ok)
+	}
+
+	mock.ExpectExec(unlinkQuery).WithArgs("1", "vk").WillReturnError(errors.New("test_err"))
+	_, err := repositoryTest.Unlink("1", "vk")
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,90 @@
+package loginlimit
+
+import (
+	log "backend/pkg/logger"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	logMessage     = "service:loginlimit:repository:"
+	failuresPrefix = "login_failures:"
+	lockPrefix     = "login_lock:"
+	// Lockouts of the last day, every next one is longer
+	lockoutsPrefix = "login_lockouts:"
+)
+
+// Keys are "account:<mail>" or "ip:<address>", the repository does not tell them apart
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func (s *Repository) count(key string, window time.Duration) (int64, error) {
+	count, err := s.db.Incr(key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// The window starts with the first event
+	if count == 1 {
+		err = s.db.Expire(key, window).Err()
+	}
+	return count, err
+}
+
+// CountFailure registers a failed login and returns the number of failures within the window
+func (s *Repository) CountFailure(key string, window time.Duration) (int64, error) {
+	message := logMessage + "CountFailure:"
+	log.Debug(message + "started")
+	count, err := s.count(failuresPrefix+key, window)
+	log.Debug(message + "ended")
+	return count, err
+}
+
+// CountLockout registers a lockout and returns the number of lockouts within the window
+func (s *Repository) CountLockout(key string, window time.Duration) (int64, error) {
+	message := logMessage + "CountLockout:"
+	log.Debug(message + "started")
+	count, err := s.count(lockoutsPrefix+key, window)
+	log.Debug(message + "ended")
+	return count, err
+}
+
+// ResetFailures is called after a successful login, the lockouts are still counted
+func (s *Repository) ResetFailures(key string) error {
+	message := logMessage + "ResetFailures:"
+	log.Debug(message + "started")
+	res := s.db.Del(failuresPrefix + key)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+func (s *Repository) Lock(key string, lifeTime time.Duration) error {
+	message := logMessage + "Lock:"
+	log.Debug(message + "started")
+	res := s.db.Set(lockPrefix+key, "1", lifeTime)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+// LockTime returns how long the key stays locked, 0 if it is not locked
+func (s *Repository) LockTime(key string) (time.Duration, error) {
+	message := logMessage + "LockTime:"
+	log.Debug(message + "started")
+	ttl, err := s.db.TTL(lockPrefix + key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// Negative values mean there is no such key
+	if ttl < 0 {
+		return 0, nil
+	}
+	log.Debug(message + "ended")
+	return ttl, nil
+}
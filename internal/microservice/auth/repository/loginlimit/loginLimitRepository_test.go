@@ -0,0 +1,67 @@
+package loginlimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var client *redis.Client
+
+func TestCountFailure(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Incr", failuresPrefix+"account:test@mail.ru").Return(redis.NewIntResult(1, nil))
+	mock.On("Incr", failuresPrefix+"ip:127.0.0.1").Return(redis.NewIntResult(2, nil))
+	mock.On("Expire", failuresPrefix+"account:test@mail.ru", time.Minute).Return(redis.NewBoolResult(true, nil))
+
+	r := NewRepository(mock)
+	count, err := r.CountFailure("account:test@mail.ru", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	count, err = r.CountFailure("ip:127.0.0.1", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	mock.AssertExpectations(t)
+	mock.AssertNumberOfCalls(t, "Expire", 1)
+}
+
+func TestCountLockout(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Incr", lockoutsPrefix+"account:test@mail.ru").Return(redis.NewIntResult(1, nil))
+	mock.On("Expire", lockoutsPrefix+"account:test@mail.ru", time.Hour).Return(redis.NewBoolResult(true, nil))
+
+	r := NewRepository(mock)
+	count, err := r.CountLockout("account:test@mail.ru", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	mock.AssertExpectations(t)
+}
+
+func TestResetFailures(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Del", []string{failuresPrefix + "account:test@mail.ru"}).Return(redis.NewIntResult(1, nil))
+
+	r := NewRepository(mock)
+	assert.NoError(t, r.ResetFailures("account:test@mail.ru"))
+	mock.AssertExpectations(t)
+}
+
+func TestLock(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", lockPrefix+"account:test@mail.ru", "1", time.Minute).Return(redis.NewStatusResult("", nil))
+	mock.On("TTL", lockPrefix+"account:test@mail.ru").Return(redis.NewDurationResult(time.Minute, nil))
+	mock.On("TTL", lockPrefix+"ip:127.0.0.1").Return(redis.NewDurationResult(-2, nil))
+
+	r := NewRepository(mock)
+	assert.NoError(t, r.Lock("account:test@mail.ru", time.Minute))
+	lockTime, err := r.LockTime("account:test@mail.ru")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, lockTime)
+	lockTime, err = r.LockTime("ip:127.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), lockTime)
+	mock.AssertExpectations(t)
+}
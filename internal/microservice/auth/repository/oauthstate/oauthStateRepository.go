@@ -0,0 +1,73 @@
+package oauthstate
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	log "backend/pkg/logger"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	logMessage  = "service:oauthstate:repository:"
+	statePrefix = "oauth_state:"
+)
+
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func stateKey(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return statePrefix + hex.EncodeToString(sum[:])
+}
+
+func (s *Repository) Save(state string, data *authServiceModels.OAuthState, lifeTime time.Duration) error {
+	message := logMessage + "Save:"
+	log.Debug(message + "started")
+	value, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	res := s.db.Set(stateKey(state), string(value), lifeTime)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+// Use returns the data of the state and deletes it, an unknown or already used state gives nil
+func (s *Repository) Use(state string) (*authServiceModels.OAuthState, error) {
+	message := logMessage + "Use:"
+	log.Debug(message + "started")
+	key := stateKey(state)
+	value, err := s.db.Get(key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Of two concurrent callbacks with the same state only one deletes it
+	deleted, err := s.db.Del(key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, nil
+	}
+	data := &authServiceModels.OAuthState{}
+	err = json.Unmarshal([]byte(value), data)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(message + "ended")
+	return data, nil
+}
@@ -0,0 +1,59 @@
+package oauthstate
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var client *redis.Client
+
+const stateValue = `{"Provider":"vk","Nonce":"nonce","Verifier":"verifier","UserId":"1"}`
+
+var stateData = &authServiceModels.OAuthState{
+	Provider: "vk",
+	Nonce:    "nonce",
+	Verifier: "verifier",
+	UserId:   "1",
+}
+
+func TestSave(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", stateKey("state"), stateValue, time.Minute).Return(redis.NewStatusResult("", nil))
+
+	r := NewRepository(mock)
+	err := r.Save("state", stateData, time.Minute)
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestUse(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Get", stateKey("state")).Return(redis.NewStringResult(stateValue, nil))
+	mock.On("Get", stateKey("unknown")).Return(redis.NewStringResult("", redis.Nil))
+	mock.On("Del", []string{stateKey("state")}).Return(redis.NewIntResult(1, nil))
+
+	r := NewRepository(mock)
+	data, err := r.Use("state")
+	assert.NoError(t, err)
+	assert.Equal(t, stateData, data)
+	data, err = r.Use("unknown")
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+	mock.AssertExpectations(t)
+}
+
+func TestUseConcurrent(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Get", stateKey("state")).Return(redis.NewStringResult(stateValue, nil))
+	mock.On("Del", []string{stateKey("state")}).Return(redis.NewIntResult(0, nil))
+
+	r := NewRepository(mock)
+	data, err := r.Use("state")
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+}
@@ -0,0 +1,93 @@
+package preauth
+
+import (
+	log "backend/pkg/logger"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	logMessage     = "service:preauth:repository:"
+	tokenPrefix    = "pre_auth:"
+	attemptsPrefix = "pre_auth_attempts:"
+	// Time steps of the TOTP codes that have already been used by a user
+	usedStepPrefix = "totp_used:"
+)
+
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func usedStepKey(userId string, step int64) string {
+	return usedStepPrefix + userId + ":" + strconv.FormatInt(step, 10)
+}
+
+// CreateToken stores the user id of a login that waits for the second factor
+func (s *Repository) CreateToken(token, userId string, lifeTime time.Duration) error {
+	message := logMessage + "CreateToken:"
+	log.Debug(message + "started")
+	res := s.db.Set(tokenPrefix+hashToken(token), userId, lifeTime)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+// CheckToken returns "" for an unknown or expired token
+func (s *Repository) CheckToken(token string) (string, error) {
+	message := logMessage + "CheckToken:"
+	log.Debug(message + "started")
+	userId, err := s.db.Get(tokenPrefix + hashToken(token)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	log.Debug(message + "ended")
+	return userId, err
+}
+
+// CountAttempt registers a code entered for the token and returns the number of attempts
+func (s *Repository) CountAttempt(token string, lifeTime time.Duration) (int64, error) {
+	message := logMessage + "CountAttempt:"
+	log.Debug(message + "started")
+	key := attemptsPrefix + hashToken(token)
+	count, err := s.db.Incr(key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		err = s.db.Expire(key, lifeTime).Err()
+	}
+	log.Debug(message + "ended")
+	return count, err
+}
+
+func (s *Repository) DeleteToken(token string) error {
+	message := logMessage + "DeleteToken:"
+	log.Debug(message + "started")
+	hash := hashToken(token)
+	res := s.db.Del(tokenPrefix+hash, attemptsPrefix+hash)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+// UseStep returns false if a code of this time step has already been used by the user
+func (s *Repository) UseStep(userId string, step int64, lifeTime time.Duration) (bool, error) {
+	message := logMessage + "UseStep:"
+	log.Debug(message + "started")
+	res := s.db.SetNX(usedStepKey(userId, step), "1", lifeTime)
+	log.Debug(message + "ended")
+	return res.Result()
+}
@@ -0,0 +1,74 @@
+package preauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var client *redis.Client
+
+func TestCreateToken(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", tokenPrefix+hashToken("token"), "1", time.Minute).Return(redis.NewStatusResult("", nil))
+
+	r := NewRepository(mock)
+	err := r.CreateToken("token", "1", time.Minute)
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestCheckToken(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Get", tokenPrefix+hashToken("token")).Return(redis.NewStringResult("1", nil))
+	mock.On("Get", tokenPrefix+hashToken("expired")).Return(redis.NewStringResult("", redis.Nil))
+
+	r := NewRepository(mock)
+	userId, err := r.CheckToken("token")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", userId)
+	userId, err = r.CheckToken("expired")
+	assert.NoError(t, err)
+	assert.Equal(t, "", userId)
+}
+
+func TestCountAttempt(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	key := attemptsPrefix + hashToken("token")
+	mock.On("Incr", key).Return(redis.NewIntResult(1, nil))
+	mock.On("Expire", key, time.Minute).Return(redis.NewBoolResult(true, nil))
+
+	r := NewRepository(mock)
+	count, err := r.CountAttempt("token", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	mock.AssertExpectations(t)
+}
+
+func TestDeleteToken(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	keys := []string{tokenPrefix + hashToken("token"), attemptsPrefix + hashToken("token")}
+	mock.On("Del", keys).Return(redis.NewIntResult(2, nil))
+
+	r := NewRepository(mock)
+	err := r.DeleteToken("token")
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestUseStep(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("SetNX", usedStepKey("1", 42), "1", time.Minute).Return(redis.NewBoolResult(true, nil)).Once()
+	mock.On("SetNX", usedStepKey("1", 42), "1", time.Minute).Return(redis.NewBoolResult(false, nil)).Once()
+
+	r := NewRepository(mock)
+	ok, err := r.UseStep("1", 42, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = r.UseStep("1", 42, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
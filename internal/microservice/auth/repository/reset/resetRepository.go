@@ -0,0 +1,85 @@
+package reset
+
+import (
+	log "backend/pkg/logger"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-redis/redis"
+	"strings"
+	"time"
+)
+
+const (
+	logMessage  = "service:reset:repository:"
+	tokenPrefix = "password_reset:"
+	limitPrefix = "password_reset_limit:"
+)
+
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// Only the hash of a token is stored, so the keys can not be used to reset a password
+func tokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return tokenPrefix + hex.EncodeToString(sum[:])
+}
+
+func limitKey(mail string) string {
+	return limitPrefix + strings.ToLower(strings.TrimSpace(mail))
+}
+
+func (s *Repository) CreateToken(token, userId string, lifeTime time.Duration) error {
+	message := logMessage + "CreateToken:"
+	log.Debug(message + "started")
+	res := s.db.Set(tokenKey(token), userId, lifeTime)
+	log.Debug(message + "ended")
+	return res.Err()
+}
+
+// UseToken returns the user id of the token and deletes it, an unknown or already used token gives ""
+func (s *Repository) UseToken(token string) (string, error) {
+	message := logMessage + "UseToken:"
+	log.Debug(message + "started")
+	key := tokenKey(token)
+	userId, err := s.db.Get(key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	// Of two concurrent requests with the same token only one deletes it
+	deleted, err := s.db.Del(key).Result()
+	if err != nil {
+		return "", err
+	}
+	if deleted == 0 {
+		return "", nil
+	}
+	log.Debug(message + "ended")
+	return userId, nil
+}
+
+// CountRequest registers a reset request for the mail and returns the number of requests within the window
+func (s *Repository) CountRequest(mail string, window time.Duration) (int64, error) {
+	message := logMessage + "CountRequest:"
+	log.Debug(message + "started")
+	key := limitKey(mail)
+	count, err := s.db.Incr(key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// The window starts with the first request
+	if count == 1 {
+		err = s.db.Expire(key, window).Err()
+	}
+	log.Debug(message + "ended")
+	return count, err
+}
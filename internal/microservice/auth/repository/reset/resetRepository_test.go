@@ -0,0 +1,99 @@
+package reset
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var client *redis.Client
+
+func TestCreateToken(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", tokenKey("token"), "1", time.Minute).Return(redis.NewStatusResult("", nil))
+
+	r := NewRepository(mock)
+	err := r.CreateToken("token", "1", time.Minute)
+	assert.NoError(t, err)
+	// The token itself is not stored
+	assert.NotContains(t, tokenKey("token"), "token")
+	mock.AssertExpectations(t)
+}
+
+var useTokenTests = []struct {
+	id        int
+	getRes    *redis.StringCmd
+	delRes    *redis.IntCmd
+	outputId  string
+	outputErr error
+}{
+	{
+		1,
+		redis.NewStringResult("1", nil),
+		redis.NewIntResult(1, nil),
+		"1",
+		nil,
+	},
+	{
+		2,
+		redis.NewStringResult("", redis.Nil),
+		nil,
+		"",
+		nil,
+	},
+	{
+		3,
+		redis.NewStringResult("1", nil),
+		redis.NewIntResult(0, nil),
+		"",
+		nil,
+	},
+	{
+		4,
+		redis.NewStringResult("", errors.New("test_err")),
+		nil,
+		"",
+		errors.New("test_err"),
+	},
+}
+
+func TestUseToken(t *testing.T) {
+	for _, test := range useTokenTests {
+		mock := redismock.NewNiceMock(client)
+		mock.On("Get", tokenKey("token")).Return(test.getRes)
+		if test.delRes != nil {
+			mock.On("Del", []string{tokenKey("token")}).Return(test.delRes)
+		}
+
+		r := NewRepository(mock)
+		userId, err := r.UseToken("token")
+		assert.Equal(t, test.outputErr, err, test.id)
+		assert.Equal(t, test.outputId, userId, test.id)
+		mock.AssertExpectations(t)
+	}
+}
+
+func TestCountRequest(t *testing.T) {
+	key := limitKey("test@mail.ru")
+	assert.Equal(t, key, limitKey(" Test@Mail.ru"))
+
+	first := redismock.NewNiceMock(client)
+	first.On("Incr", key).Return(redis.NewIntResult(1, nil))
+	first.On("Expire", key, time.Hour).Return(redis.NewBoolResult(true, nil))
+	count, err := NewRepository(first).CountRequest("test@mail.ru", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	first.AssertExpectations(t)
+
+	// The window is not extended by the following requests
+	next := redismock.NewNiceMock(client)
+	next.On("Incr", key).Return(redis.NewIntResult(2, nil))
+	count, err = NewRepository(next).CountRequest("test@mail.ru", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	next.AssertExpectations(t)
+}
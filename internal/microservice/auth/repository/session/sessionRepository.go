@@ -4,9 +4,17 @@ import (
 	authServiceModels "backend/internal/microservice/auth/models"
 	log "backend/pkg/logger"
 	"github.com/go-redis/redis"
+	"strconv"
+	"time"
 )
 
-const logMessage = "service:session:repository:"
+const (
+	logMessage = "service:session:repository:"
+	// Set of the session ids of a user, used to log the user out everywhere
+	userSessionsPrefix = "user_sessions:"
+	// Hash with the user agent, ip and times of a session, lives as long as the session
+	sessionMetaPrefix = "session_meta:"
+)
 
 type Repository struct {
 	db redis.Cmdable
@@ -18,12 +26,62 @@ func NewRepository(database redis.Cmdable) *Repository {
 	}
 }
 
+func userSessionsKey(userId string) string {
+	return userSessionsPrefix + userId
+}
+
+func sessionMetaKey(sessionId string) string {
+	return sessionMetaPrefix + sessionId
+}
+
+func formatUnix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func parseUnix(value string) time.Time {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
 func (s *Repository) Create(data *authServiceModels.SessionData) error {
 	message := logMessage + "Create:"
 	log.Debug(message + "started")
 	res := s.db.Set(data.SessionId, data.UserId, data.Expiration)
+	if res.Err() != nil {
+		return res.Err()
+	}
+	metaKey := sessionMetaKey(data.SessionId)
+	err := s.db.HMSet(metaKey, map[string]interface{}{
+		"user_agent": data.UserAgent,
+		"ip":         data.IP,
+		"created_at": formatUnix(data.CreatedAt),
+		"last_seen":  formatUnix(data.LastSeen),
+		"remember":   strconv.FormatBool(data.Remember),
+		"expires_at": formatUnix(data.ExpiresAt),
+	}).Err()
+	if err != nil {
+		return err
+	}
+	key := userSessionsKey(data.UserId)
+	err = s.db.SAdd(key, data.SessionId).Err()
+	if err != nil {
+		return err
+	}
+	if data.Expiration > 0 {
+		err = s.db.Expire(metaKey, data.Expiration).Err()
+		if err != nil {
+			return err
+		}
+	}
+	// No session outlives its absolute expiration, so the set expires with the newest one
+	if !data.ExpiresAt.IsZero() {
+		err = s.db.ExpireAt(key, data.ExpiresAt).Err()
+	}
 	log.Debug(message + "ended")
-	return res.Err()
+	return err
 }
 
 func (s *Repository) Check(sessionId string) (string, error) {
@@ -34,10 +92,126 @@ func (s *Repository) Check(sessionId string) (string, error) {
 	return res.Val(), res.Err()
 }
 
+// Meta returns nil for sessions created without metadata
+func (s *Repository) Meta(sessionId string) (*authServiceModels.SessionData, error) {
+	meta, err := s.db.HGetAll(sessionMetaKey(sessionId)).Result()
+	if err != nil || len(meta) == 0 {
+		return nil, err
+	}
+	remember, _ := strconv.ParseBool(meta["remember"])
+	return &authServiceModels.SessionData{
+		SessionId: sessionId,
+		UserAgent: meta["user_agent"],
+		IP:        meta["ip"],
+		CreatedAt: parseUnix(meta["created_at"]),
+		LastSeen:  parseUnix(meta["last_seen"]),
+		Remember:  remember,
+		ExpiresAt: parseUnix(meta["expires_at"]),
+	}, nil
+}
+
+// Touch updates the last seen time and prolongs the session by expiration
+func (s *Repository) Touch(sessionId string, lastSeen time.Time, expiration time.Duration) error {
+	metaKey := sessionMetaKey(sessionId)
+	err := s.db.HSet(metaKey, "last_seen", formatUnix(lastSeen)).Err()
+	if err != nil {
+		return err
+	}
+	err = s.db.Expire(metaKey, expiration).Err()
+	if err != nil {
+		return err
+	}
+	return s.db.Expire(sessionId, expiration).Err()
+}
+
+func (s *Repository) List(userId string) ([]*authServiceModels.SessionData, error) {
+	message := logMessage + "List:"
+	log.Debug(message + "started")
+	key := userSessionsKey(userId)
+	sessionIds, err := s.db.SMembers(key).Result()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*authServiceModels.SessionData, 0, len(sessionIds))
+	for _, sessionId := range sessionIds {
+		sessionUserId, err := s.db.Get(sessionId).Result()
+		if err == redis.Nil || (err == nil && sessionUserId != userId) {
+			// The session has expired, its id is left in the set
+			err = s.db.SRem(key, sessionId).Err()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		session, err := s.Meta(sessionId)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			session = &authServiceModels.SessionData{
+				SessionId: sessionId,
+			}
+		}
+		session.UserId = userId
+		result = append(result, session)
+	}
+	log.Debug(message + "ended")
+	return result, nil
+}
+
 func (s *Repository) Delete(sessionId string) error {
 	message := logMessage + "Delete:"
 	log.Debug(message + "started")
-	res := s.db.Del(sessionId)
+	userId, err := s.db.Get(sessionId).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	err = s.db.Del(sessionId, sessionMetaKey(sessionId)).Err()
+	if err != nil {
+		return err
+	}
+	if userId != "" {
+		err = s.db.SRem(userSessionsKey(userId), sessionId).Err()
+	}
+	log.Debug(message + "ended")
+	return err
+}
+
+func (s *Repository) DeleteUserSessions(userId string) error {
+	return s.DeleteOtherSessions(userId, "")
+}
+
+// DeleteOtherSessions deletes all sessions of the user except keep
+func (s *Repository) DeleteOtherSessions(userId, keep string) error {
+	message := logMessage + "DeleteOtherSessions:"
+	log.Debug(message + "started")
+	key := userSessionsKey(userId)
+	sessionIds, err := s.db.SMembers(key).Result()
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, 2*len(sessionIds)+1)
+	members := make([]interface{}, 0, len(sessionIds))
+	for _, sessionId := range sessionIds {
+		if sessionId == keep {
+			continue
+		}
+		keys = append(keys, sessionId, sessionMetaKey(sessionId))
+		members = append(members, sessionId)
+	}
+	if keep == "" {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	err = s.db.Del(keys...).Err()
+	if err == nil && keep != "" && len(members) != 0 {
+		err = s.db.SRem(key, members...).Err()
+	}
 	log.Debug(message + "ended")
-	return res.Err()
+	return err
 }
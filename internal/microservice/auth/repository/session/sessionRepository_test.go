@@ -20,11 +20,25 @@ var (
 )
 
 func TestCreate(t *testing.T) {
-	exp := time.Duration(0)
+	exp := time.Hour
 
 	mock := redismock.NewNiceMock(client)
 
+	now := time.Unix(1637000000, 0)
+
 	mock.On("Set", key, val, exp).Return(redis.NewStatusResult("", nil))
+	mock.On("HMSet", sessionMetaKey(key), map[string]interface{}{
+		"user_agent": "Firefox",
+		"ip":         "127.0.0.1",
+		"created_at": "1637000000",
+		"last_seen":  "1637000000",
+		"remember":   "true",
+		"expires_at": "1639592000",
+	}).Return(redis.NewStatusResult("OK", nil))
+	mock.On("SAdd", userSessionsKey(val), []interface{}{key}).Return(redis.NewIntResult(1, nil))
+	mock.On("Expire", sessionMetaKey(key), exp).Return(redis.NewBoolResult(true, nil))
+	// redismock does not pass the arguments of ExpireAt to the mock
+	mock.On("ExpireAt").Return(redis.NewBoolResult(true, nil))
 
 	r := NewRepository(mock)
 
@@ -32,10 +46,17 @@ func TestCreate(t *testing.T) {
 		SessionId:  key,
 		UserId:     val,
 		Expiration: exp,
+		UserAgent:  "Firefox",
+		IP:         "127.0.0.1",
+		CreatedAt:  now,
+		LastSeen:   now,
+		Remember:   true,
+		ExpiresAt:  now.Add(30 * 24 * time.Hour),
 	}
 
 	err := r.Create(data)
 	assert.NoError(t, err)
+	mock.AssertExpectations(t)
 }
 
 func TestCheck(t *testing.T) {
@@ -49,11 +70,108 @@ func TestCheck(t *testing.T) {
 }
 func TestDelete(t *testing.T) {
 	mock := redismock.NewNiceMock(client)
-	keys := []string{key}
+	keys := []string{key, sessionMetaKey(key)}
+	mock.On("Get", key).Return(redis.NewStringResult(val, nil))
+	mock.On("Del", keys).Return(redis.NewIntResult(1, nil))
+	mock.On("SRem", userSessionsKey(val), []interface{}{key}).Return(redis.NewIntResult(1, nil))
+
+	r := NewRepository(mock)
+
+	err := r.Delete(key)
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestDeleteExpired(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	keys := []string{key, sessionMetaKey(key)}
+	mock.On("Get", key).Return(redis.NewStringResult("", redis.Nil))
 	mock.On("Del", keys).Return(redis.NewIntResult(0, nil))
 
 	r := NewRepository(mock)
 
 	err := r.Delete(key)
 	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestDeleteUserSessions(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("SMembers", userSessionsKey(val)).Return(redis.NewStringSliceResult([]string{"first", "second"}, nil))
+	mock.On("Del", []string{"first", sessionMetaKey("first"), "second", sessionMetaKey("second"), userSessionsKey(val)}).Return(redis.NewIntResult(5, nil))
+
+	r := NewRepository(mock)
+
+	err := r.DeleteUserSessions(val)
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestDeleteOtherSessions(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("SMembers", userSessionsKey(val)).Return(redis.NewStringSliceResult([]string{"first", "second"}, nil))
+	mock.On("Del", []string{"second", sessionMetaKey("second")}).Return(redis.NewIntResult(2, nil))
+	mock.On("SRem", userSessionsKey(val), []interface{}{"second"}).Return(redis.NewIntResult(1, nil))
+
+	r := NewRepository(mock)
+
+	err := r.DeleteOtherSessions(val, "first")
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestTouch(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("HSet", sessionMetaKey(key), "last_seen", "1637000000").Return(redis.NewBoolResult(false, nil))
+	mock.On("Expire", sessionMetaKey(key), time.Hour).Return(redis.NewBoolResult(true, nil))
+	mock.On("Expire", key, time.Hour).Return(redis.NewBoolResult(true, nil))
+
+	r := NewRepository(mock)
+
+	err := r.Touch(key, time.Unix(1637000000, 0), time.Hour)
+	assert.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestList(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("SMembers", userSessionsKey(val)).Return(redis.NewStringSliceResult([]string{"first", "expired"}, nil))
+	mock.On("Get", "first").Return(redis.NewStringResult(val, nil))
+	mock.On("Get", "expired").Return(redis.NewStringResult("", redis.Nil))
+	mock.On("SRem", userSessionsKey(val), []interface{}{"expired"}).Return(redis.NewIntResult(1, nil))
+	mock.On("HGetAll", sessionMetaKey("first")).Return(redis.NewStringStringMapResult(map[string]string{
+		"user_agent": "Firefox",
+		"ip":         "127.0.0.1",
+		"created_at": "1637000000",
+		"last_seen":  "1637000100",
+		"remember":   "true",
+		"expires_at": "1639592000",
+	}, nil))
+
+	r := NewRepository(mock)
+
+	sessions, err := r.List(val)
+	assert.NoError(t, err)
+	assert.Equal(t, []*authServiceModels.SessionData{{
+		SessionId: "first",
+		UserId:    val,
+		UserAgent: "Firefox",
+		IP:        "127.0.0.1",
+		CreatedAt: time.Unix(1637000000, 0),
+		LastSeen:  time.Unix(1637000100, 0),
+		Remember:  true,
+		ExpiresAt: time.Unix(1639592000, 0),
+	}}, sessions)
+	mock.AssertExpectations(t)
+}
+
+func TestMetaMissing(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("HGetAll", sessionMetaKey(key)).Return(redis.NewStringStringMapResult(map[string]string{}, nil))
+
+	r := NewRepository(mock)
+
+	session, err := r.Meta(key)
+	assert.NoError(t, err)
+	assert.Nil(t, session)
 }
@@ -0,0 +1,178 @@
+package twofactor
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	sql2 "database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	logMessage        = "service:auth:repository:twofactor:"
+	getTwoFactorQuery = `select secret, enabled from two_factor where user_id = $1`
+	// A new secret replaces an unconfirmed one, but never an enabled one
+	setSecretQuery = `insert into two_factor (user_id, secret) values ($1, $2)
+		on conflict (user_id) do update set secret = excluded.secret where two_factor.enabled = false`
+	enableQuery              = `update two_factor set enabled = true where user_id = $1 and enabled = false`
+	disableQuery             = `delete from two_factor where user_id = $1`
+	deleteRecoveryCodesQuery = `delete from recovery_code where user_id = $1`
+	addRecoveryCodeQuery     = `insert into recovery_code (user_id, code_hash) values ($1, $2)`
+	useRecoveryCodeQuery     = `delete from recovery_code where user_id = $1 and code_hash = $2`
+)
+
+type TwoFactor struct {
+	Secret  string `db:"secret"`
+	Enabled bool   `db:"enabled"`
+}
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(database *sqlx.DB) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// Get returns nil if the user has never set up two-factor authentication
+func (s *Repository) Get(userId string) (*authServiceModels.TwoFactor, error) {
+	message := logMessage + "Get:"
+	log.Debug(message + "started")
+	twoFactor := TwoFactor{}
+	err := s.db.Get(&twoFactor, getTwoFactorQuery, userId)
+	if err == sql2.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &authServiceModels.TwoFactor{
+		UserId:  userId,
+		Secret:  twoFactor.Secret,
+		Enabled: twoFactor.Enabled,
+	}, nil
+}
+
+// SetSecret returns false if two-factor authentication is already enabled
+func (s *Repository) SetSecret(userId, secret string) (bool, error) {
+	message := logMessage + "SetSecret:"
+	log.Debug(message + "started")
+	res, err := s.db.Exec(setSecretQuery, userId, secret)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return rows == 1, nil
+}
+
+func (s *Repository) replaceRecoveryCodes(tx *sqlx.Tx, userId string, codeHashes []string) error {
+	_, err := tx.Exec(deleteRecoveryCodesQuery, userId)
+	if err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		_, err = tx.Exec(addRecoveryCodeQuery, userId, codeHash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enable turns two-factor authentication on together with the recovery codes, false means it is already enabled
+func (s *Repository) Enable(userId string, codeHashes []string) (bool, error) {
+	message := logMessage + "Enable:"
+	log.Debug(message + "started")
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(enableQuery, userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	if rows == 0 {
+		return false, nil
+	}
+	err = s.replaceRecoveryCodes(tx, userId, codeHashes)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return true, nil
+}
+
+// Disable deletes the secret, the recovery codes are deleted with it
+func (s *Repository) Disable(userId string) error {
+	message := logMessage + "Disable:"
+	log.Debug(message + "started")
+	_, err := s.db.Exec(disableQuery, userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+func (s *Repository) ReplaceRecoveryCodes(userId string, codeHashes []string) error {
+	message := logMessage + "ReplaceRecoveryCodes:"
+	log.Debug(message + "started")
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	err = s.replaceRecoveryCodes(tx, userId, codeHashes)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+// UseRecoveryCode deletes the code, so of two concurrent logins with the same code only one succeeds
+func (s *Repository) UseRecoveryCode(userId, codeHash string) (bool, error) {
+	message := logMessage + "UseRecoveryCode:"
+	log.Debug(message + "started")
+	res, err := s.db.Exec(useRecoveryCodeQuery, userId, codeHash)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return false, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return rows == 1, nil
+}
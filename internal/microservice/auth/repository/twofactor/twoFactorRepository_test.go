@@ -0,0 +1,111 @@
+package twofactor
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	error2 "backend/internal/service/auth/error"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRepositoryTest(t *testing.T) (*Repository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return NewRepository(sqlx.NewDb(db, "sqlmock")), mock
+}
+
+func TestGet(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	mock.ExpectQuery(getTwoFactorQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled"}).AddRow("secret", true))
+	twoFactor, err := repositoryTest.Get("1")
+	assert.NoError(t, err)
+	assert.Equal(t, &authServiceModels.TwoFactor{UserId: "1", Secret: "secret", Enabled: true}, twoFactor)
+
+	mock.ExpectQuery(getTwoFactorQuery).WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled"}))
+	twoFactor, err = repositoryTest.Get("2")
+	assert.NoError(t, err)
+	assert.Nil(t, twoFactor)
+
+	mock.ExpectQuery(getTwoFactorQuery).WithArgs("3").WillReturnError(errors.New("test_err"))
+	_, err = repositoryTest.Get("3")
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetSecret(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	for _, rows := range []int64{1, 0} {
+		mock.ExpectExec(setSecretQuery).WithArgs("1", "secret").
+			WillReturnResult(sqlmock.NewResult(0, rows))
+		ok, err := repositoryTest.SetSecret("1", "secret")
+		assert.NoError(t, err)
+		assert.Equal(t, rows == 1, ok)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnable(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(enableQuery).WithArgs("1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteRecoveryCodesQuery).WithArgs("1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(addRecoveryCodeQuery).WithArgs("1", "first").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(addRecoveryCodeQuery).WithArgs("1", "second").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	ok, err := repositoryTest.Enable("1", []string{"first", "second"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(enableQuery).WithArgs("1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	ok, err = repositoryTest.Enable("1", []string{"first"})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaceRecoveryCodes(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteRecoveryCodesQuery).WithArgs("1").WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec(addRecoveryCodeQuery).WithArgs("1", "first").WillReturnError(errors.New("test_err"))
+	mock.ExpectRollback()
+	err := repositoryTest.ReplaceRecoveryCodes("1", []string{"first"})
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDisable(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	mock.ExpectExec(disableQuery).WithArgs("1").WillReturnResult(sqlmock.NewResult(0, 1))
+	err := repositoryTest.Disable("1")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	repositoryTest, mock := newRepositoryTest(t)
+
+	for _, rows := range []int64{1, 0} {
+		mock.ExpectExec(useRecoveryCodeQuery).WithArgs("1", "hash").
+			WillReturnResult(sqlmock.NewResult(0, rows))
+		ok, err := repositoryTest.UseRecoveryCode("1", "hash")
+		assert.NoError(t, err)
+		assert.Equal(t, rows == 1, ok)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -13,6 +13,8 @@ type User struct {
 	Password string `db:"password"`
 	About    string `db:"about"`
 	ImgUrl   string `db:"img_url"`
+	Verified bool   `db:"verified"`
+	Role     string `db:"role"`
 }
 
 func toPostgresUser(u *models.User) *User {
@@ -35,5 +37,7 @@ func toModelUser(u *User) *models.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
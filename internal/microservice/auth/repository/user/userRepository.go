@@ -10,9 +10,16 @@ import (
 )
 
 const (
-	logMessage      = "service:auth:repository:postgres:"
-	createUserQuery = `insert into "user" (name, surname, mail, password, about) values($1, $2, $3, $4, $5) returning id`
-	getUserQuery    = `select * from "user" where mail = $1 and password = $2`
+	logMessage       = "service:auth:repository:postgres:"
+	createUserQuery  = `insert into "user" (name, surname, mail, password, about) values($1, $2, $3, $4, $5) returning id`
+	getUserQuery     = `select * from "user" where mail = $1`
+	getUserByIdQuery = `select * from "user" where id = $1`
+	getUserRoleQuery = `select role from "user" where id = $1`
+	// Mail is compared too, so a link sent to an old address does not verify a new one
+	verifyUserQuery = `update "user" set verified = true where id = $1 and mail = $2`
+	// Password is compared too, so a concurrent password change is not overwritten by the rehash
+	updatePasswordQuery = `update "user" set password = $1 where id = $2 and password = $3`
+	resetPasswordQuery  = `update "user" set password = $1 where id = $2`
 )
 
 type Repository struct {
@@ -39,10 +46,10 @@ func (s *Repository) CreateUser(user *models.User) (string, error) {
 	return strconv.Itoa(userId), nil
 }
 
-func (s *Repository) GetUser(mail, password string) (*models.User, error) {
+func (s *Repository) GetUser(mail string) (*models.User, error) {
 	query := getUserQuery
 	user := User{}
-	err := s.db.Get(&user, query, mail, password)
+	err := s.db.Get(&user, query, mail)
 	if err != nil {
 		if err == sql2.ErrNoRows {
 			return nil, error2.ErrUserNotFound
@@ -51,3 +58,61 @@ func (s *Repository) GetUser(mail, password string) (*models.User, error) {
 	}
 	return toModelUser(&user), nil
 }
+
+func (s *Repository) UpdatePassword(userId, oldHash, newHash string) error {
+	query := updatePasswordQuery
+	_, err := s.db.Exec(query, newHash, userId, oldHash)
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) ResetPassword(userId, hash string) error {
+	query := resetPasswordQuery
+	_, err := s.db.Exec(query, hash, userId)
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) GetUserById(userId string) (*models.User, error) {
+	query := getUserByIdQuery
+	user := User{}
+	err := s.db.Get(&user, query, userId)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrUserNotFound
+		}
+		return nil, error2.ErrPostgres
+	}
+	return toModelUser(&user), nil
+}
+
+// GetUserRole reads only the role, it is called on every session check
+func (s *Repository) GetUserRole(userId string) (string, error) {
+	query := getUserRoleQuery
+	var role string
+	err := s.db.Get(&role, query, userId)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return "", error2.ErrUserNotFound
+		}
+		return "", error2.ErrPostgres
+	}
+	return role, nil
+}
+
+func (s *Repository) VerifyUser(userId, mail string) (bool, error) {
+	query := verifyUserQuery
+	result, err := s.db.Exec(query, userId, mail)
+	if err != nil {
+		return false, error2.ErrPostgres
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, error2.ErrPostgres
+	}
+	return rowsAffected != 0, nil
+}
@@ -2,6 +2,8 @@ package user
 
 import (
 	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
@@ -65,7 +67,6 @@ func TestCreateUser(t *testing.T) {
 var getUserTests = []struct {
 	id          int
 	mail        string
-	password    string
 	postgresErr error
 	outputUser  *models.User
 	outputErr   error
@@ -73,7 +74,6 @@ var getUserTests = []struct {
 	{
 		1,
 		"testMail",
-		"testPassword",
 		nil,
 		&models.User{
 			ID:       "1",
@@ -88,7 +88,6 @@ var getUserTests = []struct {
 	{
 		2,
 		"testMail",
-		"testPassword",
 		errors.New("internal DB server error"),
 		&models.User{
 			ID:       "1",
@@ -103,7 +102,6 @@ var getUserTests = []struct {
 	{
 		3,
 		"testMail",
-		"testPassword",
 		errors.New("test error"),
 		&models.User{
 			ID:       "1",
@@ -126,8 +124,7 @@ func TestGetUser(t *testing.T) {
 
 	for _, test := range getUserTests {
 		mock.ExpectQuery(getUserQuery).WithArgs(
-			test.mail,
-			test.password).
+			test.mail).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "surname", "mail", "password", "about"}).
 				AddRow(test.outputUser.ID,
 					test.outputUser.Name,
@@ -137,7 +134,7 @@ func TestGetUser(t *testing.T) {
 					test.outputUser.About)).
 			WillReturnError(test.postgresErr)
 
-		actualUser, actualErr := repositoryTest.GetUser(test.mail, test.password)
+		actualUser, actualErr := repositoryTest.GetUser(test.mail)
 		assert.Equal(t, test.outputErr, actualErr)
 		var expectedUser *models.User
 		if test.outputErr != nil {
@@ -148,3 +145,168 @@ func TestGetUser(t *testing.T) {
 		assert.Equal(t, expectedUser, actualUser)
 	}
 }
+
+var updatePasswordTests = []struct {
+	id          int
+	userId      string
+	oldHash     string
+	newHash     string
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"oldHash",
+		"newHash",
+		nil,
+		nil,
+	},
+	{
+		2,
+		"1",
+		"oldHash",
+		"newHash",
+		errors.New("test error"),
+		errors.New("internal DB server error"),
+	},
+}
+
+func TestUpdatePassword(t *testing.T) {
+	for _, test := range updatePasswordTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		mock.ExpectExec(updatePasswordQuery).WithArgs(
+			test.newHash,
+			test.userId,
+			test.oldHash).
+			WillReturnResult(sqlmock.NewResult(0, 1)).
+			WillReturnError(test.postgresErr)
+
+		actualErr := repositoryTest.UpdatePassword(test.userId, test.oldHash, test.newHash)
+		assert.Equal(t, test.outputErr, actualErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	for _, test := range updatePasswordTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		mock.ExpectExec(resetPasswordQuery).WithArgs(
+			test.newHash,
+			test.userId).
+			WillReturnResult(sqlmock.NewResult(0, 1)).
+			WillReturnError(test.postgresErr)
+
+		actualErr := repositoryTest.ResetPassword(test.userId, test.newHash)
+		assert.Equal(t, test.outputErr, actualErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
+
+func TestGetUserById(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	mock.ExpectQuery(getUserByIdQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "surname", "mail", "password", "about", "verified"}).
+			AddRow(1, "testName", "testSurname", "testMail", "testPassword", "testAbout", true))
+	mock.ExpectQuery(getUserByIdQuery).WithArgs("2").WillReturnError(sql.ErrNoRows)
+
+	actualUser, actualErr := repositoryTest.GetUserById("1")
+	assert.NoError(t, actualErr)
+	assert.Equal(t, &models.User{
+		ID:       "1",
+		Name:     "testName",
+		Surname:  "testSurname",
+		Mail:     "testMail",
+		Password: "testPassword",
+		About:    "testAbout",
+		Verified: true,
+	}, actualUser)
+
+	_, actualErr = repositoryTest.GetUserById("2")
+	assert.Equal(t, error2.ErrUserNotFound, actualErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserRole(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	mock.ExpectQuery(getUserRoleQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow(models.RoleModerator))
+	mock.ExpectQuery(getUserRoleQuery).WithArgs("2").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(getUserRoleQuery).WithArgs("3").WillReturnError(errors.New("test error"))
+
+	actualRole, actualErr := repositoryTest.GetUserRole("1")
+	assert.NoError(t, actualErr)
+	assert.Equal(t, models.RoleModerator, actualRole)
+
+	_, actualErr = repositoryTest.GetUserRole("2")
+	assert.Equal(t, error2.ErrUserNotFound, actualErr)
+	_, actualErr = repositoryTest.GetUserRole("3")
+	assert.Equal(t, error2.ErrPostgres, actualErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+var verifyUserTests = []struct {
+	id           int
+	rowsAffected int64
+	postgresErr  error
+	output       bool
+	outputErr    error
+}{
+	{
+		1,
+		1,
+		nil,
+		true,
+		nil,
+	},
+	{
+		2,
+		0,
+		nil,
+		false,
+		nil,
+	},
+	{
+		3,
+		0,
+		errors.New("test error"),
+		false,
+		errors.New("internal DB server error"),
+	},
+}
+
+func TestVerifyUser(t *testing.T) {
+	for _, test := range verifyUserTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err, logMessage, err)
+		repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+		mock.ExpectExec(verifyUserQuery).WithArgs("1", "testMail").
+			WillReturnResult(sqlmock.NewResult(0, test.rowsAffected)).
+			WillReturnError(test.postgresErr)
+
+		actual, actualErr := repositoryTest.VerifyUser("1", "testMail")
+		assert.Equal(t, test.outputErr, actualErr, test.id)
+		assert.Equal(t, test.output, actual, test.id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
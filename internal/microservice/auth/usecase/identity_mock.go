@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type AuthIdentityMock struct {
+	mock.Mock
+}
+
+func (m *AuthIdentityMock) Get(provider, subject string) (*authServiceModels.Identity, error) {
+	args := m.Called(provider, subject)
+	return args.Get(0).(*authServiceModels.Identity), args.Error(1)
+}
+
+func (m *AuthIdentityMock) Link(identity *authServiceModels.Identity) error {
+	args := m.Called(identity)
+	return args.Error(0)
+}
+
+func (m *AuthIdentityMock) List(userId string) ([]*authServiceModels.Identity, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*authServiceModels.Identity), args.Error(1)
+}
+
+func (m *AuthIdentityMock) Unlink(userId, provider string) (bool, error) {
+	args := m.Called(userId, provider)
+	return args.Bool(0), args.Error(1)
+}
+
+type AuthOAuthStateMock struct {
+	mock.Mock
+}
+
+func (m *AuthOAuthStateMock) Save(state string, data *authServiceModels.OAuthState, lifeTime time.Duration) error {
+	args := m.Called(state, data, lifeTime)
+	return args.Error(0)
+}
+
+func (m *AuthOAuthStateMock) Use(state string) (*authServiceModels.OAuthState, error) {
+	args := m.Called(state)
+	return args.Get(0).(*authServiceModels.OAuthState), args.Error(1)
+}
@@ -2,47 +2,114 @@ package usecase
 
 import (
 	protoAuth "backend/internal/microservice/auth/proto"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
 	"context"
-	"github.com/dgrijalva/jwt-go/v4"
+	"crypto/hmac"
+	"errors"
 	"os"
+	"strings"
 	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
 )
 
-func generateCsrfToken(userId string) (string, error) {
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
-		ID:        userId,
-		ExpiresAt: jwt.At(time.Now().Add(time.Hour * 7 * 24)), //Week  P.S. Maybe Frontend should ask us
-	})
-	secretWord := os.Getenv("CSRFSECRET")
-	csrfToken, err := jwtToken.SignedString([]byte(secretWord))
+const (
+	csrfLifeTime = time.Hour * 7 * 24 //Week  P.S. Maybe Frontend should ask us
+	// Key id of CSRFSECRET, used when CSRF_KEYS is not set
+	defaultCsrfKeyId = "default"
+)
+
+var ErrCsrfKeys = errors.New("csrf keys are not set")
+
+type csrfKey struct {
+	id     string
+	secret []byte
+}
+
+type csrfClaims struct {
+	jwt.StandardClaims
+	// The token is sent to scripts, so it keeps a hash of the session id and not the cookie value
+	Session string `json:"sid"`
+}
+
+// csrfKeys reads CSRF_KEYS as "id:secret,id:secret". The first key signs new tokens, the others
+// are only accepted, so a key can be replaced without invalidating the tokens signed with the old one.
+func csrfKeys() ([]csrfKey, error) {
+	var keys []csrfKey
+	for _, pair := range strings.Split(os.Getenv("CSRF_KEYS"), ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		keys = append(keys, csrfKey{id: parts[0], secret: []byte(parts[1])})
+	}
+	if len(keys) == 0 {
+		if secretWord := os.Getenv("CSRFSECRET"); secretWord != "" {
+			keys = append(keys, csrfKey{id: defaultCsrfKeyId, secret: []byte(secretWord)})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, ErrCsrfKeys
+	}
+	return keys, nil
+}
+
+func generateCsrfToken(userId, sessionId string) (string, error) {
+	keys, err := csrfKeys()
 	if err != nil {
 		return "", err
 	}
-	return csrfToken, err
+	now := time.Now()
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &csrfClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   userId,
+			IssuedAt:  jwt.At(now),
+			ExpiresAt: jwt.At(now.Add(csrfLifeTime)),
+		},
+		Session: publicSessionId(sessionId),
+	})
+	jwtToken.Header["kid"] = keys[0].id
+	return jwtToken.SignedString(keys[0].secret)
 }
 
-func parseToken(susToken string, signingKey []byte) (string, error) {
-	token, err := jwt.ParseWithClaims(susToken, &jwt.StandardClaims{}, func(token *jwt.Token) (interface{}, error) {
+// parseToken returns the claims of a token signed with one of the active keys
+func parseToken(susToken string, keys []csrfKey) (*csrfClaims, error) {
+	token, err := jwt.ParseWithClaims(susToken, &csrfClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, jwt.ErrHashUnavailable
 		}
-		return signingKey, nil
+		keyId, _ := token.Header["kid"].(string)
+		for _, key := range keys {
+			if key.id == keyId {
+				return key.secret, nil
+			}
+		}
+		return nil, jwt.ErrHashUnavailable
 	})
-
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	if claims, ok := token.Claims.(*jwt.StandardClaims); ok && token.Valid {
-		if claims.ExpiresAt.Before(time.Now()) {
-			return "", jwt.ErrHashUnavailable
-		}
-		return claims.ID, nil
+	claims, ok := token.Claims.(*csrfClaims)
+	if !ok || !token.Valid || claims.ExpiresAt == nil || claims.ExpiresAt.Before(time.Now()) {
+		return nil, jwt.ErrHashUnavailable
 	}
-	return "", jwt.ErrHashUnavailable
+	return claims, nil
 }
 
-func (s *authService) CreateToken(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.CSRFToken, error) {
-	csrfToken, err := generateCsrfToken(protoUserId.ID)
+// CreateToken gives a token for the session, a new session always gets a new token
+func (s *authService) CreateToken(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.CSRFToken, error) {
+	if protoSession.Session == "" {
+		return &protoAuth.CSRFToken{}, error2.ErrEmptySessionId
+	}
+	userId, err := s.authSessionRepository.Check(protoSession.Session)
+	if err != nil {
+		if strings.Contains(err.Error(), "redis: nil") {
+			return &protoAuth.CSRFToken{}, error2.ErrSessionNotFound
+		}
+		return &protoAuth.CSRFToken{}, err
+	}
+	csrfToken, err := generateCsrfToken(userId, protoSession.Session)
 	if err != nil {
 		return &protoAuth.CSRFToken{}, err
 	}
@@ -52,15 +119,35 @@ func (s *authService) CreateToken(ctx context.Context, protoUserId *protoAuth.Us
 	return response, err
 }
 
+// CheckToken accepts the token only together with the session it was given for,
+// so the token stops working when the user logs out or the session is revoked
 func (s *authService) CheckToken(ctx context.Context, protoToken *protoAuth.CSRFToken) (*protoAuth.UserId, error) {
-	secretWord := os.Getenv("CSRFSECRET")
-	susToken := protoToken.CSRFToken
-	userId, err := parseToken(susToken, []byte(secretWord))
+	message := logMessage + "CheckToken:"
+	keys, err := csrfKeys()
 	if err != nil {
 		return &protoAuth.UserId{}, err
 	}
+	claims, err := parseToken(protoToken.CSRFToken, keys)
+	if err != nil {
+		log.Debug(message+"err =", err)
+		return &protoAuth.UserId{}, error2.ErrCSRFToken
+	}
+	if protoToken.Session == "" ||
+		!hmac.Equal([]byte(claims.Session), []byte(publicSessionId(protoToken.Session))) {
+		return &protoAuth.UserId{}, error2.ErrCSRFToken
+	}
+	userId, err := s.authSessionRepository.Check(protoToken.Session)
+	if err != nil {
+		if strings.Contains(err.Error(), "redis: nil") {
+			return &protoAuth.UserId{}, error2.ErrCSRFToken
+		}
+		return &protoAuth.UserId{}, err
+	}
+	if userId != claims.Subject {
+		return &protoAuth.UserId{}, error2.ErrCSRFToken
+	}
 	response := &protoAuth.UserId{
 		ID: userId,
 	}
-	return response, err
+	return response, nil
 }
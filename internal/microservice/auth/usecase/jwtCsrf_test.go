@@ -2,37 +2,173 @@ package usecase
 
 import (
 	protoAuth "backend/internal/microservice/auth/proto"
+	error2 "backend/internal/service/auth/error"
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var errRedisNil = errors.New("redis: nil")
+
 func TestCreateToken(t *testing.T) {
+	t.Setenv("CSRF_KEYS", "2:new_secret,1:old_secret")
+	sessionMock := new(AuthSessionMock)
+	sessionMock.On("Check", "session").Return("1", nil)
+	sessionMock.On("Check", "deleted").Return("", errRedisNil)
+	useCaseTest := NewService(nil, sessionMock, nil, nil, nil, nil, nil, nil, nil)
 
-	useCaseTest := NewService(nil, nil)
+	out, err := useCaseTest.CreateToken(context.Background(), &protoAuth.Session{Session: "session"})
+	require.NoError(t, err)
+	token, _, err := new(jwt.Parser).ParseUnverified(out.CSRFToken, &csrfClaims{})
+	require.NoError(t, err)
+	// New tokens are signed with the first key
+	assert.Equal(t, "2", token.Header["kid"])
+	claims := token.Claims.(*csrfClaims)
+	assert.Equal(t, "1", claims.Subject)
+	assert.Equal(t, publicSessionId("session"), claims.Session)
 
-	ctx := context.Background()
-	protoUserId := &protoAuth.UserId{
-		ID: "1",
-	}
-	_, err := useCaseTest.CreateToken(ctx, protoUserId)
-	assert.NoError(t, err)
+	_, err = useCaseTest.CreateToken(context.Background(), &protoAuth.Session{Session: "deleted"})
+	assert.Equal(t, error2.ErrSessionNotFound, err)
+	_, err = useCaseTest.CreateToken(context.Background(), &protoAuth.Session{})
+	assert.Equal(t, error2.ErrEmptySessionId, err)
+}
+
+func TestCreateTokenWithoutKeys(t *testing.T) {
+	t.Setenv("CSRF_KEYS", "")
+	t.Setenv("CSRFSECRET", "")
+	sessionMock := new(AuthSessionMock)
+	sessionMock.On("Check", "session").Return("1", nil)
+	useCaseTest := NewService(nil, sessionMock, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := useCaseTest.CreateToken(context.Background(), &protoAuth.Session{Session: "session"})
+	assert.Equal(t, ErrCsrfKeys, err)
+}
+
+// signToken makes a token the way generateCsrfToken does, but with any key and expiration
+func signToken(t *testing.T, keyId, secret, userId, sessionId string, expiresAt time.Time) string {
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &csrfClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   userId,
+			ExpiresAt: jwt.At(expiresAt),
+		},
+		Session: publicSessionId(sessionId),
+	})
+	jwtToken.Header["kid"] = keyId
+	token, err := jwtToken.SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
 }
 
 func TestCheckToken(t *testing.T) {
-	useCaseTest := NewService(nil, nil)
+	week := time.Now().Add(csrfLifeTime)
+	tests := []struct {
+		name      string
+		token     string
+		session   string
+		outputId  string
+		outputErr error
+	}{
+		{
+			name:     "Valid",
+			token:    signToken(t, "2", "new_secret", "1", "session", week),
+			session:  "session",
+			outputId: "1",
+		},
+		{
+			name:     "Signed with the previous key",
+			token:    signToken(t, "1", "old_secret", "1", "session", week),
+			session:  "session",
+			outputId: "1",
+		},
+		{
+			name:      "Signed with a removed key",
+			token:     signToken(t, "0", "removed_secret", "1", "session", week),
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Wrong secret for the key id",
+			token:     signToken(t, "2", "old_secret", "1", "session", week),
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Another session of the user",
+			token:     signToken(t, "2", "new_secret", "1", "other", week),
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Deleted session",
+			token:     signToken(t, "2", "new_secret", "1", "deleted", week),
+			session:   "deleted",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Another user",
+			token:     signToken(t, "2", "new_secret", "2", "session", week),
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Expired",
+			token:     signToken(t, "2", "new_secret", "1", "session", time.Now().Add(-time.Minute)),
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "No session cookie",
+			token:     signToken(t, "2", "new_secret", "1", "session", week),
+			outputErr: error2.ErrCSRFToken,
+		},
+		{
+			name:      "Not a token",
+			token:     "token",
+			session:   "session",
+			outputErr: error2.ErrCSRFToken,
+		},
+	}
 
-	ctx := context.Background()
-	userId := "1"
-	csrfToken, err := generateCsrfToken(userId)
-	assert.NoError(t, err)
+	t.Setenv("CSRF_KEYS", "2:new_secret, 1:old_secret")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessionMock := new(AuthSessionMock)
+			sessionMock.On("Check", "session").Return("1", nil)
+			sessionMock.On("Check", "deleted").Return("", errRedisNil)
+			useCaseTest := NewService(nil, sessionMock, nil, nil, nil, nil, nil, nil, nil)
 
-	protoCSRF := &protoAuth.CSRFToken{
-		CSRFToken: csrfToken,
+			out, err := useCaseTest.CheckToken(context.Background(), &protoAuth.CSRFToken{
+				CSRFToken: test.token,
+				Session:   test.session,
+			})
+			assert.Equal(t, test.outputErr, err)
+			assert.Equal(t, test.outputId, out.ID)
+		})
 	}
-	protoUserId, err := useCaseTest.CheckToken(ctx, protoCSRF)
+}
+
+func TestCheckTokenRoundTrip(t *testing.T) {
+	t.Setenv("CSRF_KEYS", "")
+	t.Setenv("CSRFSECRET", "secret")
+	sessionMock := new(AuthSessionMock)
+	sessionMock.On("Check", "session").Return("1", nil).Once()
+	sessionMock.On("Check", "session").Return("1", nil).Once()
+	// The user has logged out
+	sessionMock.On("Check", "session").Return("", errRedisNil).Once()
+	useCaseTest := NewService(nil, sessionMock, nil, nil, nil, nil, nil, nil, nil)
 
-	assert.Equal(t, "1", protoUserId.ID)
+	token, err := useCaseTest.CreateToken(context.Background(), &protoAuth.Session{Session: "session"})
+	require.NoError(t, err)
+	in := &protoAuth.CSRFToken{CSRFToken: token.CSRFToken, Session: "session"}
+	out, err := useCaseTest.CheckToken(context.Background(), in)
 	assert.NoError(t, err)
+	assert.Equal(t, "1", out.ID)
+	_, err = useCaseTest.CheckToken(context.Background(), in)
+	assert.Equal(t, error2.ErrCSRFToken, err)
+	sessionMock.AssertExpectations(t)
 }
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// Failed logins are counted per account and per IP within the window
+	defaultLoginWindow = 15 * time.Minute
+	// After the free attempts every failure delays the next attempt, starting with loginDelay
+	defaultLoginFreeAttempts = 3
+	loginDelay               = time.Second
+	// The account is locked after max attempts, every next lockout within a day is twice as long
+	defaultLoginMaxAttempts = 10
+	defaultLoginLockout     = 15 * time.Minute
+	defaultLoginMaxLockout  = 24 * time.Hour
+	loginLockoutWindow      = 24 * time.Hour
+	// Many users can share an address, so the IP limit is much higher
+	defaultLoginIPMaxAttempts = 100
+	defaultLoginIPLockout     = 15 * time.Minute
+	loginLockedEmailTheme     = "Вход в аккаунт заблокирован"
+)
+
+func intOrDefault(key string, value int64) int64 {
+	if i := viper.GetInt64(key); i > 0 {
+		return i
+	}
+	return value
+}
+
+func accountKey(mail string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(mail))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+func loginDelayTime(failures, freeAttempts int64, maxDelay time.Duration) time.Duration {
+	delay := loginDelay
+	for i := freeAttempts + 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+func lockoutTime(lockouts int64) time.Duration {
+	lockout := durationOrDefault("login_limit.lockout", defaultLoginLockout)
+	maxLockout := durationOrDefault("login_limit.max_lockout", defaultLoginMaxLockout)
+	for i := int64(1); i < lockouts && lockout < maxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > maxLockout {
+		return maxLockout
+	}
+	return lockout
+}
+
+func lockTimeText(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d ч.", d/time.Hour)
+	}
+	return fmt.Sprintf("%d мин.", (d+time.Minute-1)/time.Minute)
+}
+
+// checkLoginLock is called before the password is checked, so a locked login can not be guessed
+func (s *authService) checkLoginLock(mail, ip string) error {
+	keys := []string{accountKey(mail)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	var lockTime time.Duration
+	for _, key := range keys {
+		keyLockTime, err := s.authLoginLimitRepository.LockTime(key)
+		if err != nil {
+			return err
+		}
+		if keyLockTime > lockTime {
+			lockTime = keyLockTime
+		}
+	}
+	if lockTime > 0 {
+		return error2.LoginLocked(lockTime)
+	}
+	return nil
+}
+
+// loginFailed counts the failure and returns the lock error if the login is locked because of it.
+// u is nil for an unknown mail, the attempts are counted the same way.
+func (s *authService) loginFailed(u *models.User, mail, ip string) error {
+	lockTime, err := s.countAccountFailure(u, mail)
+	if err != nil {
+		return err
+	}
+	if ip != "" {
+		ipLockTime, err := s.countIPFailure(ip)
+		if err != nil {
+			return err
+		}
+		if ipLockTime > lockTime {
+			lockTime = ipLockTime
+		}
+	}
+	if lockTime > 0 {
+		return error2.LoginLocked(lockTime)
+	}
+	return nil
+}
+
+func (s *authService) countAccountFailure(u *models.User, mail string) (time.Duration, error) {
+	key := accountKey(mail)
+	failures, err := s.authLoginLimitRepository.CountFailure(key, durationOrDefault("login_limit.window", defaultLoginWindow))
+	if err != nil {
+		return 0, err
+	}
+	freeAttempts := intOrDefault("login_limit.free_attempts", defaultLoginFreeAttempts)
+	if failures <= freeAttempts {
+		return 0, nil
+	}
+	if failures < intOrDefault("login_limit.max_attempts", defaultLoginMaxAttempts) {
+		delay := loginDelayTime(failures, freeAttempts, durationOrDefault("login_limit.lockout", defaultLoginLockout))
+		return delay, s.authLoginLimitRepository.Lock(key, delay)
+	}
+	lockouts, err := s.authLoginLimitRepository.CountLockout(key, loginLockoutWindow)
+	if err != nil {
+		return 0, err
+	}
+	lockout := lockoutTime(lockouts)
+	err = s.authLoginLimitRepository.Lock(key, lockout)
+	if err != nil {
+		return 0, err
+	}
+	// The attempts start over after the lockout
+	err = s.authLoginLimitRepository.ResetFailures(key)
+	if err != nil {
+		return 0, err
+	}
+	if u != nil {
+		s.sendLoginLocked(u, lockout)
+	}
+	return lockout, nil
+}
+
+func (s *authService) countIPFailure(ip string) (time.Duration, error) {
+	key := ipKey(ip)
+	failures, err := s.authLoginLimitRepository.CountFailure(key, durationOrDefault("login_limit.window", defaultLoginWindow))
+	if err != nil {
+		return 0, err
+	}
+	if failures < intOrDefault("login_limit.ip_max_attempts", defaultLoginIPMaxAttempts) {
+		return 0, nil
+	}
+	lockout := durationOrDefault("login_limit.ip_lockout", defaultLoginIPLockout)
+	err = s.authLoginLimitRepository.Lock(key, lockout)
+	if err != nil {
+		return 0, err
+	}
+	err = s.authLoginLimitRepository.ResetFailures(key)
+	if err != nil {
+		return 0, err
+	}
+	return lockout, nil
+}
+
+// loginSucceeded forgets the failures of the account, the failures of the IP are kept,
+// otherwise an attacker could reset them by logging in to their own account
+func (s *authService) loginSucceeded(mail string) {
+	err := s.authLoginLimitRepository.ResetFailures(accountKey(mail))
+	if err != nil {
+		log.Error(logMessage+"loginSucceeded:err =", err)
+	}
+}
+
+// sendLoginLocked tells the owner that someone is guessing the password
+func (s *authService) sendLoginLocked(u *models.User, lockout time.Duration) {
+	receiver := &models.Info{
+		Name:  u.Name,
+		Mail:  u.Mail,
+		Title: lockTimeText(lockout),
+	}
+	go s.sendEmail(loginLockedEmailTheme, viper.GetString("login_locked_html"), []*models.Info{receiver})
+}
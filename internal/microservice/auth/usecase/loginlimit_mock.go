@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type AuthLoginLimitMock struct {
+	mock.Mock
+}
+
+func (m *AuthLoginLimitMock) CountFailure(key string, window time.Duration) (int64, error) {
+	args := m.Called(key, window)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *AuthLoginLimitMock) CountLockout(key string, window time.Duration) (int64, error) {
+	args := m.Called(key, window)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *AuthLoginLimitMock) ResetFailures(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *AuthLoginLimitMock) Lock(key string, lifeTime time.Duration) error {
+	args := m.Called(key, lifeTime)
+	return args.Error(0)
+}
+
+func (m *AuthLoginLimitMock) LockTime(key string) (time.Duration, error) {
+	args := m.Called(key)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"backend/pkg/password"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newLoginLimitMock never locks anything
+func newLoginLimitMock() *AuthLoginLimitMock {
+	loginLimitMock := new(AuthLoginLimitMock)
+	loginLimitMock.On("LockTime", mock.Anything).Return(time.Duration(0), nil)
+	loginLimitMock.On("CountFailure", mock.Anything, mock.Anything).Return(int64(1), nil)
+	loginLimitMock.On("ResetFailures", mock.Anything).Return(nil)
+	return loginLimitMock
+}
+
+func TestSignInLocked(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	loginLimitMock := new(AuthLoginLimitMock)
+	loginLimitMock.On("LockTime", "account:test@mail.ru").Return(time.Duration(0), nil)
+	loginLimitMock.On("LockTime", "ip:1.2.3.4").Return(90*time.Second, nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil, nil, nil, loginLimitMock)
+	_, err := useCaseTest.SignIn(context.Background(), &protoAuth.SignInRequest{
+		Mail:     "Test@mail.ru ",
+		Password: "12345678",
+		IP:       "1.2.3.4",
+	})
+	assert.ErrorIs(t, err, error2.ErrLoginLocked)
+	assert.Equal(t, "login is temporarily locked, retry in 90 seconds", err.Error())
+	// The password is not checked while the login is locked
+	authRepositoryMock.AssertNotCalled(t, "GetUser", mock.Anything)
+	loginLimitMock.AssertExpectations(t)
+}
+
+func TestSignInFailed(t *testing.T) {
+	hash, err := password.Hash("12345678")
+	assert.NoError(t, err)
+	user := &models.User{ID: "1", Name: "Artyom", Mail: "test@mail.ru", Password: hash}
+
+	tests := []struct {
+		name       string
+		user       *models.User
+		failures   int64
+		ipFailures int64
+		lockouts   int64
+		lock       time.Duration
+		ipLock     time.Duration
+		email      bool
+		outputErr  string
+	}{
+		{
+			name:       "Free attempt",
+			user:       user,
+			failures:   3,
+			ipFailures: 3,
+			outputErr:  error2.ErrUserNotFound.Error(),
+		},
+		{
+			name:       "First delay",
+			user:       user,
+			failures:   4,
+			ipFailures: 4,
+			lock:       time.Second,
+			outputErr:  "login is temporarily locked, retry in 1 seconds",
+		},
+		{
+			name:       "Progressive delay",
+			user:       user,
+			failures:   7,
+			ipFailures: 7,
+			lock:       8 * time.Second,
+			outputErr:  "login is temporarily locked, retry in 8 seconds",
+		},
+		{
+			name:       "Lockout",
+			user:       user,
+			failures:   10,
+			ipFailures: 10,
+			lockouts:   1,
+			lock:       15 * time.Minute,
+			email:      true,
+			outputErr:  "login is temporarily locked, retry in 900 seconds",
+		},
+		{
+			name:       "Repeated lockout is longer",
+			user:       user,
+			failures:   10,
+			ipFailures: 10,
+			lockouts:   3,
+			lock:       time.Hour,
+			email:      true,
+			outputErr:  "login is temporarily locked, retry in 3600 seconds",
+		},
+		{
+			name:       "Repeated lockout is capped",
+			user:       user,
+			failures:   10,
+			ipFailures: 10,
+			lockouts:   20,
+			lock:       24 * time.Hour,
+			email:      true,
+			outputErr:  "login is temporarily locked, retry in 86400 seconds",
+		},
+		{
+			name:       "Unknown mail is locked without email",
+			failures:   10,
+			ipFailures: 10,
+			lockouts:   1,
+			lock:       15 * time.Minute,
+			outputErr:  "login is temporarily locked, retry in 900 seconds",
+		},
+		{
+			name:       "IP lockout",
+			user:       user,
+			failures:   1,
+			ipFailures: 100,
+			ipLock:     15 * time.Minute,
+			outputErr:  "login is temporarily locked, retry in 900 seconds",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authRepositoryMock := new(AuthRepoMock)
+			if test.user != nil {
+				authRepositoryMock.On("GetUser", "test@mail.ru").Return(test.user, nil)
+			} else {
+				authRepositoryMock.On("GetUser", "test@mail.ru").Return((*models.User)(nil), error2.ErrUserNotFound)
+			}
+			loginLimitMock := new(AuthLoginLimitMock)
+			loginLimitMock.On("LockTime", mock.Anything).Return(time.Duration(0), nil)
+			loginLimitMock.On("CountFailure", "account:test@mail.ru", defaultLoginWindow).Return(test.failures, nil)
+			loginLimitMock.On("CountFailure", "ip:1.2.3.4", defaultLoginWindow).Return(test.ipFailures, nil)
+			if test.lockouts > 0 {
+				loginLimitMock.On("CountLockout", "account:test@mail.ru", loginLockoutWindow).Return(test.lockouts, nil)
+				loginLimitMock.On("ResetFailures", "account:test@mail.ru").Return(nil)
+			}
+			if test.lock > 0 {
+				loginLimitMock.On("Lock", "account:test@mail.ru", test.lock).Return(nil)
+			}
+			if test.ipLock > 0 {
+				loginLimitMock.On("Lock", "ip:1.2.3.4", test.ipLock).Return(nil)
+				loginLimitMock.On("ResetFailures", "ip:1.2.3.4").Return(nil)
+			}
+
+			useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil, nil, nil, loginLimitMock)
+			sent := make(chan *models.Info, 1)
+			useCaseTest.sendEmail = func(theme, htmlTemplate string, info []*models.Info) {
+				assert.Equal(t, loginLockedEmailTheme, theme)
+				sent <- info[0]
+			}
+			_, err := useCaseTest.SignIn(context.Background(), &protoAuth.SignInRequest{
+				Mail:     "test@mail.ru",
+				Password: "87654321",
+				IP:       "1.2.3.4",
+			})
+			assert.EqualError(t, err, test.outputErr)
+			if test.email {
+				select {
+				case info := <-sent:
+					assert.Equal(t, "test@mail.ru", info.Mail)
+					assert.Equal(t, lockTimeText(test.lock), info.Title)
+				case <-time.After(time.Second):
+					t.Error("lockout email was not sent")
+				}
+			} else {
+				select {
+				case <-sent:
+					t.Error("unexpected lockout email")
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+			loginLimitMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSignInResetsFailures(t *testing.T) {
+	hash, err := password.Hash("12345678")
+	assert.NoError(t, err)
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Password: hash}, nil)
+	twoFactorRepositoryMock := new(AuthTwoFactorMock)
+	twoFactorRepositoryMock.On("Get", "1").Return((*authServiceModels.TwoFactor)(nil), nil)
+	loginLimitMock := new(AuthLoginLimitMock)
+	loginLimitMock.On("LockTime", mock.Anything).Return(time.Duration(0), nil)
+	// Only the account failures are forgotten
+	loginLimitMock.On("ResetFailures", "account:test@mail.ru").Return(errors.New("test_err"))
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, twoFactorRepositoryMock, nil, nil, nil, nil, loginLimitMock)
+	res, err := useCaseTest.SignIn(context.Background(), &protoAuth.SignInRequest{
+		Mail:     "test@mail.ru",
+		Password: "12345678",
+		IP:       "1.2.3.4",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res.ID)
+	loginLimitMock.AssertExpectations(t)
+}
+
+func TestLockTimeText(t *testing.T) {
+	assert.Equal(t, "1 мин.", lockTimeText(time.Second))
+	assert.Equal(t, "15 мин.", lockTimeText(15*time.Minute))
+	assert.Equal(t, "90 мин.", lockTimeText(90*time.Minute))
+	assert.Equal(t, "24 ч.", lockTimeText(24*time.Hour))
+}
@@ -16,12 +16,12 @@ func (m *AuthClientMock) SignUp(ctx context.Context, in *protoAuth.SignUpRequest
 	return args.Get(0).(*protoAuth.UserId), args.Error(1)
 }
 
-func (m *AuthClientMock) SignIn(ctx context.Context, in *protoAuth.SignInRequest, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+func (m *AuthClientMock) SignIn(ctx context.Context, in *protoAuth.SignInRequest, opts ...grpc.CallOption) (*protoAuth.SignInResponse, error) {
 	args := m.Called(ctx, in)
-	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+	return args.Get(0).(*protoAuth.SignInResponse), args.Error(1)
 }
 
-func (m *AuthClientMock) CreateSession(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.Session, error) {
+func (m *AuthClientMock) CreateSession(ctx context.Context, in *protoAuth.SessionRequest, opts ...grpc.CallOption) (*protoAuth.Session, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*protoAuth.Session), args.Error(1)
 }
@@ -36,7 +36,7 @@ func (m *AuthClientMock) DeleteSession(ctx context.Context, in *protoAuth.Sessio
 	return args.Get(0).(*protoAuth.Success), args.Error(1)
 }
 
-func (m *AuthClientMock) CreateToken(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.CSRFToken, error) {
+func (m *AuthClientMock) CreateToken(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.CSRFToken, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*protoAuth.CSRFToken), args.Error(1)
 }
@@ -45,3 +45,93 @@ func (m *AuthClientMock) CheckToken(ctx context.Context, in *protoAuth.CSRFToken
 	args := m.Called(ctx, in)
 	return args.Get(0).(*protoAuth.UserId), args.Error(1)
 }
+
+func (m *AuthClientMock) RequestPasswordReset(ctx context.Context, in *protoAuth.PasswordResetRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) ResetPassword(ctx context.Context, in *protoAuth.ResetPasswordRequest, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+}
+
+func (m *AuthClientMock) ConfirmEmail(ctx context.Context, in *protoAuth.VerificationToken, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+}
+
+func (m *AuthClientMock) ResendVerification(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) IsVerified(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.Verified, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Verified), args.Error(1)
+}
+
+func (m *AuthClientMock) ListSessions(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.SessionList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.SessionList), args.Error(1)
+}
+
+func (m *AuthClientMock) RevokeSession(ctx context.Context, in *protoAuth.RevokeSessionRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) RevokeOtherSessions(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) VerifyTwoFactor(ctx context.Context, in *protoAuth.TwoFactorLogin, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+}
+
+func (m *AuthClientMock) SetupTwoFactor(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.TwoFactorSetup, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.TwoFactorSetup), args.Error(1)
+}
+
+func (m *AuthClientMock) ConfirmTwoFactor(ctx context.Context, in *protoAuth.TwoFactorCode, opts ...grpc.CallOption) (*protoAuth.RecoveryCodes, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.RecoveryCodes), args.Error(1)
+}
+
+func (m *AuthClientMock) DisableTwoFactor(ctx context.Context, in *protoAuth.TwoFactorCode, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) RegenerateRecoveryCodes(ctx context.Context, in *protoAuth.TwoFactorCode, opts ...grpc.CallOption) (*protoAuth.RecoveryCodes, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.RecoveryCodes), args.Error(1)
+}
+
+func (m *AuthClientMock) StartOAuth(ctx context.Context, in *protoAuth.OAuthStartRequest, opts ...grpc.CallOption) (*protoAuth.OAuthStart, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.OAuthStart), args.Error(1)
+}
+
+func (m *AuthClientMock) FinishOAuth(ctx context.Context, in *protoAuth.OAuthCallback, opts ...grpc.CallOption) (*protoAuth.OAuthLogin, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.OAuthLogin), args.Error(1)
+}
+
+func (m *AuthClientMock) ListOAuthAccounts(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.OAuthAccountList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.OAuthAccountList), args.Error(1)
+}
+
+func (m *AuthClientMock) UnlinkOAuthAccount(ctx context.Context, in *protoAuth.OAuthUnlinkRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) ChangePassword(ctx context.Context, in *protoAuth.ChangePasswordRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
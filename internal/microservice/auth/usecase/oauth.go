@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"backend/internal/microservice/auth/oauth"
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	"backend/pkg/password"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+const (
+	// The user has oauthStateLifeTime to log in at the provider
+	oauthStateLifeTime = 10 * time.Minute
+	oauthRandomLength  = 32
+	// Limits of the "user" columns
+	maxNameLength = 50
+)
+
+func generateOAuthRandom() (string, error) {
+	b := make([]byte, oauthRandomLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
+
+func (s *authService) provider(name string) (*oauth.Provider, error) {
+	provider, ok := s.oauthProviders[name]
+	if !ok {
+		return nil, error2.ErrOAuthProvider
+	}
+	return provider, nil
+}
+
+// StartOAuth returns the url of the provider login page, the state must come back with the code
+func (s *authService) StartOAuth(ctx context.Context, in *protoAuth.OAuthStartRequest) (*protoAuth.OAuthStart, error) {
+	message := logMessage + "StartOAuth:"
+	log.Debug(message + "started")
+	provider, err := s.provider(in.Provider)
+	if err != nil {
+		return &protoAuth.OAuthStart{}, err
+	}
+	data := &authServiceModels.OAuthState{
+		Provider: in.Provider,
+		UserId:   in.UserId,
+	}
+	var state string
+	for _, value := range []*string{&state, &data.Nonce, &data.Verifier} {
+		*value, err = generateOAuthRandom()
+		if err != nil {
+			return &protoAuth.OAuthStart{}, err
+		}
+	}
+	err = s.authOAuthStateRepository.Save(state, data, oauthStateLifeTime)
+	if err != nil {
+		return &protoAuth.OAuthStart{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.OAuthStart{
+		URL:   provider.AuthCodeURL(state, data.Nonce, data.Verifier),
+		State: state,
+	}, nil
+}
+
+// FinishOAuth exchanges the code and logs the user in, or links the account if the state was created by a logged in user
+func (s *authService) FinishOAuth(ctx context.Context, in *protoAuth.OAuthCallback) (*protoAuth.OAuthLogin, error) {
+	message := logMessage + "FinishOAuth:"
+	log.Debug(message + "started")
+	provider, err := s.provider(in.Provider)
+	if err != nil {
+		return &protoAuth.OAuthLogin{}, err
+	}
+	data, err := s.authOAuthStateRepository.Use(in.State)
+	if err != nil {
+		return &protoAuth.OAuthLogin{}, err
+	}
+	if data == nil || data.Provider != in.Provider {
+		return &protoAuth.OAuthLogin{}, error2.ErrOAuthState
+	}
+	identity, err := provider.Authenticate(in.Code, data.Verifier, data.Nonce)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &protoAuth.OAuthLogin{}, error2.ErrOAuthFailed
+	}
+	if identity.Subject == "" {
+		log.Error(message + "err = no subject in the provider response")
+		return &protoAuth.OAuthLogin{}, error2.ErrOAuthFailed
+	}
+	linked, err := s.authIdentityRepository.Get(in.Provider, identity.Subject)
+	if err != nil {
+		return &protoAuth.OAuthLogin{}, err
+	}
+	if data.UserId != "" {
+		return s.linkOAuthAccount(data.UserId, in.Provider, identity, linked)
+	}
+	var userId string
+	if linked != nil {
+		userId = linked.UserId
+	} else {
+		userId, err = s.oauthSignUp(in.Provider, identity)
+		if err != nil {
+			return &protoAuth.OAuthLogin{}, err
+		}
+	}
+	log.Debug(message + "ended")
+	return s.oauthSignIn(userId)
+}
+
+func (s *authService) linkOAuthAccount(userId, provider string, identity *oauth.Identity, linked *authServiceModels.Identity) (*protoAuth.OAuthLogin, error) {
+	if linked != nil {
+		if linked.UserId != userId {
+			return &protoAuth.OAuthLogin{}, error2.ErrOAuthLinked
+		}
+		return &protoAuth.OAuthLogin{ID: userId, Linked: true}, nil
+	}
+	err := s.authIdentityRepository.Link(&authServiceModels.Identity{
+		Provider: provider,
+		Subject:  identity.Subject,
+		UserId:   userId,
+		Mail:     identity.Email,
+	})
+	if err != nil {
+		return &protoAuth.OAuthLogin{}, err
+	}
+	return &protoAuth.OAuthLogin{ID: userId, Linked: true}, nil
+}
+
+// oauthSignUp links the account to the user with the same verified mail or creates a new user
+func (s *authService) oauthSignUp(provider string, identity *oauth.Identity) (string, error) {
+	message := logMessage + "oauthSignUp:"
+	if identity.Email == "" {
+		return "", error2.ErrOAuthNoEmail
+	}
+	created := false
+	u, err := s.authUserRepository.GetUser(identity.Email)
+	switch {
+	case err == error2.ErrUserNotFound:
+		u, err = s.createOAuthUser(identity)
+		if err != nil {
+			return "", err
+		}
+		created = true
+	case err != nil:
+		return "", err
+	// Otherwise anyone could register the mail at the provider and take over the account
+	case !identity.EmailVerified:
+		return "", error2.ErrOAuthEmailExists
+	// Otherwise anyone could register the mail here with a known password before the owner comes with the provider
+	case !u.Verified:
+		return "", error2.ErrOAuthEmailExists
+	}
+	err = s.authIdentityRepository.Link(&authServiceModels.Identity{
+		Provider: provider,
+		Subject:  identity.Subject,
+		UserId:   u.ID,
+		Mail:     identity.Email,
+	})
+	if err != nil {
+		return "", err
+	}
+	// The provider has confirmed the mail, there is no need to send a link
+	if created && identity.EmailVerified {
+		_, err = s.authUserRepository.VerifyUser(u.ID, u.Mail)
+		if err != nil {
+			log.Error(message+"err =", err)
+		}
+	}
+	return u.ID, nil
+}
+
+// createOAuthUser gets a random password, it can be set later with the password reset
+func (s *authService) createOAuthUser(identity *oauth.Identity) (*models.User, error) {
+	message := logMessage + "createOAuthUser:"
+	plain, err := generateOAuthRandom()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := password.Hash(plain)
+	if err != nil {
+		return nil, err
+	}
+	name := identity.Name
+	if name == "" {
+		name = strings.SplitN(identity.Email, "@", 2)[0]
+	}
+	newUser := &models.User{
+		Name:     truncate(name, maxNameLength),
+		Surname:  truncate(identity.Surname, maxNameLength),
+		Mail:     identity.Email,
+		Password: hashedPassword,
+	}
+	newUser.ID, err = s.authUserRepository.CreateUser(newUser)
+	if err != nil {
+		return nil, err
+	}
+	if !identity.EmailVerified {
+		err = s.sendVerification(newUser)
+		if err != nil {
+			log.Error(message+"err =", err)
+		}
+	}
+	return newUser, nil
+}
+
+// oauthSignIn asks for the second factor the same way SignIn does
+func (s *authService) oauthSignIn(userId string) (*protoAuth.OAuthLogin, error) {
+	twoFactor, err := s.authTwoFactorRepository.Get(userId)
+	if err != nil {
+		return &protoAuth.OAuthLogin{}, err
+	}
+	if twoFactor != nil && twoFactor.Enabled {
+		token, err := s.startTwoFactor(userId)
+		if err != nil {
+			return &protoAuth.OAuthLogin{}, err
+		}
+		return &protoAuth.OAuthLogin{PreAuthToken: token}, nil
+	}
+	return &protoAuth.OAuthLogin{ID: userId}, nil
+}
+
+func (s *authService) ListOAuthAccounts(ctx context.Context, in *protoAuth.UserId) (*protoAuth.OAuthAccountList, error) {
+	message := logMessage + "ListOAuthAccounts:"
+	log.Debug(message + "started")
+	identities, err := s.authIdentityRepository.List(in.ID)
+	if err != nil {
+		return &protoAuth.OAuthAccountList{}, err
+	}
+	out := &protoAuth.OAuthAccountList{}
+	for _, identity := range identities {
+		out.Accounts = append(out.Accounts, &protoAuth.OAuthAccount{
+			Provider:  identity.Provider,
+			Mail:      identity.Mail,
+			CreatedAt: identity.CreatedAt.Unix(),
+		})
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func (s *authService) UnlinkOAuthAccount(ctx context.Context, in *protoAuth.OAuthUnlinkRequest) (*protoAuth.Success, error) {
+	message := logMessage + "UnlinkOAuthAccount:"
+	log.Debug(message + "started")
+	ok, err := s.authIdentityRepository.Unlink(in.UserId, in.Provider)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if !ok {
+		return &protoAuth.Success{}, error2.ErrOAuthNotLinked
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.Success{Ok: "success"}, nil
+}
@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"backend/internal/microservice/auth/oauth"
+	"backend/internal/microservice/auth/oauth/fakeidp"
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var oauthUser = fakeidp.User{
+	Subject:       "42",
+	Email:         "test@mail.ru",
+	EmailVerified: true,
+	Name:          "Artyom",
+	Surname:       "Shirshov",
+}
+
+type oauthTest struct {
+	service   *authService
+	user      *AuthRepoMock
+	identity  *AuthIdentityMock
+	state     *AuthOAuthStateMock
+	twoFactor *AuthTwoFactorMock
+	preAuth   *AuthPreAuthMock
+	sent      chan *models.Info
+}
+
+func newOAuthTest(t *testing.T, user fakeidp.User) *oauthTest {
+	idp, ts := fakeidp.NewTestServer(user)
+	t.Cleanup(ts.Close)
+	test := &oauthTest{
+		user:      new(AuthRepoMock),
+		identity:  new(AuthIdentityMock),
+		state:     new(AuthOAuthStateMock),
+		twoFactor: new(AuthTwoFactorMock),
+		preAuth:   new(AuthPreAuthMock),
+		sent:      make(chan *models.Info, 1),
+	}
+	providers := map[string]*oauth.Provider{
+		"fake": oauth.NewProvider("fake", idp.Config("client", "https://bmstusa.ru/oauth/fake")),
+	}
+	test.service = NewService(test.user, nil, nil, test.twoFactor, test.preAuth, test.identity, test.state, providers, nil)
+	test.service.sendEmail = func(theme, htmlTemplate string, info []*models.Info) {
+		test.sent <- info[0]
+	}
+	return test
+}
+
+// login goes through the provider and returns the callback request and the saved state
+func (test *oauthTest) login(t *testing.T, userId string) (*protoAuth.OAuthCallback, *authServiceModels.OAuthState) {
+	var saved *authServiceModels.OAuthState
+	test.state.On("Save", mock.Anything, mock.Anything, oauthStateLifeTime).Return(nil).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*authServiceModels.OAuthState)
+	}).Once()
+	start, err := test.service.StartOAuth(context.Background(), &protoAuth.OAuthStartRequest{Provider: "fake", UserId: userId})
+	require.NoError(t, err)
+	assert.Equal(t, &authServiceModels.OAuthState{
+		Provider: "fake",
+		Nonce:    saved.Nonce,
+		Verifier: saved.Verifier,
+		UserId:   userId,
+	}, saved)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(start.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, start.State, location.Query().Get("state"))
+	return &protoAuth.OAuthCallback{
+		Provider: "fake",
+		Code:     location.Query().Get("code"),
+		State:    start.State,
+	}, saved
+}
+
+func (test *oauthTest) noTwoFactor(userId string) {
+	test.twoFactor.On("Get", userId).Return((*authServiceModels.TwoFactor)(nil), nil)
+}
+
+func TestFinishOAuthLinkedAccount(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return(&authServiceModels.Identity{UserId: "1"}, nil)
+	test.noTwoFactor("1")
+
+	res, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.NoError(t, err)
+	assert.Equal(t, &protoAuth.OAuthLogin{ID: "1"}, res)
+}
+
+func TestFinishOAuthVerifiedEmail(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return((*authServiceModels.Identity)(nil), nil)
+	test.user.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Mail: "test@mail.ru", Verified: true}, nil)
+	test.identity.On("Link", &authServiceModels.Identity{Provider: "fake", Subject: "42", UserId: "1", Mail: "test@mail.ru"}).Return(nil)
+	test.noTwoFactor("1")
+
+	res, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.NoError(t, err)
+	assert.Equal(t, &protoAuth.OAuthLogin{ID: "1"}, res)
+	test.identity.AssertExpectations(t)
+	test.user.AssertExpectations(t)
+}
+
+func TestFinishOAuthUnverifiedEmail(t *testing.T) {
+	user := oauthUser
+	user.EmailVerified = false
+	test := newOAuthTest(t, user)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return((*authServiceModels.Identity)(nil), nil)
+	test.user.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Mail: "test@mail.ru"}, nil)
+
+	_, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.Equal(t, error2.ErrOAuthEmailExists, err)
+	test.identity.AssertNotCalled(t, "Link", mock.Anything)
+}
+
+func TestFinishOAuthUnverifiedUser(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return((*authServiceModels.Identity)(nil), nil)
+	test.user.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Mail: "test@mail.ru"}, nil)
+
+	_, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.Equal(t, error2.ErrOAuthEmailExists, err)
+	test.identity.AssertNotCalled(t, "Link", mock.Anything)
+	test.user.AssertNotCalled(t, "VerifyUser", mock.Anything, mock.Anything)
+}
+
+func TestFinishOAuthSignUp(t *testing.T) {
+	t.Setenv("VERIFYSECRET", "secret")
+	for _, verified := range []bool{true, false} {
+		user := oauthUser
+		user.EmailVerified = verified
+		test := newOAuthTest(t, user)
+		callback, saved := test.login(t, "")
+		test.state.On("Use", callback.State).Return(saved, nil)
+		test.identity.On("Get", "fake", "42").Return((*authServiceModels.Identity)(nil), nil)
+		test.user.On("GetUser", "test@mail.ru").Return((*models.User)(nil), error2.ErrUserNotFound)
+		test.user.On("CreateUser", mock.MatchedBy(func(u *models.User) bool {
+			return u.Name == "Artyom" && u.Surname == "Shirshov" && u.Mail == "test@mail.ru" && u.Password != ""
+		})).Return("2", nil)
+		test.identity.On("Link", &authServiceModels.Identity{Provider: "fake", Subject: "42", UserId: "2", Mail: "test@mail.ru"}).Return(nil)
+		if verified {
+			test.user.On("VerifyUser", "2", "test@mail.ru").Return(true, nil)
+		}
+		test.noTwoFactor("2")
+
+		res, err := test.service.FinishOAuth(context.Background(), callback)
+		assert.NoError(t, err)
+		assert.Equal(t, &protoAuth.OAuthLogin{ID: "2"}, res)
+		test.user.AssertExpectations(t)
+		// The provider has not confirmed the mail, so a verification link is sent
+		if !verified {
+			select {
+			case info := <-test.sent:
+				assert.Equal(t, "test@mail.ru", info.Mail)
+			case <-time.After(time.Second):
+				t.Fatal("verification email was not sent")
+			}
+		}
+	}
+}
+
+func TestFinishOAuthNoEmail(t *testing.T) {
+	user := oauthUser
+	user.Email = ""
+	test := newOAuthTest(t, user)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return((*authServiceModels.Identity)(nil), nil)
+
+	_, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.Equal(t, error2.ErrOAuthNoEmail, err)
+}
+
+func TestFinishOAuthWithTwoFactor(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", callback.State).Return(saved, nil)
+	test.identity.On("Get", "fake", "42").Return(&authServiceModels.Identity{UserId: "1"}, nil)
+	test.twoFactor.On("Get", "1").Return(&authServiceModels.TwoFactor{UserId: "1", Enabled: true}, nil)
+	test.preAuth.On("CreateToken", mock.Anything, "1", preAuthLifeTime).Return(nil)
+
+	res, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.NoError(t, err)
+	assert.Empty(t, res.ID)
+	assert.Len(t, res.PreAuthToken, 2*preAuthTokenLength)
+}
+
+func TestFinishOAuthLink(t *testing.T) {
+	tests := []struct {
+		name      string
+		linked    *authServiceModels.Identity
+		outputErr error
+	}{
+		{
+			name: "New account",
+		},
+		{
+			name:   "Already linked to the user",
+			linked: &authServiceModels.Identity{UserId: "1"},
+		},
+		{
+			name:      "Linked to another user",
+			linked:    &authServiceModels.Identity{UserId: "2"},
+			outputErr: error2.ErrOAuthLinked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oauthTest := newOAuthTest(t, oauthUser)
+			callback, saved := oauthTest.login(t, "1")
+			oauthTest.state.On("Use", callback.State).Return(saved, nil)
+			oauthTest.identity.On("Get", "fake", "42").Return(test.linked, nil)
+			oauthTest.identity.On("Link", &authServiceModels.Identity{Provider: "fake", Subject: "42", UserId: "1", Mail: "test@mail.ru"}).Return(nil)
+
+			res, err := oauthTest.service.FinishOAuth(context.Background(), callback)
+			assert.Equal(t, test.outputErr, err)
+			if test.outputErr == nil {
+				assert.Equal(t, &protoAuth.OAuthLogin{ID: "1", Linked: true}, res)
+			}
+			if test.linked != nil {
+				oauthTest.identity.AssertNotCalled(t, "Link", mock.Anything)
+			}
+			oauthTest.twoFactor.AssertNotCalled(t, "Get", mock.Anything)
+		})
+	}
+}
+
+func TestFinishOAuthInvalidState(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	test.state.On("Use", "unknown").Return((*authServiceModels.OAuthState)(nil), nil)
+	other := *saved
+	other.Provider = "vk"
+	test.state.On("Use", callback.State).Return(&other, nil)
+
+	_, err := test.service.FinishOAuth(context.Background(), &protoAuth.OAuthCallback{Provider: "fake", Code: callback.Code, State: "unknown"})
+	assert.Equal(t, error2.ErrOAuthState, err)
+	_, err = test.service.FinishOAuth(context.Background(), callback)
+	assert.Equal(t, error2.ErrOAuthState, err)
+}
+
+func TestFinishOAuthFailed(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	callback, saved := test.login(t, "")
+	other := *saved
+	other.Verifier = "other"
+	test.state.On("Use", callback.State).Return(&other, nil)
+
+	_, err := test.service.FinishOAuth(context.Background(), callback)
+	assert.Equal(t, error2.ErrOAuthFailed, err)
+}
+
+func TestOAuthUnknownProvider(t *testing.T) {
+	test := newOAuthTest(t, oauthUser)
+	_, err := test.service.StartOAuth(context.Background(), &protoAuth.OAuthStartRequest{Provider: "vk"})
+	assert.Equal(t, error2.ErrOAuthProvider, err)
+	_, err = test.service.FinishOAuth(context.Background(), &protoAuth.OAuthCallback{Provider: "vk", State: "state"})
+	assert.Equal(t, error2.ErrOAuthProvider, err)
+}
+
+func TestListOAuthAccounts(t *testing.T) {
+	identityRepositoryMock := new(AuthIdentityMock)
+	createdAt := time.Unix(1638352800, 0)
+	identityRepositoryMock.On("List", "1").Return([]*authServiceModels.Identity{
+		{Provider: "vk", Subject: "42", UserId: "1", Mail: "test@vk.com", CreatedAt: createdAt},
+	}, nil)
+
+	useCaseTest := NewService(nil, nil, nil, nil, nil, identityRepositoryMock, nil, nil, nil)
+	res, err := useCaseTest.ListOAuthAccounts(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*protoAuth.OAuthAccount{{Provider: "vk", Mail: "test@vk.com", CreatedAt: 1638352800}}, res.Accounts)
+}
+
+func TestUnlinkOAuthAccount(t *testing.T) {
+	identityRepositoryMock := new(AuthIdentityMock)
+	identityRepositoryMock.On("Unlink", "1", "vk").Return(true, nil)
+	identityRepositoryMock.On("Unlink", "1", "google").Return(false, nil)
+
+	useCaseTest := NewService(nil, nil, nil, nil, nil, identityRepositoryMock, nil, nil, nil)
+	_, err := useCaseTest.UnlinkOAuthAccount(context.Background(), &protoAuth.OAuthUnlinkRequest{UserId: "1", Provider: "vk"})
+	assert.NoError(t, err)
+	_, err = useCaseTest.UnlinkOAuthAccount(context.Background(), &protoAuth.OAuthUnlinkRequest{UserId: "1", Provider: "google"})
+	assert.Equal(t, error2.ErrOAuthNotLinked, err)
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	"backend/pkg/password"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	resetTokenLength      = 32
+	defaultResetLifeTime  = 30 * time.Minute
+	defaultResetLimit     = 3
+	defaultResetLimitTime = time.Hour
+	defaultResetURL       = "https://bmstusa.ru/reset"
+	resetEmailTheme       = "Восстановление пароля"
+)
+
+func generateResetToken() (string, error) {
+	b := make([]byte, resetTokenLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func resetLink(token string) string {
+	link := viper.GetString("password_reset.url")
+	if link == "" {
+		link = defaultResetURL
+	}
+	return link + "?token=" + url.QueryEscape(token)
+}
+
+func durationOrDefault(key string, value time.Duration) time.Duration {
+	if d := viper.GetDuration(key); d > 0 {
+		return d
+	}
+	return value
+}
+
+// RequestPasswordReset emails a reset link to the user. An unknown mail is not
+// reported, so the endpoint can not be used to find out who is registered.
+func (s *authService) RequestPasswordReset(ctx context.Context, in *protoAuth.PasswordResetRequest) (*protoAuth.Success, error) {
+	message := logMessage + "RequestPasswordReset:"
+	log.Debug(message + "started")
+	if in.Mail == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	limit := int64(viper.GetInt("password_reset.limit"))
+	if limit <= 0 {
+		limit = defaultResetLimit
+	}
+	// Counted before the lookup, so unknown addresses are limited the same way
+	count, err := s.authResetRepository.CountRequest(in.Mail, durationOrDefault("password_reset.limit_time", defaultResetLimitTime))
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if count > limit {
+		return &protoAuth.Success{}, error2.ErrTooManyRequests
+	}
+	u, err := s.authUserRepository.GetUser(in.Mail)
+	if err == error2.ErrUserNotFound {
+		return &protoAuth.Success{Ok: "success"}, nil
+	}
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	token, err := generateResetToken()
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authResetRepository.CreateToken(token, u.ID, durationOrDefault("password_reset.lifetime", defaultResetLifeTime))
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	receiver := &models.Info{
+		Name: u.Name,
+		Mail: u.Mail,
+		Link: resetLink(token),
+	}
+	// Sending takes a while and must not show whether the user exists
+	go s.sendEmail(resetEmailTheme, viper.GetString("reset_password_html"), []*models.Info{receiver})
+	log.Debug(message + "ended")
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+// ResetPassword sets a new password by a token from the email and logs the user out everywhere
+func (s *authService) ResetPassword(ctx context.Context, in *protoAuth.ResetPasswordRequest) (*protoAuth.UserId, error) {
+	message := logMessage + "ResetPassword:"
+	log.Debug(message + "started")
+	if in.Token == "" || in.Password == "" {
+		return &protoAuth.UserId{}, error2.ErrEmptyData
+	}
+	userId, err := s.authResetRepository.UseToken(in.Token)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	if userId == "" {
+		return &protoAuth.UserId{}, error2.ErrResetToken
+	}
+	hashedPassword, err := password.Hash(in.Password)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	err = s.authUserRepository.ResetPassword(userId, hashedPassword)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	err = s.authSessionRepository.DeleteUserSessions(userId)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.UserId{ID: userId}, nil
+}
+
+// ChangePassword sets a new password for the owner of the session and logs the user out
+// everywhere else. The sessions are revoked here, so the change can't succeed without it.
+func (s *authService) ChangePassword(ctx context.Context, in *protoAuth.ChangePasswordRequest) (*protoAuth.Success, error) {
+	message := logMessage + "ChangePassword:"
+	log.Debug(message + "started")
+	if in.Password == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	userId, err := s.checkSession(in.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	hashedPassword, err := password.Hash(in.Password)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authUserRepository.ResetPassword(userId, hashedPassword)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authSessionRepository.DeleteOtherSessions(userId, in.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.Success{Ok: "success"}, nil
+}
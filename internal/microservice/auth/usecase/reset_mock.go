@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type AuthResetMock struct {
+	mock.Mock
+}
+
+func (m *AuthResetMock) CreateToken(token, userId string, lifeTime time.Duration) error {
+	args := m.Called(token, userId, lifeTime)
+	return args.Error(0)
+}
+
+func (m *AuthResetMock) UseToken(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+func (m *AuthResetMock) CountRequest(mail string, window time.Duration) (int64, error) {
+	args := m.Called(mail, window)
+	return args.Get(0).(int64), args.Error(1)
+}
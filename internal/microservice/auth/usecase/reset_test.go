@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"backend/pkg/password"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var requestPasswordResetTests = []struct {
+	name      string
+	mail      string
+	count     int64
+	user      *models.User
+	getErr    error
+	sent      bool
+	outputErr error
+}{
+	{
+		name:  "Registered user",
+		mail:  "test@mail.ru",
+		count: 1,
+		user:  &models.User{ID: "1", Name: "Artyom", Mail: "test@mail.ru"},
+		sent:  true,
+	},
+	{
+		name:   "Unknown mail is not reported",
+		mail:   "unknown@mail.ru",
+		count:  1,
+		getErr: error2.ErrUserNotFound,
+	},
+	{
+		name:      "Too many requests",
+		mail:      "test@mail.ru",
+		count:     defaultResetLimit + 1,
+		outputErr: error2.ErrTooManyRequests,
+	},
+	{
+		name:      "Empty mail",
+		outputErr: error2.ErrEmptyData,
+	},
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			resetRepositoryMock := new(AuthResetMock)
+			useCaseTest := NewService(userRepositoryMock, nil, resetRepositoryMock, nil, nil, nil, nil, nil, nil)
+			sent := make(chan *models.Info, 1)
+			useCaseTest.sendEmail = func(theme, htmlTemplate string, info []*models.Info) {
+				sent <- info[0]
+			}
+
+			if test.mail != "" {
+				resetRepositoryMock.On("CountRequest", test.mail, defaultResetLimitTime).Return(test.count, nil)
+			}
+			if test.user != nil || test.getErr != nil {
+				userRepositoryMock.On("GetUser", test.mail).Return(test.user, test.getErr)
+			}
+			var token string
+			if test.sent {
+				resetRepositoryMock.On("CreateToken", mock.AnythingOfType("string"), test.user.ID, defaultResetLifeTime).
+					Run(func(args mock.Arguments) { token = args.String(0) }).Return(nil)
+			}
+
+			out, err := useCaseTest.RequestPasswordReset(context.Background(), &protoAuth.PasswordResetRequest{Mail: test.mail})
+			assert.Equal(t, test.outputErr, err, test.name)
+			if test.outputErr == nil {
+				assert.Equal(t, "success", out.Ok, test.name)
+			}
+			if test.sent {
+				select {
+				case info := <-sent:
+					assert.Equal(t, test.user.Mail, info.Mail)
+					assert.Len(t, token, resetTokenLength*2)
+					assert.True(t, strings.HasSuffix(info.Link, "?token="+token), info.Link)
+				case <-time.After(time.Second):
+					t.Fatal("reset email was not sent")
+				}
+			} else {
+				assert.Len(t, sent, 0)
+			}
+			userRepositoryMock.AssertExpectations(t)
+			resetRepositoryMock.AssertExpectations(t)
+		})
+	}
+}
+
+var resetPasswordTests = []struct {
+	name      string
+	token     string
+	password  string
+	userId    string
+	useErr    error
+	updateErr error
+	outputErr error
+}{
+	{
+		name:     "Valid token",
+		token:    "token",
+		password: "87654321",
+		userId:   "1",
+	},
+	{
+		name:      "Used or expired token",
+		token:     "token",
+		password:  "87654321",
+		outputErr: error2.ErrResetToken,
+	},
+	{
+		name:      "Redis error",
+		token:     "token",
+		password:  "87654321",
+		useErr:    errors.New("test_err"),
+		outputErr: errors.New("test_err"),
+	},
+	{
+		name:      "Postgres error",
+		token:     "token",
+		password:  "87654321",
+		userId:    "1",
+		updateErr: error2.ErrPostgres,
+		outputErr: error2.ErrPostgres,
+	},
+	{
+		name:      "Empty password",
+		token:     "token",
+		outputErr: error2.ErrEmptyData,
+	},
+}
+
+func TestResetPassword(t *testing.T) {
+	for _, test := range resetPasswordTests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			sessionRepositoryMock := new(AuthSessionMock)
+			resetRepositoryMock := new(AuthResetMock)
+			useCaseTest := NewService(userRepositoryMock, sessionRepositoryMock, resetRepositoryMock, nil, nil, nil, nil, nil, nil)
+
+			if test.password != "" {
+				resetRepositoryMock.On("UseToken", test.token).Return(test.userId, test.useErr)
+			}
+			if test.userId != "" {
+				hashedPassword := mock.MatchedBy(func(h string) bool {
+					return password.Verify(h, test.password)
+				})
+				userRepositoryMock.On("ResetPassword", test.userId, hashedPassword).Return(test.updateErr)
+			}
+			if test.outputErr == nil {
+				sessionRepositoryMock.On("DeleteUserSessions", test.userId).Return(nil)
+			}
+
+			out, err := useCaseTest.ResetPassword(context.Background(), &protoAuth.ResetPasswordRequest{
+				Token:    test.token,
+				Password: test.password,
+			})
+			assert.Equal(t, test.outputErr, err, test.name)
+			if test.outputErr == nil {
+				assert.Equal(t, test.userId, out.ID, test.name)
+			}
+			userRepositoryMock.AssertExpectations(t)
+			sessionRepositoryMock.AssertExpectations(t)
+			resetRepositoryMock.AssertExpectations(t)
+		})
+	}
+}
+
+var changePasswordTests = []struct {
+	name      string
+	password  string
+	updateErr error
+	deleteErr error
+	outputErr error
+}{
+	{
+		name:     "Password changed",
+		password: "87654321",
+	},
+	{
+		name:      "Postgres error",
+		password:  "87654321",
+		updateErr: error2.ErrPostgres,
+		outputErr: error2.ErrPostgres,
+	},
+	{
+		name:      "Sessions not revoked",
+		password:  "87654321",
+		deleteErr: errors.New("test_err"),
+		outputErr: errors.New("test_err"),
+	},
+	{
+		name:      "Empty password",
+		outputErr: error2.ErrEmptyData,
+	},
+}
+
+func TestChangePassword(t *testing.T) {
+	for _, test := range changePasswordTests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			sessionRepositoryMock := new(AuthSessionMock)
+			useCaseTest := NewService(userRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.password != "" {
+				sessionRepositoryMock.On("Check", "current").Return("1", nil)
+				sessionRepositoryMock.On("Meta", "current").Return((*authServiceModels.SessionData)(nil), nil)
+				hashedPassword := mock.MatchedBy(func(h string) bool {
+					return password.Verify(h, test.password)
+				})
+				userRepositoryMock.On("ResetPassword", "1", hashedPassword).Return(test.updateErr)
+			}
+			if test.password != "" && test.updateErr == nil {
+				sessionRepositoryMock.On("DeleteOtherSessions", "1", "current").Return(test.deleteErr)
+			}
+
+			out, err := useCaseTest.ChangePassword(context.Background(), &protoAuth.ChangePasswordRequest{
+				Session:  "current",
+				Password: test.password,
+			})
+			assert.Equal(t, test.outputErr, err, test.name)
+			if test.outputErr == nil {
+				assert.Equal(t, "success", out.Ok, test.name)
+			}
+			userRepositoryMock.AssertExpectations(t)
+			sessionRepositoryMock.AssertExpectations(t)
+		})
+	}
+}
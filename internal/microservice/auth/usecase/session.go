@@ -3,48 +3,75 @@ package usecase
 import (
 	authServiceModels "backend/internal/microservice/auth/models"
 	protoAuth "backend/internal/microservice/auth/proto"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
 	"context"
-	"errors"
-	"math/rand"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
-var (
-	ErrEmptySessionId  = errors.New("session id is empty")
-	ErrSessionNotFound = errors.New("session was not found")
-	letterRunes        = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
-)
-
-func generateSessionId(n int) string {
-	if n == 0 {
-		return ""
-	}
-	b := make([]rune, n)
-	rand.Seed(time.Now().UnixNano())
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+// generateSessionId returns n random bytes encoded for a cookie
+func generateSessionId(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
 	}
-	return string(b)
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
 const (
-	sessionIdLength = 16
-	sessionLifeTime = time.Hour * 24
+	sessionIdLength = 32
+	// Sessions are prolonged on every request but end after sessionMaxLifeTime anyway
+	sessionLifeTime    = time.Hour * 24
+	rememberLifeTime   = time.Hour * 24 * 14
+	sessionMaxLifeTime = time.Hour * 24 * 30
+	// Length of the public session id and of the stored user agent
+	publicIdLength     = 16
+	maxUserAgentLength = 255
 )
 
-func (s *authService) CreateSession(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.Session, error) {
+// publicSessionId identifies a session in the session list without revealing the cookie value
+func publicSessionId(sessionId string) string {
+	sum := sha256.Sum256([]byte(sessionId))
+	return hex.EncodeToString(sum[:])[:publicIdLength]
+}
+
+func (s *authService) CreateSession(ctx context.Context, in *protoAuth.SessionRequest) (*protoAuth.Session, error) {
+	userAgent := in.UserAgent
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	sessionId, err := generateSessionId(sessionIdLength)
+	if err != nil {
+		return &protoAuth.Session{}, err
+	}
+	now := time.Now()
 	sessionData := &authServiceModels.SessionData{
-		SessionId:  generateSessionId(sessionIdLength),
-		UserId:     protoUserId.ID,
+		SessionId:  sessionId,
+		UserId:     in.UserId,
 		Expiration: sessionLifeTime,
+		UserAgent:  userAgent,
+		IP:         in.IP,
+		CreatedAt:  now,
+		LastSeen:   now,
+		Remember:   in.Remember,
+		ExpiresAt:  now.Add(sessionMaxLifeTime),
+	}
+	if in.Remember {
+		sessionData.Expiration = rememberLifeTime
 	}
 	id, _ := strconv.Atoi(sessionData.UserId)
 	if id <= 0 {
 		sessionData.SessionId = ""
 	}
-	err := s.authSessionRepository.Create(sessionData)
+	err = s.authSessionRepository.Create(sessionData)
 	if err != nil {
 		return &protoAuth.Session{}, err
 	}
@@ -54,22 +81,76 @@ func (s *authService) CreateSession(ctx context.Context, protoUserId *protoAuth.
 	return response, err
 }
 
+// CheckSession also gives the role of the user, it is read on every check so a changed role applies at once
 func (s *authService) CheckSession(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.UserId, error) {
-	sessionId := protoSession.Session
+	userId, err := s.checkSession(protoSession.Session)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	role, err := s.authUserRepository.GetUserRole(userId)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	response := &protoAuth.UserId{
+		ID:   userId,
+		Role: role,
+	}
+	return response, nil
+}
+
+func (s *authService) checkSession(sessionId string) (string, error) {
 	if sessionId == "" {
-		return &protoAuth.UserId{}, ErrEmptySessionId
+		return "", error2.ErrEmptySessionId
 	}
 	userId, err := s.authSessionRepository.Check(sessionId)
 	if err != nil {
 		if strings.Contains(err.Error(), "redis: nil") {
-			return &protoAuth.UserId{}, ErrSessionNotFound
+			return "", error2.ErrSessionNotFound
 		}
-		return &protoAuth.UserId{}, err
+		return "", err
 	}
-	response := &protoAuth.UserId{
-		ID: userId,
+	err = s.refreshSession(sessionId, time.Now())
+	if err != nil {
+		return "", err
 	}
-	return response, nil
+	return userId, nil
+}
+
+// refreshSession prolongs the session unless it has reached its absolute expiration
+func (s *authService) refreshSession(sessionId string, now time.Time) error {
+	message := logMessage + "refreshSession:"
+	// The session stays valid even if it could not be prolonged
+	meta, err := s.authSessionRepository.Meta(sessionId)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return nil
+	}
+	if meta == nil {
+		return nil
+	}
+	expiresAt := meta.ExpiresAt
+	if meta.ExpiresAt.IsZero() {
+		expiresAt = meta.CreatedAt.Add(sessionMaxLifeTime)
+	}
+	expiration := sessionLifeTime
+	if meta.Remember {
+		expiration = rememberLifeTime
+	}
+	if left := expiresAt.Sub(now); left < expiration {
+		expiration = left
+	}
+	if expiration < time.Second {
+		err = s.authSessionRepository.Delete(sessionId)
+		if err != nil {
+			log.Error(message+"err =", err)
+		}
+		return error2.ErrSessionNotFound
+	}
+	err = s.authSessionRepository.Touch(sessionId, now, expiration)
+	if err != nil {
+		log.Error(message+"err =", err)
+	}
+	return nil
 }
 
 func (s *authService) DeleteSession(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.Success, error) {
@@ -82,3 +163,63 @@ func (s *authService) DeleteSession(ctx context.Context, protoSession *protoAuth
 	}
 	return response, nil
 }
+
+func (s *authService) ListSessions(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.SessionList, error) {
+	userId, err := s.checkSession(protoSession.Session)
+	if err != nil {
+		return &protoAuth.SessionList{}, err
+	}
+	sessions, err := s.authSessionRepository.List(userId)
+	if err != nil {
+		return &protoAuth.SessionList{}, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	result := make([]*protoAuth.SessionInfo, len(sessions))
+	for i, session := range sessions {
+		result[i] = &protoAuth.SessionInfo{
+			ID:        publicSessionId(session.SessionId),
+			UserAgent: session.UserAgent,
+			IP:        session.IP,
+			CreatedAt: session.CreatedAt.Unix(),
+			LastSeen:  session.LastSeen.Unix(),
+			Current:   session.SessionId == protoSession.Session,
+		}
+	}
+	return &protoAuth.SessionList{Sessions: result}, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, in *protoAuth.RevokeSessionRequest) (*protoAuth.Success, error) {
+	userId, err := s.checkSession(in.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	// Only sessions of the same user can be found by the public id
+	sessions, err := s.authSessionRepository.List(userId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	for _, session := range sessions {
+		if publicSessionId(session.SessionId) == in.ID {
+			err = s.authSessionRepository.Delete(session.SessionId)
+			if err != nil {
+				return &protoAuth.Success{}, err
+			}
+			return &protoAuth.Success{Ok: "success"}, nil
+		}
+	}
+	return &protoAuth.Success{}, error2.ErrUnknownSession
+}
+
+func (s *authService) RevokeOtherSessions(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.Success, error) {
+	userId, err := s.checkSession(protoSession.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authSessionRepository.DeleteOtherSessions(userId, protoSession.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	authServiceModels "backend/internal/microservice/auth/models"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type AuthSessionMock struct {
@@ -24,6 +25,31 @@ func (m *AuthSessionMock) Delete(sessionId string) error {
 	return args.Error(0)
 }
 
+func (m *AuthSessionMock) DeleteUserSessions(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *AuthSessionMock) Meta(sessionId string) (*authServiceModels.SessionData, error) {
+	args := m.Called(sessionId)
+	return args.Get(0).(*authServiceModels.SessionData), args.Error(1)
+}
+
+func (m *AuthSessionMock) Touch(sessionId string, lastSeen time.Time, expiration time.Duration) error {
+	args := m.Called(sessionId, lastSeen, expiration)
+	return args.Error(0)
+}
+
+func (m *AuthSessionMock) List(userId string) ([]*authServiceModels.SessionData, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*authServiceModels.SessionData), args.Error(1)
+}
+
+func (m *AuthSessionMock) DeleteOtherSessions(userId, keep string) error {
+	args := m.Called(userId, keep)
+	return args.Error(0)
+}
+
 /*
 func (m *AuthClientMock) CreateToken(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.CSRFToken, error) {
 	args := m.Called(ctx, in)
@@ -3,28 +3,36 @@ package usecase
 import (
 	authServiceModels "backend/internal/microservice/auth/models"
 	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateSession(t *testing.T) {
 	sessionRepositoryMock := new(AuthSessionMock)
 	authRepositoryMock := new(AuthRepoMock)
-	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock)
+	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
 	userId := "-1"
-	sessionData := &authServiceModels.SessionData{
-		SessionId:  "",
-		UserId:     userId,
-		Expiration: sessionLifeTime,
-	}
-	sessionRepositoryMock.On("Create", sessionData).Return(nil)
+	userAgent := strings.Repeat("a", maxUserAgentLength+10)
+	sessionRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.SessionData) bool {
+		return data.SessionId == "" && data.UserId == userId && data.Expiration == sessionLifeTime &&
+			data.UserAgent == userAgent[:maxUserAgentLength] && data.IP == "127.0.0.1" &&
+			!data.CreatedAt.IsZero() && data.LastSeen.Equal(data.CreatedAt) && !data.Remember &&
+			data.ExpiresAt.Equal(data.CreatedAt.Add(sessionMaxLifeTime))
+	})).Return(nil)
 	ctx := context.Background()
-	protoUserId := &protoAuth.UserId{
-		ID: userId,
+	in := &protoAuth.SessionRequest{
+		UserId:    userId,
+		UserAgent: userAgent,
+		IP:        "127.0.0.1",
 	}
-	protoSession, err := useCaseTest.CreateSession(ctx, protoUserId)
+	protoSession, err := useCaseTest.CreateSession(ctx, in)
 
 	assert.Equal(t, len(protoSession.Session), 0)
 	assert.NoError(t, err)
@@ -37,8 +45,11 @@ func TestCheckSession(t *testing.T) {
 	sessionId := "1111111111111111"
 	expUserId := "1"
 	sessionRepositoryMock.On("Check", sessionId).Return(expUserId, nil)
+	sessionRepositoryMock.On("Meta", sessionId).Return((*authServiceModels.SessionData)(nil), nil)
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetUserRole", expUserId).Return(models.RoleModerator, nil)
 
-	useCaseTest := NewService(nil, sessionRepositoryMock)
+	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoSession := &protoAuth.Session{
@@ -47,8 +58,10 @@ func TestCheckSession(t *testing.T) {
 	protoUserId, err := useCaseTest.CheckSession(ctx, protoSession)
 	userId := protoUserId.ID
 	assert.Equal(t, "1", userId)
+	assert.Equal(t, models.RoleModerator, protoUserId.Role)
 	assert.NoError(t, err)
 	sessionRepositoryMock.AssertExpectations(t)
+	authRepositoryMock.AssertExpectations(t)
 }
 
 func TestDeleteSession(t *testing.T) {
@@ -58,7 +71,7 @@ func TestDeleteSession(t *testing.T) {
 
 	sessionRepositoryMock.On("Delete", sessionId).Return(nil)
 
-	useCaseTest := NewService(nil, sessionRepositoryMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoSession := &protoAuth.Session{
@@ -70,3 +83,163 @@ func TestDeleteSession(t *testing.T) {
 	assert.NoError(t, err)
 	sessionRepositoryMock.AssertExpectations(t)
 }
+
+func TestListSessions(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+	now := time.Now()
+	sessions := []*authServiceModels.SessionData{
+		{SessionId: "current", UserId: "1", UserAgent: "Firefox", IP: "127.0.0.1", CreatedAt: now, LastSeen: now.Add(-time.Hour)},
+		{SessionId: "other", UserId: "1", UserAgent: "Chrome", IP: "127.0.0.2", CreatedAt: now, LastSeen: now},
+	}
+	sessionRepositoryMock.On("Check", "current").Return("1", nil)
+	sessionRepositoryMock.On("Meta", "current").Return((*authServiceModels.SessionData)(nil), nil)
+	sessionRepositoryMock.On("List", "1").Return(sessions, nil)
+
+	list, err := useCaseTest.ListSessions(context.Background(), &protoAuth.Session{Session: "current"})
+	assert.NoError(t, err)
+	assert.Len(t, list.Sessions, 2)
+	assert.Equal(t, publicSessionId("other"), list.Sessions[0].ID)
+	assert.False(t, list.Sessions[0].Current)
+	assert.Equal(t, publicSessionId("current"), list.Sessions[1].ID)
+	assert.True(t, list.Sessions[1].Current)
+	assert.Equal(t, "Firefox", list.Sessions[1].UserAgent)
+	assert.Equal(t, now.Unix(), list.Sessions[1].CreatedAt)
+	assert.NotContains(t, list.Sessions[0].ID, "other")
+}
+
+var revokeSessionTests = []struct {
+	id     int
+	target string
+	err    error
+}{
+	{1, publicSessionId("other"), nil},
+	{2, publicSessionId("foreign"), error2.ErrUnknownSession},
+}
+
+func TestRevokeSession(t *testing.T) {
+	for _, test := range revokeSessionTests {
+		sessionRepositoryMock := new(AuthSessionMock)
+		useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+		sessions := []*authServiceModels.SessionData{
+			{SessionId: "current", UserId: "1"},
+			{SessionId: "other", UserId: "1"},
+		}
+		sessionRepositoryMock.On("Check", "current").Return("1", nil)
+		sessionRepositoryMock.On("Meta", "current").Return((*authServiceModels.SessionData)(nil), nil)
+		sessionRepositoryMock.On("List", "1").Return(sessions, nil)
+		sessionRepositoryMock.On("Delete", "other").Return(nil)
+
+		in := &protoAuth.RevokeSessionRequest{
+			Session: "current",
+			ID:      test.target,
+		}
+		_, err := useCaseTest.RevokeSession(context.Background(), in)
+		assert.Equal(t, test.err, err, test.id)
+		if test.err == nil {
+			sessionRepositoryMock.AssertCalled(t, "Delete", "other")
+		} else {
+			sessionRepositoryMock.AssertNotCalled(t, "Delete", mock.Anything)
+		}
+	}
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+	sessionRepositoryMock.On("Check", "current").Return("1", nil)
+	sessionRepositoryMock.On("Meta", "current").Return((*authServiceModels.SessionData)(nil), nil)
+	sessionRepositoryMock.On("DeleteOtherSessions", "1", "current").Return(nil)
+
+	res, err := useCaseTest.RevokeOtherSessions(context.Background(), &protoAuth.Session{Session: "current"})
+	assert.NoError(t, err)
+	assert.Equal(t, "success", res.Ok)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+func TestGenerateSessionId(t *testing.T) {
+	first, err := generateSessionId(sessionIdLength)
+	assert.NoError(t, err)
+	second, err := generateSessionId(sessionIdLength)
+	assert.NoError(t, err)
+	assert.Len(t, first, 43)
+	assert.NotEqual(t, first, second)
+}
+
+func TestCreateRememberedSession(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+	sessionRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.SessionData) bool {
+		return len(data.SessionId) == 43 && data.Remember && data.Expiration == rememberLifeTime
+	})).Return(nil)
+
+	in := &protoAuth.SessionRequest{
+		UserId:   "1",
+		Remember: true,
+	}
+	protoSession, err := useCaseTest.CreateSession(context.Background(), in)
+	assert.NoError(t, err)
+	assert.Len(t, protoSession.Session, 43)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+var refreshSessionTests = []struct {
+	id         int
+	meta       *authServiceModels.SessionData
+	expiration time.Duration
+	err        error
+}{
+	{
+		1,
+		&authServiceModels.SessionData{ExpiresAt: refreshNow.Add(sessionMaxLifeTime)},
+		sessionLifeTime,
+		nil,
+	},
+	{
+		2,
+		&authServiceModels.SessionData{Remember: true, ExpiresAt: refreshNow.Add(sessionMaxLifeTime)},
+		rememberLifeTime,
+		nil,
+	},
+	{
+		3,
+		&authServiceModels.SessionData{Remember: true, ExpiresAt: refreshNow.Add(time.Hour)},
+		time.Hour,
+		nil,
+	},
+	{
+		4,
+		&authServiceModels.SessionData{CreatedAt: refreshNow.Add(-sessionMaxLifeTime + time.Minute)},
+		time.Minute,
+		nil,
+	},
+	{
+		5,
+		&authServiceModels.SessionData{ExpiresAt: refreshNow.Add(-time.Minute)},
+		0,
+		error2.ErrSessionNotFound,
+	},
+}
+
+var refreshNow = time.Unix(1637000000, 0)
+
+func TestRefreshSession(t *testing.T) {
+	for _, test := range refreshSessionTests {
+		sessionRepositoryMock := new(AuthSessionMock)
+		useCaseTest := &authService{authSessionRepository: sessionRepositoryMock}
+		sessionRepositoryMock.On("Meta", "session").Return(test.meta, nil)
+		sessionRepositoryMock.On("Touch", "session", refreshNow, test.expiration).Return(nil)
+		sessionRepositoryMock.On("Delete", "session").Return(nil)
+
+		err := useCaseTest.refreshSession("session", refreshNow)
+		assert.Equal(t, test.err, err, test.id)
+		if test.err == nil {
+			sessionRepositoryMock.AssertCalled(t, "Touch", "session", refreshNow, test.expiration)
+		} else {
+			sessionRepositoryMock.AssertCalled(t, "Delete", "session")
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	protoAuth "backend/internal/microservice/auth/proto"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	"backend/pkg/totp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	twoFactorIssuer = "BMSTUSA"
+	// The second step of the login must be finished within preAuthLifeTime
+	preAuthTokenLength = 32
+	preAuthLifeTime    = 5 * time.Minute
+	maxTwoFactorTries  = 5
+	// Ten codes of ten characters, shown to the user as xxxxx-xxxxx
+	recoveryCodesCount = 10
+	recoveryCodeLength = 10
+	// A used TOTP code is remembered while it is still accepted
+	usedStepLifeTime = 3 * totp.Period * time.Second
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func generatePreAuthToken() (string, error) {
+	b := make([]byte, preAuthTokenLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	return strings.ToLower(code)
+}
+
+// Only the hashes of the recovery codes are stored
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+	b := make([]byte, recoveryCodeLength*5/8)
+	for i := range codes {
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+		hashes[i] = hashRecoveryCode(code)
+	}
+	return codes, hashes, nil
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTOTP accepts every code only once
+func (s *authService) checkTOTP(userId, secret, code string) (bool, error) {
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return s.authPreAuthRepository.UseStep(userId, step, usedStepLifeTime)
+}
+
+// checkSecondFactor accepts a TOTP code or one of the recovery codes
+func (s *authService) checkSecondFactor(userId, code string) (bool, error) {
+	twoFactor, err := s.authTwoFactorRepository.Get(userId)
+	if err != nil {
+		return false, err
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return false, error2.ErrTwoFactorDisabled
+	}
+	if isTOTPCode(code) {
+		return s.checkTOTP(userId, twoFactor.Secret, code)
+	}
+	return s.authTwoFactorRepository.UseRecoveryCode(userId, hashRecoveryCode(code))
+}
+
+// startTwoFactor is the first step of the login when two-factor authentication is enabled
+func (s *authService) startTwoFactor(userId string) (string, error) {
+	token, err := generatePreAuthToken()
+	if err != nil {
+		return "", err
+	}
+	err = s.authPreAuthRepository.CreateToken(token, userId, preAuthLifeTime)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *authService) VerifyTwoFactor(ctx context.Context, in *protoAuth.TwoFactorLogin) (*protoAuth.UserId, error) {
+	message := logMessage + "VerifyTwoFactor:"
+	log.Debug(message + "started")
+	userId, err := s.authPreAuthRepository.CheckToken(in.PreAuthToken)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	if userId == "" {
+		return &protoAuth.UserId{}, error2.ErrPreAuthToken
+	}
+	u, err := s.authUserRepository.GetUserById(userId)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	// Wrong codes lock the login the same way as wrong passwords
+	err = s.checkLoginLock(u.Mail, in.IP)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	attempts, err := s.authPreAuthRepository.CountAttempt(in.PreAuthToken, preAuthLifeTime)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	// The password has to be entered again after too many wrong codes
+	if attempts > maxTwoFactorTries {
+		err = s.authPreAuthRepository.DeleteToken(in.PreAuthToken)
+		if err != nil {
+			log.Error(message+"err =", err)
+		}
+		return &protoAuth.UserId{}, error2.ErrTooManyRequests
+	}
+	ok, err := s.checkSecondFactor(userId, in.Code)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	if !ok {
+		err = s.loginFailed(u, u.Mail, in.IP)
+		if err != nil {
+			return &protoAuth.UserId{}, err
+		}
+		return &protoAuth.UserId{}, error2.ErrTwoFactorCode
+	}
+	err = s.authPreAuthRepository.DeleteToken(in.PreAuthToken)
+	if err != nil {
+		log.Error(message+"err =", err)
+	}
+	s.loginSucceeded(u.Mail)
+	log.Debug(message + "ended")
+	return &protoAuth.UserId{ID: userId}, nil
+}
+
+// SetupTwoFactor creates a new secret, two-factor authentication is enabled only after ConfirmTwoFactor
+func (s *authService) SetupTwoFactor(ctx context.Context, in *protoAuth.UserId) (*protoAuth.TwoFactorSetup, error) {
+	message := logMessage + "SetupTwoFactor:"
+	log.Debug(message + "started")
+	u, err := s.authUserRepository.GetUserById(in.ID)
+	if err != nil {
+		return &protoAuth.TwoFactorSetup{}, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return &protoAuth.TwoFactorSetup{}, err
+	}
+	ok, err := s.authTwoFactorRepository.SetSecret(in.ID, secret)
+	if err != nil {
+		return &protoAuth.TwoFactorSetup{}, err
+	}
+	if !ok {
+		return &protoAuth.TwoFactorSetup{}, error2.ErrTwoFactorEnabled
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.TwoFactorSetup{
+		Secret: secret,
+		URI:    totp.URI(secret, twoFactorIssuer, u.Mail),
+	}, nil
+}
+
+// ConfirmTwoFactor enables two-factor authentication and returns the recovery codes, they are shown only once
+func (s *authService) ConfirmTwoFactor(ctx context.Context, in *protoAuth.TwoFactorCode) (*protoAuth.RecoveryCodes, error) {
+	message := logMessage + "ConfirmTwoFactor:"
+	log.Debug(message + "started")
+	twoFactor, err := s.authTwoFactorRepository.Get(in.UserId)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	if twoFactor == nil {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTwoFactorDisabled
+	}
+	if twoFactor.Enabled {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTwoFactorEnabled
+	}
+	ok, err := s.checkTOTP(in.UserId, twoFactor.Secret, in.Code)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	if !ok {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTwoFactorCode
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	ok, err = s.authTwoFactorRepository.Enable(in.UserId, hashes)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	if !ok {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTwoFactorEnabled
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.RecoveryCodes{Codes: codes}, nil
+}
+
+func (s *authService) DisableTwoFactor(ctx context.Context, in *protoAuth.TwoFactorCode) (*protoAuth.Success, error) {
+	message := logMessage + "DisableTwoFactor:"
+	log.Debug(message + "started")
+	ok, err := s.checkSecondFactor(in.UserId, in.Code)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if !ok {
+		return &protoAuth.Success{}, error2.ErrTwoFactorCode
+	}
+	err = s.authTwoFactorRepository.Disable(in.UserId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes, the old ones stop working
+func (s *authService) RegenerateRecoveryCodes(ctx context.Context, in *protoAuth.TwoFactorCode) (*protoAuth.RecoveryCodes, error) {
+	message := logMessage + "RegenerateRecoveryCodes:"
+	log.Debug(message + "started")
+	ok, err := s.checkSecondFactor(in.UserId, in.Code)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	if !ok {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTwoFactorCode
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	err = s.authTwoFactorRepository.ReplaceRecoveryCodes(in.UserId, hashes)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.RecoveryCodes{Codes: codes}, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type AuthTwoFactorMock struct {
+	mock.Mock
+}
+
+func (m *AuthTwoFactorMock) Get(userId string) (*authServiceModels.TwoFactor, error) {
+	args := m.Called(userId)
+	return args.Get(0).(*authServiceModels.TwoFactor), args.Error(1)
+}
+
+func (m *AuthTwoFactorMock) SetSecret(userId, secret string) (bool, error) {
+	args := m.Called(userId, secret)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *AuthTwoFactorMock) Enable(userId string, codeHashes []string) (bool, error) {
+	args := m.Called(userId, codeHashes)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *AuthTwoFactorMock) Disable(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *AuthTwoFactorMock) ReplaceRecoveryCodes(userId string, codeHashes []string) error {
+	args := m.Called(userId, codeHashes)
+	return args.Error(0)
+}
+
+func (m *AuthTwoFactorMock) UseRecoveryCode(userId, codeHash string) (bool, error) {
+	args := m.Called(userId, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+type AuthPreAuthMock struct {
+	mock.Mock
+}
+
+func (m *AuthPreAuthMock) CreateToken(token, userId string, lifeTime time.Duration) error {
+	args := m.Called(token, userId, lifeTime)
+	return args.Error(0)
+}
+
+func (m *AuthPreAuthMock) CheckToken(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+func (m *AuthPreAuthMock) CountAttempt(token string, lifeTime time.Duration) (int64, error) {
+	args := m.Called(token, lifeTime)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *AuthPreAuthMock) DeleteToken(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *AuthPreAuthMock) UseStep(userId string, step int64, lifeTime time.Duration) (bool, error) {
+	args := m.Called(userId, step, lifeTime)
+	return args.Bool(0), args.Error(1)
+}
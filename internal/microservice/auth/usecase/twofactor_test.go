@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	authServiceModels "backend/internal/microservice/auth/models"
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"backend/pkg/totp"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentCode(t *testing.T) string {
+	code, err := totp.Code(testSecret, time.Now())
+	require.NoError(t, err)
+	return code
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		userId    string
+		attempts  int64
+		lock      time.Duration
+		failures  int64
+		unused    bool
+		recovery  bool
+		outputId  string
+		outputErr error
+	}{
+		{
+			name:     "TOTP code",
+			userId:   "1",
+			attempts: 1,
+			unused:   true,
+			outputId: "1",
+		},
+		{
+			name:      "Used TOTP code",
+			userId:    "1",
+			attempts:  1,
+			outputErr: error2.ErrTwoFactorCode,
+		},
+		{
+			name:     "Recovery code",
+			code:     "ABCDE-fghij",
+			userId:   "1",
+			attempts: 1,
+			recovery: true,
+			outputId: "1",
+		},
+		{
+			name:      "Wrong recovery code",
+			code:      "abcde-fghij",
+			userId:    "1",
+			attempts:  1,
+			outputErr: error2.ErrTwoFactorCode,
+		},
+		{
+			name:      "Unknown token",
+			outputErr: error2.ErrPreAuthToken,
+		},
+		{
+			name:      "Too many attempts",
+			userId:    "1",
+			attempts:  maxTwoFactorTries + 1,
+			unused:    true,
+			outputErr: error2.ErrTooManyRequests,
+		},
+		{
+			name:      "Locked login",
+			userId:    "1",
+			lock:      time.Minute,
+			outputErr: error2.LoginLocked(time.Minute),
+		},
+		{
+			name:      "Wrong code locks the login",
+			code:      "abcde-fghij",
+			userId:    "1",
+			attempts:  1,
+			failures:  defaultLoginFreeAttempts + 1,
+			outputErr: error2.LoginLocked(loginDelay),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			twoFactorRepositoryMock := new(AuthTwoFactorMock)
+			preAuthRepositoryMock := new(AuthPreAuthMock)
+			loginLimitMock := new(AuthLoginLimitMock)
+			code := test.code
+			if code == "" {
+				code = currentCode(t)
+			}
+			preAuthRepositoryMock.On("CheckToken", "token").Return(test.userId, nil)
+			preAuthRepositoryMock.On("CountAttempt", "token", preAuthLifeTime).Return(test.attempts, nil)
+			preAuthRepositoryMock.On("DeleteToken", "token").Return(nil)
+			preAuthRepositoryMock.On("UseStep", "1", mock.Anything, usedStepLifeTime).Return(test.unused, nil)
+			twoFactorRepositoryMock.On("Get", "1").Return(&authServiceModels.TwoFactor{
+				UserId:  "1",
+				Secret:  testSecret,
+				Enabled: true,
+			}, nil)
+			twoFactorRepositoryMock.On("UseRecoveryCode", "1", hashRecoveryCode("abcdefghij")).Return(test.recovery, nil)
+			userRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", Mail: "test@mail.ru"}, nil)
+			loginLimitMock.On("LockTime", "account:test@mail.ru").Return(test.lock, nil)
+			loginLimitMock.On("LockTime", "ip:1.2.3.4").Return(time.Duration(0), nil)
+			loginLimitMock.On("CountFailure", "account:test@mail.ru", defaultLoginWindow).Return(test.failures, nil)
+			loginLimitMock.On("CountFailure", "ip:1.2.3.4", defaultLoginWindow).Return(int64(1), nil)
+			loginLimitMock.On("Lock", "account:test@mail.ru", mock.Anything).Return(nil)
+			loginLimitMock.On("ResetFailures", "account:test@mail.ru").Return(nil)
+
+			useCaseTest := NewService(userRepositoryMock, nil, nil, twoFactorRepositoryMock, preAuthRepositoryMock, nil, nil, nil, loginLimitMock)
+			in := &protoAuth.TwoFactorLogin{
+				PreAuthToken: "token",
+				Code:         code,
+				IP:           "1.2.3.4",
+			}
+			res, err := useCaseTest.VerifyTwoFactor(context.Background(), in)
+			assert.Equal(t, test.outputErr, err)
+			assert.Equal(t, test.outputId, res.ID)
+			if test.outputId != "" || test.outputErr == error2.ErrTooManyRequests {
+				preAuthRepositoryMock.AssertCalled(t, "DeleteToken", "token")
+			} else {
+				preAuthRepositoryMock.AssertNotCalled(t, "DeleteToken", "token")
+			}
+			// The failures of the account are forgotten only after the second factor
+			if test.outputId != "" {
+				loginLimitMock.AssertCalled(t, "ResetFailures", "account:test@mail.ru")
+			} else {
+				loginLimitMock.AssertNotCalled(t, "ResetFailures", "account:test@mail.ru")
+			}
+			// Wrong codes are counted like wrong passwords
+			if test.outputErr == error2.ErrTwoFactorCode || test.failures > 0 {
+				loginLimitMock.AssertCalled(t, "CountFailure", "account:test@mail.ru", defaultLoginWindow)
+				loginLimitMock.AssertCalled(t, "CountFailure", "ip:1.2.3.4", defaultLoginWindow)
+			} else {
+				loginLimitMock.AssertNotCalled(t, "CountFailure", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestSetupTwoFactor(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		userRepositoryMock := new(AuthRepoMock)
+		twoFactorRepositoryMock := new(AuthTwoFactorMock)
+		userRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", Mail: "test@mail.ru"}, nil)
+		twoFactorRepositoryMock.On("SetSecret", "1", mock.Anything).Return(!enabled, nil)
+
+		useCaseTest := NewService(userRepositoryMock, nil, nil, twoFactorRepositoryMock, nil, nil, nil, nil, nil)
+		res, err := useCaseTest.SetupTwoFactor(context.Background(), &protoAuth.UserId{ID: "1"})
+		if enabled {
+			assert.Equal(t, error2.ErrTwoFactorEnabled, err)
+			continue
+		}
+		assert.NoError(t, err)
+		twoFactorRepositoryMock.AssertCalled(t, "SetSecret", "1", res.Secret)
+		assert.True(t, strings.HasPrefix(res.URI, "otpauth://totp/BMSTUSA:test@mail.ru?"))
+		assert.Contains(t, res.URI, "secret="+res.Secret)
+	}
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	tests := []struct {
+		name      string
+		twoFactor *authServiceModels.TwoFactor
+		code      string
+		outputErr error
+	}{
+		{
+			name:      "Confirmed",
+			twoFactor: &authServiceModels.TwoFactor{UserId: "1", Secret: testSecret},
+		},
+		{
+			name:      "Wrong code",
+			twoFactor: &authServiceModels.TwoFactor{UserId: "1", Secret: testSecret},
+			code:      "abcde-fghij",
+			outputErr: error2.ErrTwoFactorCode,
+		},
+		{
+			name:      "Not set up",
+			outputErr: error2.ErrTwoFactorDisabled,
+		},
+		{
+			name:      "Already enabled",
+			twoFactor: &authServiceModels.TwoFactor{UserId: "1", Secret: testSecret, Enabled: true},
+			outputErr: error2.ErrTwoFactorEnabled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			twoFactorRepositoryMock := new(AuthTwoFactorMock)
+			preAuthRepositoryMock := new(AuthPreAuthMock)
+			code := test.code
+			if code == "" {
+				code = currentCode(t)
+			}
+			twoFactorRepositoryMock.On("Get", "1").Return(test.twoFactor, nil)
+			twoFactorRepositoryMock.On("Enable", "1", mock.Anything).Return(true, nil)
+			preAuthRepositoryMock.On("UseStep", "1", mock.Anything, usedStepLifeTime).Return(true, nil)
+
+			useCaseTest := NewService(nil, nil, nil, twoFactorRepositoryMock, preAuthRepositoryMock, nil, nil, nil, nil)
+			in := &protoAuth.TwoFactorCode{
+				UserId: "1",
+				Code:   code,
+			}
+			res, err := useCaseTest.ConfirmTwoFactor(context.Background(), in)
+			assert.Equal(t, test.outputErr, err)
+			if test.outputErr != nil {
+				twoFactorRepositoryMock.AssertNotCalled(t, "Enable", "1", mock.Anything)
+				return
+			}
+			require.Len(t, res.Codes, recoveryCodesCount)
+			hashes := make([]string, len(res.Codes))
+			for i, recoveryCode := range res.Codes {
+				assert.Len(t, recoveryCode, recoveryCodeLength+1)
+				hashes[i] = hashRecoveryCode(recoveryCode)
+			}
+			twoFactorRepositoryMock.AssertCalled(t, "Enable", "1", hashes)
+		})
+	}
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	for _, recovery := range []bool{true, false} {
+		twoFactorRepositoryMock := new(AuthTwoFactorMock)
+		twoFactorRepositoryMock.On("Get", "1").Return(&authServiceModels.TwoFactor{UserId: "1", Secret: testSecret, Enabled: true}, nil)
+		twoFactorRepositoryMock.On("UseRecoveryCode", "1", hashRecoveryCode("abcdefghij")).Return(recovery, nil)
+		twoFactorRepositoryMock.On("Disable", "1").Return(nil)
+
+		useCaseTest := NewService(nil, nil, nil, twoFactorRepositoryMock, nil, nil, nil, nil, nil)
+		in := &protoAuth.TwoFactorCode{
+			UserId: "1",
+			Code:   "abcde-fghij",
+		}
+		_, err := useCaseTest.DisableTwoFactor(context.Background(), in)
+		if recovery {
+			assert.NoError(t, err)
+			twoFactorRepositoryMock.AssertCalled(t, "Disable", "1")
+		} else {
+			assert.Equal(t, error2.ErrTwoFactorCode, err)
+			twoFactorRepositoryMock.AssertNotCalled(t, "Disable", "1")
+		}
+	}
+}
+
+func TestRegenerateRecoveryCodes(t *testing.T) {
+	twoFactorRepositoryMock := new(AuthTwoFactorMock)
+	preAuthRepositoryMock := new(AuthPreAuthMock)
+	twoFactorRepositoryMock.On("Get", "1").Return(&authServiceModels.TwoFactor{UserId: "1", Secret: testSecret, Enabled: true}, nil)
+	twoFactorRepositoryMock.On("ReplaceRecoveryCodes", "1", mock.Anything).Return(nil)
+	preAuthRepositoryMock.On("UseStep", "1", mock.Anything, usedStepLifeTime).Return(true, nil)
+
+	useCaseTest := NewService(nil, nil, nil, twoFactorRepositoryMock, preAuthRepositoryMock, nil, nil, nil, nil)
+	in := &protoAuth.TwoFactorCode{
+		UserId: "1",
+		Code:   currentCode(t),
+	}
+	res, err := useCaseTest.RegenerateRecoveryCodes(context.Background(), in)
+	assert.NoError(t, err)
+	assert.Len(t, res.Codes, recoveryCodesCount)
+}
+
+func TestDisabledTwoFactorCode(t *testing.T) {
+	twoFactorRepositoryMock := new(AuthTwoFactorMock)
+	twoFactorRepositoryMock.On("Get", "1").Return((*authServiceModels.TwoFactor)(nil), nil)
+
+	useCaseTest := NewService(nil, nil, nil, twoFactorRepositoryMock, nil, nil, nil, nil, nil)
+	_, err := useCaseTest.DisableTwoFactor(context.Background(), &protoAuth.TwoFactorCode{UserId: "1", Code: "123456"})
+	assert.Equal(t, error2.ErrTwoFactorDisabled, err)
+}
@@ -2,53 +2,141 @@ package usecase
 
 import (
 	interfaces2 "backend/internal/microservice/auth/interfaces"
+	"backend/internal/microservice/auth/oauth"
 	protoAuth "backend/internal/microservice/auth/proto"
 	"backend/internal/models"
-	"backend/internal/utils"
+	error2 "backend/internal/service/auth/error"
+	"backend/internal/service/email"
+	log "backend/pkg/logger"
+	"backend/pkg/password"
 	"context"
 )
 
-//const logMessage = "microservice auth"
+const logMessage = "microservice:auth:usecase:"
 
 type authService struct {
 	authUserRepository    interfaces2.UserRepository
 	authSessionRepository interfaces2.SessionRepository
+	authResetRepository   interfaces2.ResetRepository
+	// Two-factor authentication settings and the logins waiting for the second factor
+	authTwoFactorRepository interfaces2.TwoFactorRepository
+	authPreAuthRepository   interfaces2.PreAuthRepository
+	// Accounts of the identity providers and the logins in progress at them
+	authIdentityRepository   interfaces2.IdentityRepository
+	authOAuthStateRepository interfaces2.OAuthStateRepository
+	oauthProviders           map[string]*oauth.Provider
+	// Failed login counters and lockouts
+	authLoginLimitRepository interfaces2.LoginLimitRepository
+	sendEmail                func(theme, htmlTemplate string, info []*models.Info)
 }
 
-func NewService(authUserRepository interfaces2.UserRepository, authSessionRepository interfaces2.SessionRepository) *authService {
+func NewService(authUserRepository interfaces2.UserRepository, authSessionRepository interfaces2.SessionRepository, authResetRepository interfaces2.ResetRepository,
+	authTwoFactorRepository interfaces2.TwoFactorRepository, authPreAuthRepository interfaces2.PreAuthRepository,
+	authIdentityRepository interfaces2.IdentityRepository, authOAuthStateRepository interfaces2.OAuthStateRepository,
+	oauthProviders map[string]*oauth.Provider, authLoginLimitRepository interfaces2.LoginLimitRepository) *authService {
 	return &authService{
-		authUserRepository:    authUserRepository,
-		authSessionRepository: authSessionRepository,
+		authUserRepository:       authUserRepository,
+		authSessionRepository:    authSessionRepository,
+		authResetRepository:      authResetRepository,
+		authTwoFactorRepository:  authTwoFactorRepository,
+		authPreAuthRepository:    authPreAuthRepository,
+		authIdentityRepository:   authIdentityRepository,
+		authOAuthStateRepository: authOAuthStateRepository,
+		oauthProviders:           oauthProviders,
+		authLoginLimitRepository: authLoginLimitRepository,
+		sendEmail:                email.SendEmail,
 	}
 }
 
 func (s *authService) SignUp(ctx context.Context, in *protoAuth.SignUpRequest) (*protoAuth.UserId, error) {
 
+	hashedPassword, err := password.Hash(in.Password)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
 	newUser := models.User{
 		Name:     in.Name,
 		Surname:  in.Surname,
 		Mail:     in.Mail,
-		Password: utils.CreatePasswordHash(in.Password),
+		Password: hashedPassword,
 	}
 
 	userId, err := s.authUserRepository.CreateUser(&newUser)
 	if err != nil {
 		return &protoAuth.UserId{}, err
 	}
+	newUser.ID = userId
+	// The account is created anyway, the link can be sent again later
+	err = s.sendVerification(&newUser)
+	if err != nil {
+		log.Error(logMessage+"SignUp:err =", err)
+	}
 
 	out := &protoAuth.UserId{ID: userId}
 	return out, nil
 }
 
-func (s *authService) SignIn(ctx context.Context, in *protoAuth.SignInRequest) (*protoAuth.UserId, error) {
+func (s *authService) SignIn(ctx context.Context, in *protoAuth.SignInRequest) (*protoAuth.SignInResponse, error) {
 
-	u, err := s.authUserRepository.GetUser(in.Mail, utils.CreatePasswordHash(in.Password))
+	message := logMessage + "SignIn:"
+	err := s.checkLoginLock(in.Mail, in.IP)
 	if err != nil {
-		return &protoAuth.UserId{}, err
+		return &protoAuth.SignInResponse{}, err
+	}
+	u, err := s.authUserRepository.GetUser(in.Mail)
+	if err == error2.ErrUserNotFound {
+		password.VerifyDummy(in.Password)
+		return &protoAuth.SignInResponse{}, s.signInFailed(nil, in)
+	}
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	// A wrong password is reported the same way as an unknown mail
+	if !password.Verify(u.Password, in.Password) {
+		return &protoAuth.SignInResponse{}, s.signInFailed(u, in)
+	}
+	if password.NeedsRehash(u.Password) {
+		s.rehashPassword(u, in.Password, message)
+	}
+	twoFactor, err := s.authTwoFactorRepository.Get(u.ID)
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	// The session is created only after VerifyTwoFactor, the failures are forgotten there too,
+	// otherwise the codes could be guessed with the known password
+	if twoFactor != nil && twoFactor.Enabled {
+		token, err := s.startTwoFactor(u.ID)
+		if err != nil {
+			return &protoAuth.SignInResponse{}, err
+		}
+		return &protoAuth.SignInResponse{PreAuthToken: token}, nil
 	}
+	s.loginSucceeded(in.Mail)
 
-	out := &protoAuth.UserId{
+	out := &protoAuth.SignInResponse{
 		ID: u.ID,
 	}
 	return out, nil
 }
+
+// signInFailed returns ErrUserNotFound, or the lock error if the failure has locked the login
+func (s *authService) signInFailed(u *models.User, in *protoAuth.SignInRequest) error {
+	err := s.loginFailed(u, in.Mail, in.IP)
+	if err != nil {
+		return err
+	}
+	return error2.ErrUserNotFound
+}
+
+// Replaces a legacy or outdated hash after a successful login, failures do not block the login
+func (s *authService) rehashPassword(u *models.User, plain string, message string) {
+	hashedPassword, err := password.Hash(plain)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return
+	}
+	err = s.authUserRepository.UpdatePassword(u.ID, u.Password, hashedPassword)
+	if err != nil {
+		log.Error(message+"err =", err)
+	}
+}
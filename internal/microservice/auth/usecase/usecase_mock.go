@@ -14,7 +14,32 @@ func (m *AuthRepoMock) CreateUser(data *models.User) (string, error) {
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *AuthRepoMock) GetUser(mail, password string) (*models.User, error) {
-	args := m.Called(mail, password)
+func (m *AuthRepoMock) GetUser(mail string) (*models.User, error) {
+	args := m.Called(mail)
 	return args.Get(0).(*models.User), args.Error(1)
 }
+
+func (m *AuthRepoMock) UpdatePassword(userId, oldHash, newHash string) error {
+	args := m.Called(userId, oldHash, newHash)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) ResetPassword(userId, hash string) error {
+	args := m.Called(userId, hash)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) GetUserById(userId string) (*models.User, error) {
+	args := m.Called(userId)
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *AuthRepoMock) GetUserRole(userId string) (string, error) {
+	args := m.Called(userId)
+	return args.String(0), args.Error(1)
+}
+
+func (m *AuthRepoMock) VerifyUser(userId, mail string) (bool, error) {
+	args := m.Called(userId, mail)
+	return args.Bool(0), args.Error(1)
+}
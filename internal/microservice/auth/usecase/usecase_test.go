@@ -1,27 +1,34 @@
 package usecase
 
 import (
+	authServiceModels "backend/internal/microservice/auth/models"
 	protoAuth "backend/internal/microservice/auth/proto"
 	"backend/internal/models"
-	"backend/internal/utils"
+	error2 "backend/internal/service/auth/error"
+	"backend/pkg/password"
 	"context"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 )
 
 func TestSignUp(t *testing.T) {
+	t.Setenv("VERIFYSECRET", "secret")
 	authRepositoryMock := new(AuthRepoMock)
 
-	newUser := &models.User{
-		Name:     "Artyom",
-		Surname:  "Shirshov",
-		Mail:     "test@mail.ru",
-		Password: utils.CreatePasswordHash("12345678"),
-	}
+	newUser := mock.MatchedBy(func(u *models.User) bool {
+		return u.Name == "Artyom" && u.Surname == "Shirshov" && u.Mail == "test@mail.ru" &&
+			!password.NeedsRehash(u.Password) && password.Verify(u.Password, "12345678")
+	})
 	expUserId := "1"
 	authRepositoryMock.On("CreateUser", newUser).Return(expUserId, nil)
 
-	useCaseTest := NewService(authRepositoryMock, nil)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil, nil, nil, nil)
+	sent := make(chan *models.Info, 1)
+	useCaseTest.sendEmail = func(theme, htmlTemplate string, info []*models.Info) {
+		sent <- info[0]
+	}
 
 	ctx := context.Background()
 	protoSignUp := &protoAuth.SignUpRequest{
@@ -35,31 +42,132 @@ func TestSignUp(t *testing.T) {
 	assert.Equal(t, "1", userId)
 	assert.NoError(t, err)
 	authRepositoryMock.AssertExpectations(t)
+	// A verification link is sent to the new user
+	select {
+	case info := <-sent:
+		assert.Equal(t, "test@mail.ru", info.Mail)
+		assert.Contains(t, info.Link, "?token=")
+	case <-time.After(time.Second):
+		t.Fatal("verification email was not sent")
+	}
 }
 
 func TestSignIn(t *testing.T) {
-	authRepositoryMock := new(AuthRepoMock)
-	password := "12345678"
-	newUser := &models.User{
-		ID:       "1",
-		Name:     "Artyom",
-		Surname:  "Shirshov",
-		Mail:     "test@mail.ru",
-		Password: utils.CreatePasswordHash(password),
+	hash, err := password.Hash("12345678")
+	assert.NoError(t, err)
+	// SHA-256 of "12345678", the format used before bcrypt
+	legacyHash := "ef797c8118f02dfb649607dd5d3f8c7623048c9c063d532cc95c5ed7a898a64f"
+
+	tests := []struct {
+		name      string
+		hash      string
+		password  string
+		getErr    error
+		rehash    bool
+		rehashErr error
+		outputId  string
+		outputErr error
+	}{
+		{
+			name:     "Bcrypt",
+			hash:     hash,
+			password: "12345678",
+			outputId: "1",
+		},
+		{
+			name:     "Legacy hash is upgraded",
+			hash:     legacyHash,
+			password: "12345678",
+			rehash:   true,
+			outputId: "1",
+		},
+		{
+			name:      "Failed upgrade does not block login",
+			hash:      legacyHash,
+			password:  "12345678",
+			rehash:    true,
+			rehashErr: error2.ErrPostgres,
+			outputId:  "1",
+		},
+		{
+			name:      "Wrong password",
+			hash:      hash,
+			password:  "87654321",
+			outputErr: error2.ErrUserNotFound,
+		},
+		{
+			name:      "Wrong password for legacy hash",
+			hash:      legacyHash,
+			password:  "87654321",
+			outputErr: error2.ErrUserNotFound,
+		},
+		{
+			name:      "Unknown mail",
+			password:  "12345678",
+			getErr:    error2.ErrUserNotFound,
+			outputErr: error2.ErrUserNotFound,
+		},
 	}
 
-	authRepositoryMock.On("GetUser", newUser.Mail, newUser.Password).Return(newUser, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authRepositoryMock := new(AuthRepoMock)
+			var u *models.User
+			if test.getErr == nil {
+				u = &models.User{
+					ID:       "1",
+					Name:     "Artyom",
+					Surname:  "Shirshov",
+					Mail:     "test@mail.ru",
+					Password: test.hash,
+				}
+			}
+			authRepositoryMock.On("GetUser", "test@mail.ru").Return(u, test.getErr)
+			if test.rehash {
+				newHash := mock.MatchedBy(func(h string) bool {
+					return !password.NeedsRehash(h) && password.Verify(h, test.password)
+				})
+				authRepositoryMock.On("UpdatePassword", "1", test.hash, newHash).Return(test.rehashErr)
+			}
 
-	useCaseTest := NewService(authRepositoryMock, nil)
+			twoFactorRepositoryMock := new(AuthTwoFactorMock)
+			twoFactorRepositoryMock.On("Get", "1").Return((*authServiceModels.TwoFactor)(nil), nil)
 
-	ctx := context.Background()
+			useCaseTest := NewService(authRepositoryMock, nil, nil, twoFactorRepositoryMock, nil, nil, nil, nil, newLoginLimitMock())
+			protoSignIn := &protoAuth.SignInRequest{
+				Mail:     "test@mail.ru",
+				Password: test.password,
+			}
+			protoUserId, err := useCaseTest.SignIn(context.Background(), protoSignIn)
+			assert.Equal(t, test.outputErr, err, test.name)
+			assert.Equal(t, test.outputId, protoUserId.ID, test.name)
+			authRepositoryMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSignInWithTwoFactor(t *testing.T) {
+	hash, err := password.Hash("12345678")
+	assert.NoError(t, err)
+	authRepositoryMock := new(AuthRepoMock)
+	twoFactorRepositoryMock := new(AuthTwoFactorMock)
+	preAuthRepositoryMock := new(AuthPreAuthMock)
+	authRepositoryMock.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Password: hash}, nil)
+	twoFactorRepositoryMock.On("Get", "1").Return(&authServiceModels.TwoFactor{UserId: "1", Enabled: true}, nil)
+	preAuthRepositoryMock.On("CreateToken", mock.Anything, "1", preAuthLifeTime).Return(nil)
+
+	loginLimitMock := newLoginLimitMock()
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, twoFactorRepositoryMock, preAuthRepositoryMock, nil, nil, nil, loginLimitMock)
 	protoSignIn := &protoAuth.SignInRequest{
 		Mail:     "test@mail.ru",
 		Password: "12345678",
 	}
-	protoUserId, err := useCaseTest.SignIn(ctx, protoSignIn)
-	userId := protoUserId.ID
-	assert.Equal(t, "1", userId)
+	res, err := useCaseTest.SignIn(context.Background(), protoSignIn)
 	assert.NoError(t, err)
-	authRepositoryMock.AssertExpectations(t)
+	assert.Empty(t, res.ID)
+	assert.Len(t, res.PreAuthToken, 2*preAuthTokenLength)
+	preAuthRepositoryMock.AssertCalled(t, "CreateToken", res.PreAuthToken, "1", preAuthLifeTime)
+	// The password alone does not forget the failures
+	loginLimitMock.AssertNotCalled(t, "ResetFailures", mock.Anything)
 }
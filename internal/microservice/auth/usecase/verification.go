@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	log "backend/pkg/logger"
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Keeps csrf tokens from being accepted as verification links and vice versa
+	verificationAudience   = "email_verification"
+	verificationLifeTime   = time.Hour * 24 * 3
+	defaultVerificationURL = "https://bmstusa.ru/verify"
+	verificationEmailTheme = "Подтверждение почты"
+)
+
+var ErrVerifySecret = errors.New("verification secret is not set")
+
+// Anyone could sign the links with an empty secret, so it is an error and not a default
+func verifySecret() ([]byte, error) {
+	secretWord := os.Getenv("VERIFYSECRET")
+	if secretWord == "" {
+		return nil, ErrVerifySecret
+	}
+	return []byte(secretWord), nil
+}
+
+func generateVerificationToken(userId, mail string) (string, error) {
+	secret, err := verifySecret()
+	if err != nil {
+		return "", err
+	}
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+		ID:        userId,
+		Subject:   mail,
+		Audience:  jwt.ClaimStrings{verificationAudience},
+		ExpiresAt: jwt.At(time.Now().Add(verificationLifeTime)),
+	})
+	return jwtToken.SignedString(secret)
+}
+
+// Returns the user id and the mail the token was issued for
+func parseVerificationToken(susToken string) (string, string, error) {
+	secret, err := verifySecret()
+	if err != nil {
+		return "", "", err
+	}
+	claims := &jwt.StandardClaims{}
+	token, err := jwt.ParseWithClaims(susToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrHashUnavailable
+		}
+		return secret, nil
+	}, jwt.WithAudience(verificationAudience))
+	if err != nil || !token.Valid {
+		return "", "", error2.ErrVerificationToken
+	}
+	// Tokens without an audience pass the parser, so it is checked once more
+	if claims.Audience == nil || claims.ExpiresAt == nil || claims.ID == "" {
+		return "", "", error2.ErrVerificationToken
+	}
+	return claims.ID, claims.Subject, nil
+}
+
+func verificationLink(token string) string {
+	link := viper.GetString("verification.url")
+	if link == "" {
+		link = defaultVerificationURL
+	}
+	return link + "?token=" + url.QueryEscape(token)
+}
+
+func (s *authService) sendVerification(u *models.User) error {
+	token, err := generateVerificationToken(u.ID, u.Mail)
+	if err != nil {
+		return err
+	}
+	receiver := &models.Info{
+		Name: u.Name,
+		Mail: u.Mail,
+		Link: verificationLink(token),
+	}
+	go s.sendEmail(verificationEmailTheme, viper.GetString("reg_html"), []*models.Info{receiver})
+	return nil
+}
+
+func (s *authService) ConfirmEmail(ctx context.Context, in *protoAuth.VerificationToken) (*protoAuth.UserId, error) {
+	message := logMessage + "ConfirmEmail:"
+	log.Debug(message + "started")
+	userId, mail, err := parseVerificationToken(in.Token)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	ok, err := s.authUserRepository.VerifyUser(userId, mail)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	if !ok {
+		return &protoAuth.UserId{}, error2.ErrVerificationToken
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.UserId{ID: userId}, nil
+}
+
+func (s *authService) ResendVerification(ctx context.Context, in *protoAuth.UserId) (*protoAuth.Success, error) {
+	message := logMessage + "ResendVerification:"
+	log.Debug(message + "started")
+	u, err := s.authUserRepository.GetUserById(in.ID)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if u.Verified {
+		return &protoAuth.Success{}, error2.ErrAlreadyVerified
+	}
+	err = s.sendVerification(u)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	log.Debug(message + "ended")
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+func (s *authService) IsVerified(ctx context.Context, in *protoAuth.UserId) (*protoAuth.Verified, error) {
+	u, err := s.authUserRepository.GetUserById(in.ID)
+	if err != nil {
+		return &protoAuth.Verified{}, err
+	}
+	return &protoAuth.Verified{Verified: u.Verified}, nil
+}
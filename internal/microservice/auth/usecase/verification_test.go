@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	protoAuth "backend/internal/microservice/auth/proto"
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVerificationToken(t *testing.T) {
+	// Even with the same secret the tokens are not interchangeable
+	t.Setenv("VERIFYSECRET", "secret")
+	t.Setenv("CSRF_KEYS", "1:secret")
+	valid, err := generateVerificationToken("1", "test@mail.ru")
+	assert.NoError(t, err)
+	csrf, err := generateCsrfToken("1", "session")
+	assert.NoError(t, err)
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+		ID:        "1",
+		Subject:   "test@mail.ru",
+		Audience:  jwt.ClaimStrings{verificationAudience},
+		ExpiresAt: jwt.At(time.Now().Add(-time.Hour)),
+	}).SignedString([]byte(os.Getenv("VERIFYSECRET")))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		token  string
+		userId string
+		mail   string
+		err    error
+	}{
+		{"Valid", valid, "1", "test@mail.ru", nil},
+		{"Csrf token", csrf, "", "", error2.ErrVerificationToken},
+		{"Expired", expired, "", "", error2.ErrVerificationToken},
+		{"Tampered", valid[:len(valid)-2] + "xx", "", "", error2.ErrVerificationToken},
+		{"Empty", "", "", "", error2.ErrVerificationToken},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userId, mail, err := parseVerificationToken(test.token)
+			assert.Equal(t, test.err, err, test.name)
+			assert.Equal(t, test.userId, userId, test.name)
+			assert.Equal(t, test.mail, mail, test.name)
+		})
+	}
+
+	// A verification token can not be used as a csrf token
+	keys, err := csrfKeys()
+	assert.NoError(t, err)
+	_, err = parseToken(valid, keys)
+	assert.Error(t, err)
+}
+
+func TestVerificationTokenWithoutSecret(t *testing.T) {
+	t.Setenv("VERIFYSECRET", "")
+	_, err := generateVerificationToken("1", "test@mail.ru")
+	assert.Equal(t, ErrVerifySecret, err)
+
+	// A token signed with the empty key is not accepted either
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+		ID:        "1",
+		Subject:   "test@mail.ru",
+		Audience:  jwt.ClaimStrings{verificationAudience},
+		ExpiresAt: jwt.At(time.Now().Add(time.Hour)),
+	}).SignedString([]byte(""))
+	assert.NoError(t, err)
+	userId, _, err := parseVerificationToken(forged)
+	assert.Equal(t, ErrVerifySecret, err)
+	assert.Equal(t, "", userId)
+}
+
+func TestConfirmEmail(t *testing.T) {
+	t.Setenv("VERIFYSECRET", "secret")
+	token, err := generateVerificationToken("1", "test@mail.ru")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		verified  bool
+		outputErr error
+	}{
+		{"Confirmed", true, nil},
+		{"Mail changed", false, error2.ErrVerificationToken},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			useCaseTest := NewService(userRepositoryMock, nil, nil, nil, nil, nil, nil, nil, nil)
+			userRepositoryMock.On("VerifyUser", "1", "test@mail.ru").Return(test.verified, nil)
+
+			out, err := useCaseTest.ConfirmEmail(context.Background(), &protoAuth.VerificationToken{Token: token})
+			assert.Equal(t, test.outputErr, err, test.name)
+			if test.outputErr == nil {
+				assert.Equal(t, "1", out.ID)
+			}
+			userRepositoryMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	t.Setenv("VERIFYSECRET", "secret")
+	tests := []struct {
+		name      string
+		user      *models.User
+		sent      bool
+		outputErr error
+	}{
+		{
+			name: "Unverified",
+			user: &models.User{ID: "1", Name: "Artyom", Mail: "test@mail.ru"},
+			sent: true,
+		},
+		{
+			name:      "Already verified",
+			user:      &models.User{ID: "1", Name: "Artyom", Mail: "test@mail.ru", Verified: true},
+			outputErr: error2.ErrAlreadyVerified,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userRepositoryMock := new(AuthRepoMock)
+			useCaseTest := NewService(userRepositoryMock, nil, nil, nil, nil, nil, nil, nil, nil)
+			sent := make(chan *models.Info, 1)
+			useCaseTest.sendEmail = func(theme, htmlTemplate string, info []*models.Info) {
+				sent <- info[0]
+			}
+			userRepositoryMock.On("GetUserById", "1").Return(test.user, nil)
+
+			_, err := useCaseTest.ResendVerification(context.Background(), &protoAuth.UserId{ID: "1"})
+			assert.Equal(t, test.outputErr, err, test.name)
+			if !test.sent {
+				assert.Len(t, sent, 0)
+				return
+			}
+			select {
+			case info := <-sent:
+				assert.Equal(t, "test@mail.ru", info.Mail)
+				token := info.Link[strings.Index(info.Link, "?token=")+len("?token="):]
+				userId, mail, err := parseVerificationToken(token)
+				assert.NoError(t, err)
+				assert.Equal(t, "1", userId)
+				assert.Equal(t, "test@mail.ru", mail)
+			case <-time.After(time.Second):
+				t.Fatal("verification email was not sent")
+			}
+		})
+	}
+}
+
+func TestIsVerified(t *testing.T) {
+	userRepositoryMock := new(AuthRepoMock)
+	useCaseTest := NewService(userRepositoryMock, nil, nil, nil, nil, nil, nil, nil, nil)
+	userRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", Verified: true}, nil)
+
+	out, err := useCaseTest.IsVerified(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.NoError(t, err)
+	assert.True(t, out.Verified)
+}
@@ -4,7 +4,9 @@ import (
 	proto "backend/internal/microservice/event/proto"
 	models "backend/internal/models"
 	"backend/internal/service/event"
+	error2 "backend/internal/service/event/error"
 	"context"
+	"time"
 )
 
 //const logMessage = "microservice:event:client:"
@@ -19,25 +21,87 @@ func NewEventService(repository event.Repository) *EventService {
 	}
 }
 
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, error2.ErrDateFormat
+	}
+	return t, nil
+}
+
+const dateLayout = "2006-01-02"
+
+func formatDates(dates []time.Time) []string {
+	result := make([]string, len(dates))
+	for i, d := range dates {
+		result[i] = d.Format(dateLayout)
+	}
+	return result
+}
+
+func parseDates(dates []string) []time.Time {
+	var result []time.Time
+	for _, d := range dates {
+		t, err := time.Parse(dateLayout, d)
+		if err == nil {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func parseTimes(times []string) ([]time.Time, error) {
+	result := make([]time.Time, len(times))
+	for i, s := range times {
+		t, err := parseTime(s)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = t
+	}
+	return result, nil
+}
+
 func MakeProtoEvent(e *models.Event) *proto.Event {
 	if e == nil {
 		return &proto.Event{}
 	}
 	return &proto.Event{
-		ID:          e.ID,
-		Title:       e.Title,
-		Description: e.Description,
-		Text:        e.Text,
-		City:        e.City,
-		Category:    e.Category,
-		Viewed:      int32(e.Viewed),
-		ImgUrl:      e.ImgUrl,
-		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
-		Address:     e.Address,
-		AuthorId:    e.AuthorId,
-		IsVisited:   e.IsVisited,
+		ID:               e.ID,
+		Title:            e.Title,
+		Description:      e.Description,
+		Text:             e.Text,
+		City:             e.City,
+		Category:         e.Category,
+		Viewed:           int32(e.Viewed),
+		ImgUrl:           e.ImgUrl,
+		Tag:              e.Tag,
+		StartDate:        formatTime(e.StartDate),
+		EndDate:          formatTime(e.EndDate),
+		TimeZone:         e.TimeZone,
+		Latitude:         e.Latitude,
+		Longitude:        e.Longitude,
+		Address:          e.Address,
+		AuthorId:         e.AuthorId,
+		IsVisited:        e.IsVisited,
+		Snippet:          e.Snippet,
+		Capacity:         int32(e.Capacity),
+		SeatsLeft:        int32(e.SeatsLeft),
+		WaitlistPosition: int32(e.WaitlistPosition),
+		SeriesId:         e.SeriesId,
+		RRule:            e.RRule,
+		ExDates:          formatDates(e.ExDates),
+		UpdatedAt:        formatTime(e.UpdatedAt),
 	}
 }
 
@@ -55,21 +119,63 @@ func MakeProtoEvents(e []*models.Event) *proto.Events {
 }
 
 func MakeModelEvent(out *proto.Event) *models.Event {
+	startDate, _ := parseTime(out.StartDate)
+	endDate, _ := parseTime(out.EndDate)
+	updatedAt, _ := parseTime(out.UpdatedAt)
 	return &models.Event{
-		ID:          out.ID,
-		Title:       out.Title,
-		Description: out.Description,
-		Text:        out.Text,
-		City:        out.City,
-		Category:    out.Category,
-		Viewed:      int(out.Viewed),
-		ImgUrl:      out.ImgUrl,
-		Tag:         out.Tag,
-		Date:        out.Date,
-		Geo:         out.Geo,
-		Address:     out.Address,
-		AuthorId:    out.AuthorId,
-		IsVisited:   out.IsVisited,
+		ID:               out.ID,
+		Title:            out.Title,
+		Description:      out.Description,
+		Text:             out.Text,
+		City:             out.City,
+		Category:         out.Category,
+		Viewed:           int(out.Viewed),
+		ImgUrl:           out.ImgUrl,
+		Tag:              out.Tag,
+		StartDate:        startDate,
+		EndDate:          endDate,
+		TimeZone:         out.TimeZone,
+		Latitude:         out.Latitude,
+		Longitude:        out.Longitude,
+		Address:          out.Address,
+		AuthorId:         out.AuthorId,
+		IsVisited:        out.IsVisited,
+		Snippet:          out.Snippet,
+		Capacity:         int(out.Capacity),
+		SeatsLeft:        int(out.SeatsLeft),
+		WaitlistPosition: int(out.WaitlistPosition),
+		SeriesId:         out.SeriesId,
+		RRule:            out.RRule,
+		ExDates:          parseDates(out.ExDates),
+		UpdatedAt:        updatedAt,
+	}
+}
+
+func MakeProtoEventMap(m *models.EventMap) *proto.EventMap {
+	if m == nil {
+		return &proto.EventMap{}
+	}
+	pins := make([]*proto.MapPin, len(m.Pins))
+	for i, pin := range m.Pins {
+		pins[i] = &proto.MapPin{
+			EventId:   pin.EventId,
+			Title:     pin.Title,
+			Category:  pin.Category,
+			Latitude:  pin.Latitude,
+			Longitude: pin.Longitude,
+		}
+	}
+	clusters := make([]*proto.MapCluster, len(m.Clusters))
+	for i, cluster := range m.Clusters {
+		clusters[i] = &proto.MapCluster{
+			Latitude:  cluster.Latitude,
+			Longitude: cluster.Longitude,
+			Count:     int32(cluster.Count),
+		}
+	}
+	return &proto.EventMap{
+		Pins:     pins,
+		Clusters: clusters,
 	}
 }
 
@@ -123,6 +229,51 @@ func (c *EventService) DeleteEvent(ctx context.Context, in *proto.DeleteEventReq
 	return out, err
 }
 
+func (c *EventService) CreateSeries(ctx context.Context, in *proto.CreateSeriesRequest) (*proto.EventId, error) {
+	modelEvent := MakeModelEvent(in.Event)
+	occurrences, err := parseTimes(in.Occurrences)
+	if err != nil {
+		return &proto.EventId{}, err
+	}
+	eventId, err := c.repository.CreateSeries(modelEvent, occurrences)
+	out := &proto.EventId{
+		ID: eventId,
+	}
+	return out, err
+}
+
+func (c *EventService) GetSeries(ctx context.Context, in *proto.EventId) (*proto.Series, error) {
+	series, err := c.repository.GetSeries(in.ID)
+	if err != nil {
+		return &proto.Series{}, err
+	}
+	out := &proto.Series{
+		ID:       series.ID,
+		AuthorId: series.AuthorId,
+		RRule:    series.RRule,
+		ExDates:  formatDates(series.ExDates),
+		Start:    formatTime(series.Start),
+	}
+	return out, nil
+}
+
+func (c *EventService) UpdateSeries(ctx context.Context, in *proto.UpdateSeriesRequest) (*proto.Empty, error) {
+	modelEvent := MakeModelEvent(in.Event)
+	occurrences, err := parseTimes(in.Occurrences)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+	err = c.repository.UpdateSeries(modelEvent, in.UserId, occurrences)
+	out := &proto.Empty{}
+	return out, err
+}
+
+func (c *EventService) DeleteSeries(ctx context.Context, in *proto.DeleteEventRequest) (*proto.Empty, error) {
+	err := c.repository.DeleteSeries(in.EventId, in.UserId)
+	out := &proto.Empty{}
+	return out, err
+}
+
 func (c *EventService) GetEventById(ctx context.Context, in *proto.EventId) (*proto.Event, error) {
 	eventId := in.ID
 	modelEvent, err := c.repository.GetEventById(eventId)
@@ -130,58 +281,189 @@ func (c *EventService) GetEventById(ctx context.Context, in *proto.EventId) (*pr
 	return out, err
 }
 
+func makeModelPage(limit int32, cursor string) *models.Page {
+	return &models.Page{
+		Limit:  int(limit),
+		Cursor: cursor,
+	}
+}
+
 func (c *EventService) GetEvents(ctx context.Context, in *proto.GetEventsRequest) (*proto.Events, error) {
 	userId := in.UserId
 	title := in.Title
 	category := in.Category
 	city := in.City
-	date := in.Date
 	tags := in.Tags
-	modelEvents, err := c.repository.GetEvents(userId, title, category, city, date, tags)
+	from, err := parseTime(in.From)
+	if err != nil {
+		return &proto.Events{}, err
+	}
+	to, err := parseTime(in.To)
+	if err != nil {
+		return &proto.Events{}, err
+	}
+	var near *models.GeoCircle
+	if in.Near != nil {
+		near = &models.GeoCircle{
+			Latitude:  in.Near.Latitude,
+			Longitude: in.Near.Longitude,
+			RadiusKm:  in.Near.RadiusKm,
+		}
+	}
+	page := makeModelPage(in.Limit, in.Cursor)
+	modelEvents, nextCursor, err := c.repository.GetEvents(userId, title, category, city, from, to, tags, near, page)
 	out := MakeProtoEvents(modelEvents)
+	out.NextCursor = nextCursor
 	return out, err
 }
 
-func (c *EventService) GetVisitedEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
-	userId := in.ID
-	modelEvents, err := c.repository.GetVisitedEvents(userId)
+func (c *EventService) GetVisitedEvents(ctx context.Context, in *proto.GetUserEventsRequest) (*proto.Events, error) {
+	userId := in.UserId
+	page := makeModelPage(in.Limit, in.Cursor)
+	modelEvents, nextCursor, err := c.repository.GetVisitedEvents(userId, page)
 	out := MakeProtoEvents(modelEvents)
+	out.NextCursor = nextCursor
 	return out, err
 }
 
-func (c *EventService) GetCreatedEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
-	userId := in.ID
-	modelEvents, err := c.repository.GetCreatedEvents(userId)
+func (c *EventService) GetCreatedEvents(ctx context.Context, in *proto.GetUserEventsRequest) (*proto.Events, error) {
+	userId := in.UserId
+	page := makeModelPage(in.Limit, in.Cursor)
+	modelEvents, nextCursor, err := c.repository.GetCreatedEvents(userId, page)
 	out := MakeProtoEvents(modelEvents)
+	out.NextCursor = nextCursor
 	return out, err
 }
 
-func (c *EventService) Visit(ctx context.Context, in *proto.VisitRequest) (*proto.Empty, error) {
+func (c *EventService) GetEventsMap(ctx context.Context, in *proto.GetEventsMapRequest) (*proto.EventMap, error) {
+	bbox := &models.BBox{
+		MinLatitude:  in.MinLatitude,
+		MinLongitude: in.MinLongitude,
+		MaxLatitude:  in.MaxLatitude,
+		MaxLongitude: in.MaxLongitude,
+	}
+	result, err := c.repository.GetEventsMap(bbox, in.CellSize)
+	out := MakeProtoEventMap(result)
+	return out, err
+}
+
+func (c *EventService) Visit(ctx context.Context, in *proto.VisitRequest) (*proto.VisitResponse, error) {
 	eventId := in.EventId
 	userId := in.UserId
-	err := c.repository.Visit(eventId, userId)
-	out := &proto.Empty{}
+	position, err := c.repository.Visit(eventId, userId)
+	out := &proto.VisitResponse{
+		WaitlistPosition: int32(position),
+	}
 	return out, err
 }
 
-func (c *EventService) Unvisit(ctx context.Context, in *proto.VisitRequest) (*proto.Empty, error) {
+func (c *EventService) Unvisit(ctx context.Context, in *proto.VisitRequest) (*proto.UnvisitResponse, error) {
 	eventId := in.EventId
 	userId := in.UserId
-	err := c.repository.Unvisit(eventId, userId)
-	out := &proto.Empty{}
+	promotedUserId, err := c.repository.Unvisit(eventId, userId)
+	out := &proto.UnvisitResponse{
+		PromotedUserId: promotedUserId,
+	}
 	return out, err
 }
 
 func (c *EventService) IsVisited(ctx context.Context, in *proto.VisitRequest) (*proto.IsVisitedRequest, error) {
 	eventId := in.EventId
 	userId := in.UserId
-	result, err := c.repository.IsVisited(eventId, userId)
+	result, position, err := c.repository.IsVisited(eventId, userId)
 	out := &proto.IsVisitedRequest{
-		Result: result,
+		Result:           result,
+		WaitlistPosition: int32(position),
+	}
+	return out, err
+}
+
+func (c *EventService) SetRSVP(ctx context.Context, in *proto.SetRSVPRequest) (*proto.SetRSVPResponse, error) {
+	promotedUserId, err := c.repository.SetRSVP(in.EventId, in.UserId, in.Status)
+	out := &proto.SetRSVPResponse{
+		PromotedUserId: promotedUserId,
+	}
+	return out, err
+}
+
+func (c *EventService) GetRSVP(ctx context.Context, in *proto.VisitRequest) (*proto.RSVP, error) {
+	result, err := c.repository.GetRSVP(in.EventId, in.UserId)
+	if err != nil {
+		return &proto.RSVP{}, err
+	}
+	out := &proto.RSVP{
+		Status:           result.Status,
+		WaitlistPosition: int32(result.WaitlistPosition),
+	}
+	return out, nil
+}
+
+func MakeProtoInvitation(i *models.Invitation) *proto.Invitation {
+	return &proto.Invitation{
+		ID:         i.ID,
+		EventId:    i.EventId,
+		EventTitle: i.EventTitle,
+		InviterId:  i.InviterId,
+		ReceiverId: i.ReceiverId,
+		Status:     i.Status,
+		CreatedAt:  formatTime(i.CreatedAt),
+	}
+}
+
+func MakeProtoInvitations(i []*models.Invitation) *proto.Invitations {
+	result := make([]*proto.Invitation, len(i))
+	for j, modelInvitation := range i {
+		result[j] = MakeProtoInvitation(modelInvitation)
+	}
+	return &proto.Invitations{
+		Invitations: result,
+	}
+}
+
+func (c *EventService) CreateInvitations(ctx context.Context, in *proto.CreateInvitationsRequest) (*proto.Empty, error) {
+	err := c.repository.CreateInvitations(in.EventId, in.InviterId, in.ReceiverIds)
+	return &proto.Empty{}, err
+}
+
+func (c *EventService) RespondInvitation(ctx context.Context, in *proto.RespondInvitationRequest) (*proto.Invitation, error) {
+	result, position, err := c.repository.RespondInvitation(in.InvitationId, in.ReceiverId, in.Status)
+	if err != nil {
+		return &proto.Invitation{}, err
+	}
+	out := MakeProtoInvitation(result)
+	out.WaitlistPosition = int32(position)
+	return out, nil
+}
+
+func (c *EventService) GetEventInvitations(ctx context.Context, in *proto.GetInvitationsRequest) (*proto.Invitations, error) {
+	result, err := c.repository.GetEventInvitations(in.EventId, in.UserId)
+	return MakeProtoInvitations(result), err
+}
+
+func (c *EventService) GetUserInvitations(ctx context.Context, in *proto.GetInvitationsRequest) (*proto.Invitations, error) {
+	result, err := c.repository.GetUserInvitations(in.UserId)
+	return MakeProtoInvitations(result), err
+}
+
+func (c *EventService) GetCalendarEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
+	result, err := c.repository.GetCalendarEvents(in.ID)
+	return MakeProtoEvents(result), err
+}
+
+func (c *EventService) GetCalendarToken(ctx context.Context, in *proto.UserId) (*proto.CalendarToken, error) {
+	token, err := c.repository.GetCalendarToken(in.ID)
+	out := &proto.CalendarToken{
+		UserId: in.ID,
+		Token:  token,
 	}
 	return out, err
 }
 
+func (c *EventService) SetCalendarToken(ctx context.Context, in *proto.CalendarToken) (*proto.Empty, error) {
+	err := c.repository.SetCalendarToken(in.UserId, in.Token)
+	return &proto.Empty{}, err
+}
+
 func (c *EventService) GetCities(ctx context.Context, in *proto.Empty) (*proto.GetCitiesRequest, error) {
 	result, err := c.repository.GetCities()
 	out := &proto.GetCitiesRequest{
@@ -29,20 +29,31 @@ type Event struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title       string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Description string   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	Text        string   `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
-	City        string   `protobuf:"bytes,5,opt,name=City,proto3" json:"City,omitempty"`
-	Category    string   `protobuf:"bytes,6,opt,name=Category,proto3" json:"Category,omitempty"`
-	Viewed      int32    `protobuf:"varint,7,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
-	ImgUrl      string   `protobuf:"bytes,8,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
-	Tag         []string `protobuf:"bytes,9,rep,name=Tag,proto3" json:"Tag,omitempty"`
-	Date        string   `protobuf:"bytes,10,opt,name=Date,proto3" json:"Date,omitempty"`
-	Geo         string   `protobuf:"bytes,11,opt,name=Geo,proto3" json:"Geo,omitempty"`
-	Address     string   `protobuf:"bytes,12,opt,name=Address,proto3" json:"Address,omitempty"`
-	AuthorId    string   `protobuf:"bytes,13,opt,name=AuthorId,proto3" json:"AuthorId,omitempty"`
-	IsVisited   bool     `protobuf:"varint,14,opt,name=IsVisited,proto3" json:"IsVisited,omitempty"`
+	ID               string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title            string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description      string   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Text             string   `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	City             string   `protobuf:"bytes,5,opt,name=City,proto3" json:"City,omitempty"`
+	Category         string   `protobuf:"bytes,6,opt,name=Category,proto3" json:"Category,omitempty"`
+	Viewed           int32    `protobuf:"varint,7,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
+	ImgUrl           string   `protobuf:"bytes,8,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
+	Tag              []string `protobuf:"bytes,9,rep,name=Tag,proto3" json:"Tag,omitempty"`
+	Address          string   `protobuf:"bytes,12,opt,name=Address,proto3" json:"Address,omitempty"`
+	AuthorId         string   `protobuf:"bytes,13,opt,name=AuthorId,proto3" json:"AuthorId,omitempty"`
+	IsVisited        bool     `protobuf:"varint,14,opt,name=IsVisited,proto3" json:"IsVisited,omitempty"`
+	StartDate        string   `protobuf:"bytes,15,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	EndDate          string   `protobuf:"bytes,16,opt,name=EndDate,proto3" json:"EndDate,omitempty"`
+	TimeZone         string   `protobuf:"bytes,17,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	Snippet          string   `protobuf:"bytes,18,opt,name=Snippet,proto3" json:"Snippet,omitempty"`
+	Latitude         float64  `protobuf:"fixed64,19,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
+	Longitude        float64  `protobuf:"fixed64,20,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	Capacity         int32    `protobuf:"varint,21,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	SeatsLeft        int32    `protobuf:"varint,22,opt,name=SeatsLeft,proto3" json:"SeatsLeft,omitempty"`
+	WaitlistPosition int32    `protobuf:"varint,23,opt,name=WaitlistPosition,proto3" json:"WaitlistPosition,omitempty"`
+	SeriesId         string   `protobuf:"bytes,24,opt,name=SeriesId,proto3" json:"SeriesId,omitempty"`
+	RRule            string   `protobuf:"bytes,25,opt,name=RRule,proto3" json:"RRule,omitempty"`
+	ExDates          []string `protobuf:"bytes,26,rep,name=ExDates,proto3" json:"ExDates,omitempty"`
+	UpdatedAt        string   `protobuf:"bytes,27,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -140,39 +151,116 @@ func (x *Event) GetTag() []string {
 	return nil
 }
 
-func (x *Event) GetDate() string {
+func (x *Event) GetAddress() string {
 	if x != nil {
-		return x.Date
+		return x.Address
 	}
 	return ""
 }
 
-func (x *Event) GetGeo() string {
+func (x *Event) GetAuthorId() string {
 	if x != nil {
-		return x.Geo
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *Event) GetAddress() string {
+func (x *Event) GetIsVisited() bool {
 	if x != nil {
-		return x.Address
+		return x.IsVisited
+	}
+	return false
+}
+
+func (x *Event) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Event) GetAuthorId() string {
+func (x *Event) GetEndDate() string {
 	if x != nil {
-		return x.AuthorId
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *Event) GetIsVisited() bool {
+func (x *Event) GetTimeZone() string {
 	if x != nil {
-		return x.IsVisited
+		return x.TimeZone
 	}
-	return false
+	return ""
+}
+
+func (x *Event) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Event) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Event) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Event) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Event) GetSeatsLeft() int32 {
+	if x != nil {
+		return x.SeatsLeft
+	}
+	return 0
+}
+
+func (x *Event) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+func (x *Event) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Event) GetRRule() string {
+	if x != nil {
+		return x.RRule
+	}
+	return ""
+}
+
+func (x *Event) GetExDates() []string {
+	if x != nil {
+		return x.ExDates
+	}
+	return nil
+}
+
+func (x *Event) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
 type EventId struct {
@@ -371,17 +459,17 @@ func (x *UpdateEventRequest) GetUserId() string {
 	return ""
 }
 
-type DeleteEventRequest struct {
+type CreateSeriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Event       *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Occurrences []string `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 }
 
-func (x *DeleteEventRequest) Reset() {
-	*x = DeleteEventRequest{}
+func (x *CreateSeriesRequest) Reset() {
+	*x = CreateSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,13 +477,13 @@ func (x *DeleteEventRequest) Reset() {
 	}
 }
 
-func (x *DeleteEventRequest) String() string {
+func (x *CreateSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEventRequest) ProtoMessage() {}
+func (*CreateSeriesRequest) ProtoMessage() {}
 
-func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,40 +495,37 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteEventRequest) GetEventId() string {
+func (x *CreateSeriesRequest) GetEvent() *Event {
 	if x != nil {
-		return x.EventId
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteEventRequest) GetUserId() string {
+func (x *CreateSeriesRequest) GetOccurrences() []string {
 	if x != nil {
-		return x.UserId
+		return x.Occurrences
 	}
-	return ""
+	return nil
 }
 
-type GetEventsRequest struct {
+type UpdateSeriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Title    string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Category string   `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	City     string   `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
-	Date     string   `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	Tags     []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Event       *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	UserId      string   `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Occurrences []string `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
+func (x *UpdateSeriesRequest) Reset() {
+	*x = UpdateSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -448,13 +533,13 @@ func (x *GetEventsRequest) Reset() {
 	}
 }
 
-func (x *GetEventsRequest) String() string {
+func (x *UpdateSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (*UpdateSeriesRequest) ProtoMessage() {}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -466,63 +551,46 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetEventsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetEventsRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *GetEventsRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *GetEventsRequest) GetCity() string {
+func (x *UpdateSeriesRequest) GetEvent() *Event {
 	if x != nil {
-		return x.City
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *GetEventsRequest) GetDate() string {
+func (x *UpdateSeriesRequest) GetUserId() string {
 	if x != nil {
-		return x.Date
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetEventsRequest) GetTags() []string {
+func (x *UpdateSeriesRequest) GetOccurrences() []string {
 	if x != nil {
-		return x.Tags
+		return x.Occurrences
 	}
 	return nil
 }
 
-type Events struct {
+type Series struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	ID       string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AuthorId string   `protobuf:"bytes,2,opt,name=AuthorId,proto3" json:"AuthorId,omitempty"`
+	RRule    string   `protobuf:"bytes,3,opt,name=RRule,proto3" json:"RRule,omitempty"`
+	ExDates  []string `protobuf:"bytes,4,rep,name=ExDates,proto3" json:"ExDates,omitempty"`
+	Start    string   `protobuf:"bytes,5,opt,name=Start,proto3" json:"Start,omitempty"`
 }
 
-func (x *Events) Reset() {
-	*x = Events{}
+func (x *Series) Reset() {
+	*x = Series{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -530,13 +598,13 @@ func (x *Events) Reset() {
 	}
 }
 
-func (x *Events) String() string {
+func (x *Series) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Events) ProtoMessage() {}
+func (*Series) ProtoMessage() {}
 
-func (x *Events) ProtoReflect() protoreflect.Message {
+func (x *Series) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,19 +616,47 @@ func (x *Events) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Events.ProtoReflect.Descriptor instead.
-func (*Events) Descriptor() ([]byte, []int) {
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Events) GetEvents() []*Event {
+func (x *Series) GetID() string {
 	if x != nil {
-		return x.Events
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Series) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Series) GetRRule() string {
+	if x != nil {
+		return x.RRule
+	}
+	return ""
+}
+
+func (x *Series) GetExDates() []string {
+	if x != nil {
+		return x.ExDates
 	}
 	return nil
 }
 
-type VisitRequest struct {
+func (x *Series) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+type DeleteEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -569,8 +665,8 @@ type VisitRequest struct {
 	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *VisitRequest) Reset() {
-	*x = VisitRequest{}
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +674,13 @@ func (x *VisitRequest) Reset() {
 	}
 }
 
-func (x *VisitRequest) String() string {
+func (x *DeleteEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitRequest) ProtoMessage() {}
+func (*DeleteEventRequest) ProtoMessage() {}
 
-func (x *VisitRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,35 +692,44 @@ func (x *VisitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitRequest.ProtoReflect.Descriptor instead.
-func (*VisitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *VisitRequest) GetEventId() string {
+func (x *DeleteEventRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *VisitRequest) GetUserId() string {
+func (x *DeleteEventRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type IsVisitedRequest struct {
+type GetEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	UserId   string     `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Title    string     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Category string     `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	City     string     `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Tags     []string   `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limit    int32      `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor   string     `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	From     string     `protobuf:"bytes,9,opt,name=from,proto3" json:"from,omitempty"`
+	To       string     `protobuf:"bytes,10,opt,name=to,proto3" json:"to,omitempty"`
+	Near     *GeoCircle `protobuf:"bytes,11,opt,name=near,proto3" json:"near,omitempty"`
 }
 
-func (x *IsVisitedRequest) Reset() {
-	*x = IsVisitedRequest{}
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,13 +737,13 @@ func (x *IsVisitedRequest) Reset() {
 	}
 }
 
-func (x *IsVisitedRequest) String() string {
+func (x *GetEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVisitedRequest) ProtoMessage() {}
+func (*GetEventsRequest) ProtoMessage() {}
 
-func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,66 +755,1342 @@ func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVisitedRequest.ProtoReflect.Descriptor instead.
-func (*IsVisitedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *IsVisitedRequest) GetResult() bool {
+func (x *GetEventsRequest) GetUserId() string {
 	if x != nil {
-		return x.Result
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-type GetCitiesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Cities []string `protobuf:"bytes,1,rep,name=Cities,proto3" json:"Cities,omitempty"`
+func (x *GetEventsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *GetCitiesRequest) Reset() {
-	*x = GetCitiesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetEventsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
+	return ""
 }
 
-func (x *GetCitiesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetEventsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
 }
 
-func (*GetCitiesRequest) ProtoMessage() {}
+func (x *GetEventsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
 
-func (x *GetCitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCitiesRequest.ProtoReflect.Descriptor instead.
-func (*GetCitiesRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{10}
+func (x *GetEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
 }
 
-func (x *GetCitiesRequest) GetCities() []string {
+func (x *GetEventsRequest) GetFrom() string {
 	if x != nil {
-		return x.Cities
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-type EmailInfo struct {
+func (x *GetEventsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetNear() *GeoCircle {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+type GeoCircle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm  float64 `protobuf:"fixed64,3,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
+}
+
+func (x *GeoCircle) Reset() {
+	*x = GeoCircle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoCircle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCircle) ProtoMessage() {}
+
+func (x *GeoCircle) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCircle.ProtoReflect.Descriptor instead.
+func (*GeoCircle) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GeoCircle) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoCircle) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoCircle) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type GetEventsMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLatitude  float64 `protobuf:"fixed64,1,opt,name=minLatitude,proto3" json:"minLatitude,omitempty"`
+	MinLongitude float64 `protobuf:"fixed64,2,opt,name=minLongitude,proto3" json:"minLongitude,omitempty"`
+	MaxLatitude  float64 `protobuf:"fixed64,3,opt,name=maxLatitude,proto3" json:"maxLatitude,omitempty"`
+	MaxLongitude float64 `protobuf:"fixed64,4,opt,name=maxLongitude,proto3" json:"maxLongitude,omitempty"`
+	CellSize     float64 `protobuf:"fixed64,5,opt,name=cellSize,proto3" json:"cellSize,omitempty"`
+}
+
+func (x *GetEventsMapRequest) Reset() {
+	*x = GetEventsMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventsMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsMapRequest) ProtoMessage() {}
+
+func (x *GetEventsMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsMapRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsMapRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEventsMapRequest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *GetEventsMapRequest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *GetEventsMapRequest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *GetEventsMapRequest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+func (x *GetEventsMapRequest) GetCellSize() float64 {
+	if x != nil {
+		return x.CellSize
+	}
+	return 0
+}
+
+type MapPin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId   string  `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Title     string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Category  string  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *MapPin) Reset() {
+	*x = MapPin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapPin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapPin) ProtoMessage() {}
+
+func (x *MapPin) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapPin.ProtoReflect.Descriptor instead.
+func (*MapPin) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MapPin) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MapPin) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MapPin) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MapPin) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MapPin) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type MapCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Count     int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MapCluster) Reset() {
+	*x = MapCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapCluster) ProtoMessage() {}
+
+func (x *MapCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapCluster.ProtoReflect.Descriptor instead.
+func (*MapCluster) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MapCluster) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MapCluster) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *MapCluster) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type EventMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pins     []*MapPin     `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
+	Clusters []*MapCluster `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *EventMap) Reset() {
+	*x = EventMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMap) ProtoMessage() {}
+
+func (x *EventMap) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMap.ProtoReflect.Descriptor instead.
+func (*EventMap) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventMap) GetPins() []*MapPin {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *EventMap) GetClusters() []*MapCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type GetUserEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetUserEventsRequest) Reset() {
+	*x = GetUserEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEventsRequest) ProtoMessage() {}
+
+func (x *GetUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Events struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events     []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *Events) Reset() {
+	*x = Events{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Events) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Events) ProtoMessage() {}
+
+func (x *Events) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Events.ProtoReflect.Descriptor instead.
+func (*Events) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Events) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Events) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type VisitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *VisitRequest) Reset() {
+	*x = VisitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitRequest) ProtoMessage() {}
+
+func (x *VisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitRequest.ProtoReflect.Descriptor instead.
+func (*VisitRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VisitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VisitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type IsVisitedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result           bool  `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	WaitlistPosition int32 `protobuf:"varint,2,opt,name=WaitlistPosition,proto3" json:"WaitlistPosition,omitempty"`
+}
+
+func (x *IsVisitedRequest) Reset() {
+	*x = IsVisitedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsVisitedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVisitedRequest) ProtoMessage() {}
+
+func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVisitedRequest.ProtoReflect.Descriptor instead.
+func (*IsVisitedRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IsVisitedRequest) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *IsVisitedRequest) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+type VisitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitlistPosition int32 `protobuf:"varint,1,opt,name=WaitlistPosition,proto3" json:"WaitlistPosition,omitempty"`
+}
+
+func (x *VisitResponse) Reset() {
+	*x = VisitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitResponse) ProtoMessage() {}
+
+func (x *VisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitResponse.ProtoReflect.Descriptor instead.
+func (*VisitResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VisitResponse) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+type UnvisitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromotedUserId string `protobuf:"bytes,1,opt,name=PromotedUserId,proto3" json:"PromotedUserId,omitempty"`
+}
+
+func (x *UnvisitResponse) Reset() {
+	*x = UnvisitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnvisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnvisitResponse) ProtoMessage() {}
+
+func (x *UnvisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnvisitResponse.ProtoReflect.Descriptor instead.
+func (*UnvisitResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnvisitResponse) GetPromotedUserId() string {
+	if x != nil {
+		return x.PromotedUserId
+	}
+	return ""
+}
+
+type SetRSVPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status  string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetRSVPRequest) Reset() {
+	*x = SetRSVPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRSVPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRSVPRequest) ProtoMessage() {}
+
+func (x *SetRSVPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRSVPRequest.ProtoReflect.Descriptor instead.
+func (*SetRSVPRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetRSVPRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetRSVPRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetRSVPRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetRSVPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromotedUserId string `protobuf:"bytes,1,opt,name=PromotedUserId,proto3" json:"PromotedUserId,omitempty"`
+}
+
+func (x *SetRSVPResponse) Reset() {
+	*x = SetRSVPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRSVPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRSVPResponse) ProtoMessage() {}
+
+func (x *SetRSVPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRSVPResponse.ProtoReflect.Descriptor instead.
+func (*SetRSVPResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetRSVPResponse) GetPromotedUserId() string {
+	if x != nil {
+		return x.PromotedUserId
+	}
+	return ""
+}
+
+type RSVP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	WaitlistPosition int32  `protobuf:"varint,2,opt,name=WaitlistPosition,proto3" json:"WaitlistPosition,omitempty"`
+}
+
+func (x *RSVP) Reset() {
+	*x = RSVP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RSVP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RSVP) ProtoMessage() {}
+
+func (x *RSVP) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RSVP.ProtoReflect.Descriptor instead.
+func (*RSVP) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RSVP) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RSVP) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+type CreateInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId     string   `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	InviterId   string   `protobuf:"bytes,2,opt,name=inviterId,proto3" json:"inviterId,omitempty"`
+	ReceiverIds []string `protobuf:"bytes,3,rep,name=receiverIds,proto3" json:"receiverIds,omitempty"`
+}
+
+func (x *CreateInvitationsRequest) Reset() {
+	*x = CreateInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationsRequest) ProtoMessage() {}
+
+func (x *CreateInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateInvitationsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateInvitationsRequest) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *CreateInvitationsRequest) GetReceiverIds() []string {
+	if x != nil {
+		return x.ReceiverIds
+	}
+	return nil
+}
+
+type RespondInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitationId,proto3" json:"invitationId,omitempty"`
+	ReceiverId   string `protobuf:"bytes,2,opt,name=receiverId,proto3" json:"receiverId,omitempty"`
+	Status       string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RespondInvitationRequest) Reset() {
+	*x = RespondInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondInvitationRequest) ProtoMessage() {}
+
+func (x *RespondInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RespondInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *RespondInvitationRequest) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *RespondInvitationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *GetInvitationsRequest) Reset() {
+	*x = GetInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationsRequest) ProtoMessage() {}
+
+func (x *GetInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetInvitationsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetInvitationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EventId    string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	EventTitle string `protobuf:"bytes,3,opt,name=eventTitle,proto3" json:"eventTitle,omitempty"`
+	InviterId  string `protobuf:"bytes,4,opt,name=inviterId,proto3" json:"inviterId,omitempty"`
+	ReceiverId string `protobuf:"bytes,5,opt,name=receiverId,proto3" json:"receiverId,omitempty"`
+	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt  string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// Set only in the response to RespondInvitation
+	WaitlistPosition int32 `protobuf:"varint,8,opt,name=WaitlistPosition,proto3" json:"WaitlistPosition,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Invitation) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Invitation) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *Invitation) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *Invitation) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Invitation) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+type Invitations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *Invitations) Reset() {
+	*x = Invitations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitations) ProtoMessage() {}
+
+func (x *Invitations) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitations.ProtoReflect.Descriptor instead.
+func (*Invitations) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Invitations) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type CalendarToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *CalendarToken) Reset() {
+	*x = CalendarToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarToken) ProtoMessage() {}
+
+func (x *CalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarToken.ProtoReflect.Descriptor instead.
+func (*CalendarToken) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CalendarToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cities []string `protobuf:"bytes,1,rep,name=Cities,proto3" json:"Cities,omitempty"`
+}
+
+func (x *GetCitiesRequest) Reset() {
+	*x = GetCitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitiesRequest) ProtoMessage() {}
+
+func (x *GetCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCitiesRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type EmailInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -723,7 +2104,7 @@ type EmailInfo struct {
 func (x *EmailInfo) Reset() {
 	*x = EmailInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[11]
+		mi := &file_event_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -736,7 +2117,7 @@ func (x *EmailInfo) String() string {
 func (*EmailInfo) ProtoMessage() {}
 
 func (x *EmailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[11]
+	mi := &file_event_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +2130,7 @@ func (x *EmailInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailInfo.ProtoReflect.Descriptor instead.
 func (*EmailInfo) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{11}
+	return file_event_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *EmailInfo) GetName() string {
@@ -791,7 +2172,7 @@ type EmailInfoArray struct {
 func (x *EmailInfoArray) Reset() {
 	*x = EmailInfoArray{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[12]
+		mi := &file_event_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +2185,7 @@ func (x *EmailInfoArray) String() string {
 func (*EmailInfoArray) ProtoMessage() {}
 
 func (x *EmailInfoArray) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[12]
+	mi := &file_event_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +2198,7 @@ func (x *EmailInfoArray) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailInfoArray.ProtoReflect.Descriptor instead.
 func (*EmailInfoArray) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{12}
+	return file_event_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *EmailInfoArray) GetInfoArray() []*EmailInfo {
@@ -836,7 +2217,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[13]
+		mi := &file_event_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +2230,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[13]
+	mi := &file_event_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,14 +2243,14 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{13}
+	return file_event_proto_rawDescGZIP(), []int{33}
 }
 
 var File_event_proto protoreflect.FileDescriptor
 
 var file_event_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x22, 0xcf, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x22, 0xad, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
@@ -883,111 +2264,340 @@ var file_event_proto_rawDesc = []byte{
 	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x10,
 	0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x65, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x47, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x22, 0x19, 0x0a, 0x07, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x44, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x65, 0x61,
+	0x74, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x52, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x0a,
+	0x10, 0x0b, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x22, 0x19, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22,
+	0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x5f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0x77, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x06, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x52, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x52, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x86, 0x02,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
 	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28,
-	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x6f, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x61, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x43, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x06, 0x4d, 0x61,
+	0x70, 0x50, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x0a, 0x4d, 0x61,
+	0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4d, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x70, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5c,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x06,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x40, 0x0a, 0x0c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x56, 0x0a, 0x10, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
+	0x0a, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0d, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0f, 0x55, 0x6e, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x5a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x53, 0x56, 0x50, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x53, 0x56, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x04, 0x52, 0x53, 0x56,
+	0x50, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x76, 0x0a, 0x18, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf6,
+	0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x3d, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x09, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x49, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x22, 0x44,
+	0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x12, 0x32, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xe4, 0x0d,
+	0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a,
+	0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x12, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
 	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x56, 0x69, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x10, 0x49, 0x73,
-	0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x43, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x22, 0x62, 0x0a, 0x09, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x49, 0x6d, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x22, 0x44, 0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x22, 0x07, 0x0a, 0x05,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xe9, 0x05, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49,
-	0x64, 0x12, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56,
-	0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a,
-	0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
-	0x12, 0x34, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x55, 0x6e, 0x76, 0x69, 0x73, 0x69,
-	0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69,
-	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43,
+	0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07,
+	0x55, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x76,
+	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
 	0x0a, 0x09, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x65, 0x76,
 	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
 	0x2e, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x00, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x12, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
-	0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x22,
-	0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x52, 0x53, 0x56, 0x50, 0x12, 0x19,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x53,
+	0x56, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x53, 0x56, 0x50, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x53,
+	0x56, 0x50, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x53, 0x56, 0x50, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x12, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1002,56 +2612,110 @@ func file_event_proto_rawDescGZIP() []byte {
 	return file_event_proto_rawDescData
 }
 
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_event_proto_goTypes = []interface{}{
-	(*Event)(nil),              // 0: eventGrpc.Event
-	(*EventId)(nil),            // 1: eventGrpc.EventId
-	(*AuthorId)(nil),           // 2: eventGrpc.AuthorId
-	(*UserId)(nil),             // 3: eventGrpc.UserId
-	(*UpdateEventRequest)(nil), // 4: eventGrpc.UpdateEventRequest
-	(*DeleteEventRequest)(nil), // 5: eventGrpc.DeleteEventRequest
-	(*GetEventsRequest)(nil),   // 6: eventGrpc.GetEventsRequest
-	(*Events)(nil),             // 7: eventGrpc.Events
-	(*VisitRequest)(nil),       // 8: eventGrpc.VisitRequest
-	(*IsVisitedRequest)(nil),   // 9: eventGrpc.IsVisitedRequest
-	(*GetCitiesRequest)(nil),   // 10: eventGrpc.GetCitiesRequest
-	(*EmailInfo)(nil),          // 11: eventGrpc.EmailInfo
-	(*EmailInfoArray)(nil),     // 12: eventGrpc.EmailInfoArray
-	(*Empty)(nil),              // 13: eventGrpc.Empty
+	(*Event)(nil),                    // 0: eventGrpc.Event
+	(*EventId)(nil),                  // 1: eventGrpc.EventId
+	(*AuthorId)(nil),                 // 2: eventGrpc.AuthorId
+	(*UserId)(nil),                   // 3: eventGrpc.UserId
+	(*UpdateEventRequest)(nil),       // 4: eventGrpc.UpdateEventRequest
+	(*CreateSeriesRequest)(nil),      // 5: eventGrpc.CreateSeriesRequest
+	(*UpdateSeriesRequest)(nil),      // 6: eventGrpc.UpdateSeriesRequest
+	(*Series)(nil),                   // 7: eventGrpc.Series
+	(*DeleteEventRequest)(nil),       // 8: eventGrpc.DeleteEventRequest
+	(*GetEventsRequest)(nil),         // 9: eventGrpc.GetEventsRequest
+	(*GeoCircle)(nil),                // 10: eventGrpc.GeoCircle
+	(*GetEventsMapRequest)(nil),      // 11: eventGrpc.GetEventsMapRequest
+	(*MapPin)(nil),                   // 12: eventGrpc.MapPin
+	(*MapCluster)(nil),               // 13: eventGrpc.MapCluster
+	(*EventMap)(nil),                 // 14: eventGrpc.EventMap
+	(*GetUserEventsRequest)(nil),     // 15: eventGrpc.GetUserEventsRequest
+	(*Events)(nil),                   // 16: eventGrpc.Events
+	(*VisitRequest)(nil),             // 17: eventGrpc.VisitRequest
+	(*IsVisitedRequest)(nil),         // 18: eventGrpc.IsVisitedRequest
+	(*VisitResponse)(nil),            // 19: eventGrpc.VisitResponse
+	(*UnvisitResponse)(nil),          // 20: eventGrpc.UnvisitResponse
+	(*SetRSVPRequest)(nil),           // 21: eventGrpc.SetRSVPRequest
+	(*SetRSVPResponse)(nil),          // 22: eventGrpc.SetRSVPResponse
+	(*RSVP)(nil),                     // 23: eventGrpc.RSVP
+	(*CreateInvitationsRequest)(nil), // 24: eventGrpc.CreateInvitationsRequest
+	(*RespondInvitationRequest)(nil), // 25: eventGrpc.RespondInvitationRequest
+	(*GetInvitationsRequest)(nil),    // 26: eventGrpc.GetInvitationsRequest
+	(*Invitation)(nil),               // 27: eventGrpc.Invitation
+	(*Invitations)(nil),              // 28: eventGrpc.Invitations
+	(*CalendarToken)(nil),            // 29: eventGrpc.CalendarToken
+	(*GetCitiesRequest)(nil),         // 30: eventGrpc.GetCitiesRequest
+	(*EmailInfo)(nil),                // 31: eventGrpc.EmailInfo
+	(*EmailInfoArray)(nil),           // 32: eventGrpc.EmailInfoArray
+	(*Empty)(nil),                    // 33: eventGrpc.Empty
 }
 var file_event_proto_depIdxs = []int32{
 	0,  // 0: eventGrpc.UpdateEventRequest.event:type_name -> eventGrpc.Event
-	0,  // 1: eventGrpc.Events.events:type_name -> eventGrpc.Event
-	11, // 2: eventGrpc.EmailInfoArray.infoArray:type_name -> eventGrpc.EmailInfo
-	0,  // 3: eventGrpc.EventService.CreateEvent:input_type -> eventGrpc.Event
-	4,  // 4: eventGrpc.EventService.UpdateEvent:input_type -> eventGrpc.UpdateEventRequest
-	5,  // 5: eventGrpc.EventService.DeleteEvent:input_type -> eventGrpc.DeleteEventRequest
-	1,  // 6: eventGrpc.EventService.GetEventById:input_type -> eventGrpc.EventId
-	6,  // 7: eventGrpc.EventService.GetEvents:input_type -> eventGrpc.GetEventsRequest
-	3,  // 8: eventGrpc.EventService.GetVisitedEvents:input_type -> eventGrpc.UserId
-	3,  // 9: eventGrpc.EventService.GetCreatedEvents:input_type -> eventGrpc.UserId
-	8,  // 10: eventGrpc.EventService.Visit:input_type -> eventGrpc.VisitRequest
-	8,  // 11: eventGrpc.EventService.Unvisit:input_type -> eventGrpc.VisitRequest
-	8,  // 12: eventGrpc.EventService.IsVisited:input_type -> eventGrpc.VisitRequest
-	13, // 13: eventGrpc.EventService.GetCities:input_type -> eventGrpc.Empty
-	1,  // 14: eventGrpc.EventService.EmailNotify:input_type -> eventGrpc.EventId
-	1,  // 15: eventGrpc.EventService.CreateEvent:output_type -> eventGrpc.EventId
-	13, // 16: eventGrpc.EventService.UpdateEvent:output_type -> eventGrpc.Empty
-	13, // 17: eventGrpc.EventService.DeleteEvent:output_type -> eventGrpc.Empty
-	0,  // 18: eventGrpc.EventService.GetEventById:output_type -> eventGrpc.Event
-	7,  // 19: eventGrpc.EventService.GetEvents:output_type -> eventGrpc.Events
-	7,  // 20: eventGrpc.EventService.GetVisitedEvents:output_type -> eventGrpc.Events
-	7,  // 21: eventGrpc.EventService.GetCreatedEvents:output_type -> eventGrpc.Events
-	13, // 22: eventGrpc.EventService.Visit:output_type -> eventGrpc.Empty
-	13, // 23: eventGrpc.EventService.Unvisit:output_type -> eventGrpc.Empty
-	9,  // 24: eventGrpc.EventService.IsVisited:output_type -> eventGrpc.IsVisitedRequest
-	10, // 25: eventGrpc.EventService.GetCities:output_type -> eventGrpc.GetCitiesRequest
-	12, // 26: eventGrpc.EventService.EmailNotify:output_type -> eventGrpc.EmailInfoArray
-	15, // [15:27] is the sub-list for method output_type
-	3,  // [3:15] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	0,  // 1: eventGrpc.CreateSeriesRequest.event:type_name -> eventGrpc.Event
+	0,  // 2: eventGrpc.UpdateSeriesRequest.event:type_name -> eventGrpc.Event
+	10, // 3: eventGrpc.GetEventsRequest.near:type_name -> eventGrpc.GeoCircle
+	12, // 4: eventGrpc.EventMap.pins:type_name -> eventGrpc.MapPin
+	13, // 5: eventGrpc.EventMap.clusters:type_name -> eventGrpc.MapCluster
+	0,  // 6: eventGrpc.Events.events:type_name -> eventGrpc.Event
+	27, // 7: eventGrpc.Invitations.invitations:type_name -> eventGrpc.Invitation
+	31, // 8: eventGrpc.EmailInfoArray.infoArray:type_name -> eventGrpc.EmailInfo
+	0,  // 9: eventGrpc.EventService.CreateEvent:input_type -> eventGrpc.Event
+	4,  // 10: eventGrpc.EventService.UpdateEvent:input_type -> eventGrpc.UpdateEventRequest
+	8,  // 11: eventGrpc.EventService.DeleteEvent:input_type -> eventGrpc.DeleteEventRequest
+	5,  // 12: eventGrpc.EventService.CreateSeries:input_type -> eventGrpc.CreateSeriesRequest
+	1,  // 13: eventGrpc.EventService.GetSeries:input_type -> eventGrpc.EventId
+	6,  // 14: eventGrpc.EventService.UpdateSeries:input_type -> eventGrpc.UpdateSeriesRequest
+	8,  // 15: eventGrpc.EventService.DeleteSeries:input_type -> eventGrpc.DeleteEventRequest
+	1,  // 16: eventGrpc.EventService.GetEventById:input_type -> eventGrpc.EventId
+	9,  // 17: eventGrpc.EventService.GetEvents:input_type -> eventGrpc.GetEventsRequest
+	15, // 18: eventGrpc.EventService.GetVisitedEvents:input_type -> eventGrpc.GetUserEventsRequest
+	15, // 19: eventGrpc.EventService.GetCreatedEvents:input_type -> eventGrpc.GetUserEventsRequest
+	11, // 20: eventGrpc.EventService.GetEventsMap:input_type -> eventGrpc.GetEventsMapRequest
+	17, // 21: eventGrpc.EventService.Visit:input_type -> eventGrpc.VisitRequest
+	17, // 22: eventGrpc.EventService.Unvisit:input_type -> eventGrpc.VisitRequest
+	17, // 23: eventGrpc.EventService.IsVisited:input_type -> eventGrpc.VisitRequest
+	21, // 24: eventGrpc.EventService.SetRSVP:input_type -> eventGrpc.SetRSVPRequest
+	17, // 25: eventGrpc.EventService.GetRSVP:input_type -> eventGrpc.VisitRequest
+	24, // 26: eventGrpc.EventService.CreateInvitations:input_type -> eventGrpc.CreateInvitationsRequest
+	25, // 27: eventGrpc.EventService.RespondInvitation:input_type -> eventGrpc.RespondInvitationRequest
+	26, // 28: eventGrpc.EventService.GetEventInvitations:input_type -> eventGrpc.GetInvitationsRequest
+	26, // 29: eventGrpc.EventService.GetUserInvitations:input_type -> eventGrpc.GetInvitationsRequest
+	3,  // 30: eventGrpc.EventService.GetCalendarEvents:input_type -> eventGrpc.UserId
+	3,  // 31: eventGrpc.EventService.GetCalendarToken:input_type -> eventGrpc.UserId
+	29, // 32: eventGrpc.EventService.SetCalendarToken:input_type -> eventGrpc.CalendarToken
+	33, // 33: eventGrpc.EventService.GetCities:input_type -> eventGrpc.Empty
+	1,  // 34: eventGrpc.EventService.EmailNotify:input_type -> eventGrpc.EventId
+	1,  // 35: eventGrpc.EventService.CreateEvent:output_type -> eventGrpc.EventId
+	33, // 36: eventGrpc.EventService.UpdateEvent:output_type -> eventGrpc.Empty
+	33, // 37: eventGrpc.EventService.DeleteEvent:output_type -> eventGrpc.Empty
+	1,  // 38: eventGrpc.EventService.CreateSeries:output_type -> eventGrpc.EventId
+	7,  // 39: eventGrpc.EventService.GetSeries:output_type -> eventGrpc.Series
+	33, // 40: eventGrpc.EventService.UpdateSeries:output_type -> eventGrpc.Empty
+	33, // 41: eventGrpc.EventService.DeleteSeries:output_type -> eventGrpc.Empty
+	0,  // 42: eventGrpc.EventService.GetEventById:output_type -> eventGrpc.Event
+	16, // 43: eventGrpc.EventService.GetEvents:output_type -> eventGrpc.Events
+	16, // 44: eventGrpc.EventService.GetVisitedEvents:output_type -> eventGrpc.Events
+	16, // 45: eventGrpc.EventService.GetCreatedEvents:output_type -> eventGrpc.Events
+	14, // 46: eventGrpc.EventService.GetEventsMap:output_type -> eventGrpc.EventMap
+	19, // 47: eventGrpc.EventService.Visit:output_type -> eventGrpc.VisitResponse
+	20, // 48: eventGrpc.EventService.Unvisit:output_type -> eventGrpc.UnvisitResponse
+	18, // 49: eventGrpc.EventService.IsVisited:output_type -> eventGrpc.IsVisitedRequest
+	22, // 50: eventGrpc.EventService.SetRSVP:output_type -> eventGrpc.SetRSVPResponse
+	23, // 51: eventGrpc.EventService.GetRSVP:output_type -> eventGrpc.RSVP
+	33, // 52: eventGrpc.EventService.CreateInvitations:output_type -> eventGrpc.Empty
+	27, // 53: eventGrpc.EventService.RespondInvitation:output_type -> eventGrpc.Invitation
+	28, // 54: eventGrpc.EventService.GetEventInvitations:output_type -> eventGrpc.Invitations
+	28, // 55: eventGrpc.EventService.GetUserInvitations:output_type -> eventGrpc.Invitations
+	16, // 56: eventGrpc.EventService.GetCalendarEvents:output_type -> eventGrpc.Events
+	29, // 57: eventGrpc.EventService.GetCalendarToken:output_type -> eventGrpc.CalendarToken
+	33, // 58: eventGrpc.EventService.SetCalendarToken:output_type -> eventGrpc.Empty
+	30, // 59: eventGrpc.EventService.GetCities:output_type -> eventGrpc.GetCitiesRequest
+	32, // 60: eventGrpc.EventService.EmailNotify:output_type -> eventGrpc.EmailInfoArray
+	35, // [35:61] is the sub-list for method output_type
+	9,  // [9:35] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -1072,8 +2736,104 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventId); i {
+		file_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Series); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1084,8 +2844,8 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorId); i {
+		file_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoCircle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1096,8 +2856,8 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserId); i {
+		file_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsMapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1108,8 +2868,8 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEventRequest); i {
+		file_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapPin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1120,8 +2880,8 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEventRequest); i {
+		file_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1132,8 +2892,8 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsRequest); i {
+		file_event_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1144,7 +2904,19 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Events); i {
 			case 0:
 				return &v.state
@@ -1156,7 +2928,7 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VisitRequest); i {
 			case 0:
 				return &v.state
@@ -1168,7 +2940,7 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsVisitedRequest); i {
 			case 0:
 				return &v.state
@@ -1180,7 +2952,139 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnvisitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRSVPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRSVPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RSVP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCitiesRequest); i {
 			case 0:
 				return &v.state
@@ -1192,7 +3096,7 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmailInfo); i {
 			case 0:
 				return &v.state
@@ -1204,7 +3108,7 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmailInfoArray); i {
 			case 0:
 				return &v.state
@@ -1216,7 +3120,7 @@ func file_event_proto_init() {
 				return nil
 			}
 		}
-		file_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_event_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -1235,7 +3139,7 @@ func file_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1264,13 +3168,27 @@ type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventId, error)
 	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*EventId, error)
+	GetSeries(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Series, error)
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteSeries(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error)
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error)
-	GetVisitedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
-	GetCreatedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
-	Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
-	Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetVisitedEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	GetCreatedEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	GetEventsMap(ctx context.Context, in *GetEventsMapRequest, opts ...grpc.CallOption) (*EventMap, error)
+	Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error)
+	Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*UnvisitResponse, error)
 	IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error)
+	SetRSVP(ctx context.Context, in *SetRSVPRequest, opts ...grpc.CallOption) (*SetRSVPResponse, error)
+	GetRSVP(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*RSVP, error)
+	CreateInvitations(ctx context.Context, in *CreateInvitationsRequest, opts ...grpc.CallOption) (*Empty, error)
+	RespondInvitation(ctx context.Context, in *RespondInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	GetEventInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*Invitations, error)
+	GetUserInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*Invitations, error)
+	GetCalendarEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
+	GetCalendarToken(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*CalendarToken, error)
+	SetCalendarToken(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*Empty, error)
 	GetCities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCitiesRequest, error)
 	EmailNotify(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EmailInfoArray, error)
 }
@@ -1301,72 +3219,198 @@ func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventReq
 	return out, nil
 }
 
-func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/DeleteEvent", in, out, opts...)
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/DeleteEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*EventId, error) {
+	out := new(EventId)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/CreateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetSeries(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Series, error) {
+	out := new(Series)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/UpdateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteSeries(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/DeleteSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEventById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetVisitedEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetVisitedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetCreatedEvents(ctx context.Context, in *GetUserEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetCreatedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventsMap(ctx context.Context, in *GetEventsMapRequest, opts ...grpc.CallOption) (*EventMap, error) {
+	out := new(EventMap)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEventsMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error) {
+	out := new(VisitResponse)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/Visit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*UnvisitResponse, error) {
+	out := new(UnvisitResponse)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/Unvisit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error) {
+	out := new(IsVisitedRequest)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/IsVisited", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetRSVP(ctx context.Context, in *SetRSVPRequest, opts ...grpc.CallOption) (*SetRSVPResponse, error) {
+	out := new(SetRSVPResponse)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/SetRSVP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetRSVP(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*RSVP, error) {
+	out := new(RSVP)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetRSVP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error) {
-	out := new(Event)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEventById", in, out, opts...)
+func (c *eventServiceClient) CreateInvitations(ctx context.Context, in *CreateInvitationsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/CreateInvitations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEvents", in, out, opts...)
+func (c *eventServiceClient) RespondInvitation(ctx context.Context, in *RespondInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/RespondInvitation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetVisitedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetVisitedEvents", in, out, opts...)
+func (c *eventServiceClient) GetEventInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*Invitations, error) {
+	out := new(Invitations)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetEventInvitations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetCreatedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetCreatedEvents", in, out, opts...)
+func (c *eventServiceClient) GetUserInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*Invitations, error) {
+	out := new(Invitations)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetUserInvitations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/Visit", in, out, opts...)
+func (c *eventServiceClient) GetCalendarEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetCalendarEvents", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/Unvisit", in, out, opts...)
+func (c *eventServiceClient) GetCalendarToken(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*CalendarToken, error) {
+	out := new(CalendarToken)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/GetCalendarToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error) {
-	out := new(IsVisitedRequest)
-	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/IsVisited", in, out, opts...)
+func (c *eventServiceClient) SetCalendarToken(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.EventService/SetCalendarToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1396,13 +3440,27 @@ type EventServiceServer interface {
 	CreateEvent(context.Context, *Event) (*EventId, error)
 	UpdateEvent(context.Context, *UpdateEventRequest) (*Empty, error)
 	DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error)
+	CreateSeries(context.Context, *CreateSeriesRequest) (*EventId, error)
+	GetSeries(context.Context, *EventId) (*Series, error)
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*Empty, error)
+	DeleteSeries(context.Context, *DeleteEventRequest) (*Empty, error)
 	GetEventById(context.Context, *EventId) (*Event, error)
 	GetEvents(context.Context, *GetEventsRequest) (*Events, error)
-	GetVisitedEvents(context.Context, *UserId) (*Events, error)
-	GetCreatedEvents(context.Context, *UserId) (*Events, error)
-	Visit(context.Context, *VisitRequest) (*Empty, error)
-	Unvisit(context.Context, *VisitRequest) (*Empty, error)
+	GetVisitedEvents(context.Context, *GetUserEventsRequest) (*Events, error)
+	GetCreatedEvents(context.Context, *GetUserEventsRequest) (*Events, error)
+	GetEventsMap(context.Context, *GetEventsMapRequest) (*EventMap, error)
+	Visit(context.Context, *VisitRequest) (*VisitResponse, error)
+	Unvisit(context.Context, *VisitRequest) (*UnvisitResponse, error)
 	IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error)
+	SetRSVP(context.Context, *SetRSVPRequest) (*SetRSVPResponse, error)
+	GetRSVP(context.Context, *VisitRequest) (*RSVP, error)
+	CreateInvitations(context.Context, *CreateInvitationsRequest) (*Empty, error)
+	RespondInvitation(context.Context, *RespondInvitationRequest) (*Invitation, error)
+	GetEventInvitations(context.Context, *GetInvitationsRequest) (*Invitations, error)
+	GetUserInvitations(context.Context, *GetInvitationsRequest) (*Invitations, error)
+	GetCalendarEvents(context.Context, *UserId) (*Events, error)
+	GetCalendarToken(context.Context, *UserId) (*CalendarToken, error)
+	SetCalendarToken(context.Context, *CalendarToken) (*Empty, error)
 	GetCities(context.Context, *Empty) (*GetCitiesRequest, error)
 	EmailNotify(context.Context, *EventId) (*EmailInfoArray, error)
 }
@@ -1420,27 +3478,69 @@ func (*UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEven
 func (*UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (*UnimplementedEventServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*EventId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (*UnimplementedEventServiceServer) GetSeries(context.Context, *EventId) (*Series, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (*UnimplementedEventServiceServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (*UnimplementedEventServiceServer) DeleteSeries(context.Context, *DeleteEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
 func (*UnimplementedEventServiceServer) GetEventById(context.Context, *EventId) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventById not implemented")
 }
 func (*UnimplementedEventServiceServer) GetEvents(context.Context, *GetEventsRequest) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
-func (*UnimplementedEventServiceServer) GetVisitedEvents(context.Context, *UserId) (*Events, error) {
+func (*UnimplementedEventServiceServer) GetVisitedEvents(context.Context, *GetUserEventsRequest) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVisitedEvents not implemented")
 }
-func (*UnimplementedEventServiceServer) GetCreatedEvents(context.Context, *UserId) (*Events, error) {
+func (*UnimplementedEventServiceServer) GetCreatedEvents(context.Context, *GetUserEventsRequest) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCreatedEvents not implemented")
 }
-func (*UnimplementedEventServiceServer) Visit(context.Context, *VisitRequest) (*Empty, error) {
+func (*UnimplementedEventServiceServer) GetEventsMap(context.Context, *GetEventsMapRequest) (*EventMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsMap not implemented")
+}
+func (*UnimplementedEventServiceServer) Visit(context.Context, *VisitRequest) (*VisitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Visit not implemented")
 }
-func (*UnimplementedEventServiceServer) Unvisit(context.Context, *VisitRequest) (*Empty, error) {
+func (*UnimplementedEventServiceServer) Unvisit(context.Context, *VisitRequest) (*UnvisitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unvisit not implemented")
 }
 func (*UnimplementedEventServiceServer) IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsVisited not implemented")
 }
+func (*UnimplementedEventServiceServer) SetRSVP(context.Context, *SetRSVPRequest) (*SetRSVPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRSVP not implemented")
+}
+func (*UnimplementedEventServiceServer) GetRSVP(context.Context, *VisitRequest) (*RSVP, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRSVP not implemented")
+}
+func (*UnimplementedEventServiceServer) CreateInvitations(context.Context, *CreateInvitationsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitations not implemented")
+}
+func (*UnimplementedEventServiceServer) RespondInvitation(context.Context, *RespondInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInvitation not implemented")
+}
+func (*UnimplementedEventServiceServer) GetEventInvitations(context.Context, *GetInvitationsRequest) (*Invitations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventInvitations not implemented")
+}
+func (*UnimplementedEventServiceServer) GetUserInvitations(context.Context, *GetInvitationsRequest) (*Invitations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInvitations not implemented")
+}
+func (*UnimplementedEventServiceServer) GetCalendarEvents(context.Context, *UserId) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarEvents not implemented")
+}
+func (*UnimplementedEventServiceServer) GetCalendarToken(context.Context, *UserId) (*CalendarToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarToken not implemented")
+}
+func (*UnimplementedEventServiceServer) SetCalendarToken(context.Context, *CalendarToken) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCalendarToken not implemented")
+}
 func (*UnimplementedEventServiceServer) GetCities(context.Context, *Empty) (*GetCitiesRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCities not implemented")
 }
@@ -1506,6 +3606,78 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/CreateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetSeries(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/UpdateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/DeleteSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteSeries(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetEventById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EventId)
 	if err := dec(in); err != nil {
@@ -1543,7 +3715,7 @@ func _EventService_GetEvents_Handler(srv interface{}, ctx context.Context, dec f
 }
 
 func _EventService_GetVisitedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+	in := new(GetUserEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1555,13 +3727,13 @@ func _EventService_GetVisitedEvents_Handler(srv interface{}, ctx context.Context
 		FullMethod: "/eventGrpc.EventService/GetVisitedEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetVisitedEvents(ctx, req.(*UserId))
+		return srv.(EventServiceServer).GetVisitedEvents(ctx, req.(*GetUserEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _EventService_GetCreatedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+	in := new(GetUserEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1573,7 +3745,25 @@ func _EventService_GetCreatedEvents_Handler(srv interface{}, ctx context.Context
 		FullMethod: "/eventGrpc.EventService/GetCreatedEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetCreatedEvents(ctx, req.(*UserId))
+		return srv.(EventServiceServer).GetCreatedEvents(ctx, req.(*GetUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventsMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventsMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetEventsMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventsMap(ctx, req.(*GetEventsMapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1632,6 +3822,168 @@ func _EventService_IsVisited_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_SetRSVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRSVPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetRSVP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/SetRSVP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetRSVP(ctx, req.(*SetRSVPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetRSVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetRSVP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetRSVP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetRSVP(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/CreateInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateInvitations(ctx, req.(*CreateInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RespondInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RespondInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/RespondInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RespondInvitation(ctx, req.(*RespondInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetEventInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventInvitations(ctx, req.(*GetInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetUserInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserInvitations(ctx, req.(*GetInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCalendarEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCalendarEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetCalendarEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCalendarEvents(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/GetCalendarToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCalendarToken(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.EventService/SetCalendarToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetCalendarToken(ctx, req.(*CalendarToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1684,6 +4036,22 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _EventService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _EventService_GetSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _EventService_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _EventService_DeleteSeries_Handler,
+		},
 		{
 			MethodName: "GetEventById",
 			Handler:    _EventService_GetEventById_Handler,
@@ -1700,6 +4068,10 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCreatedEvents",
 			Handler:    _EventService_GetCreatedEvents_Handler,
 		},
+		{
+			MethodName: "GetEventsMap",
+			Handler:    _EventService_GetEventsMap_Handler,
+		},
 		{
 			MethodName: "Visit",
 			Handler:    _EventService_Visit_Handler,
@@ -1712,6 +4084,42 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsVisited",
 			Handler:    _EventService_IsVisited_Handler,
 		},
+		{
+			MethodName: "SetRSVP",
+			Handler:    _EventService_SetRSVP_Handler,
+		},
+		{
+			MethodName: "GetRSVP",
+			Handler:    _EventService_GetRSVP_Handler,
+		},
+		{
+			MethodName: "CreateInvitations",
+			Handler:    _EventService_CreateInvitations_Handler,
+		},
+		{
+			MethodName: "RespondInvitation",
+			Handler:    _EventService_RespondInvitation_Handler,
+		},
+		{
+			MethodName: "GetEventInvitations",
+			Handler:    _EventService_GetEventInvitations_Handler,
+		},
+		{
+			MethodName: "GetUserInvitations",
+			Handler:    _EventService_GetUserInvitations_Handler,
+		},
+		{
+			MethodName: "GetCalendarEvents",
+			Handler:    _EventService_GetCalendarEvents_Handler,
+		},
+		{
+			MethodName: "GetCalendarToken",
+			Handler:    _EventService_GetCalendarToken_Handler,
+		},
+		{
+			MethodName: "SetCalendarToken",
+			Handler:    _EventService_SetCalendarToken_Handler,
+		},
 		{
 			MethodName: "GetCities",
 			Handler:    _EventService_GetCities_Handler,
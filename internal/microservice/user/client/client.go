@@ -26,6 +26,8 @@ func MakeProtoUser(u *models.User) *proto.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
 
@@ -38,6 +40,8 @@ func MakeModelUser(u *proto.User) *models.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
 
@@ -79,6 +83,12 @@ func (c *UserService) UpdateUserPassword(ctx context.Context, in *proto.UpdateUs
 	return out, err
 }
 
+func (c *UserService) UpdateUserRole(ctx context.Context, in *proto.User) (*proto.Empty, error) {
+	err := c.repository.UpdateUserRole(in.ID, in.Role)
+	out := &proto.Empty{}
+	return out, err
+}
+
 func (c *UserService) GetSubscribers(ctx context.Context, in *proto.UserId) (*proto.Users, error) {
 	userId := in.ID
 	modelUsers, err := c.repository.GetSubscribers(userId)
@@ -101,11 +111,18 @@ func (c *UserService) GetFriends(ctx context.Context, in *proto.GetFriendsReques
 	return out, err
 }
 
-func (c *UserService) GetVisitors(ctx context.Context, in *proto.EventId) (*proto.Users, error) {
+func (c *UserService) GetVisitors(ctx context.Context, in *proto.EventId) (*proto.Visitors, error) {
 	eventId := in.ID
-	modelUsers, err := c.repository.GetVisitors(eventId)
-	out := MakeProtoUsers(modelUsers)
-	return out, err
+	modelVisitors, err := c.repository.GetVisitors(eventId)
+	if err != nil {
+		return &proto.Visitors{}, err
+	}
+	out := &proto.Visitors{
+		Going:    MakeProtoUsers(modelVisitors.Going).Users,
+		Maybe:    MakeProtoUsers(modelVisitors.Maybe).Users,
+		Declined: MakeProtoUsers(modelVisitors.Declined).Users,
+	}
+	return out, nil
 }
 
 func (c *UserService) Subscribe(ctx context.Context, in *proto.SubscribeRequest) (*proto.Empty, error) {
@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"backend/internal/models"
 	response "backend/internal/response"
 	"backend/internal/service/auth"
+	error2 "backend/internal/service/auth/error"
 	log "backend/pkg/logger"
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -13,6 +16,16 @@ import (
 
 const logMessage = "middleware:"
 
+// The routes under /api are the first API version: they answer with 200 and have the status only in the body,
+// unless the client asks for the real status with X-API-Version: 2. The routes under /api/v2 always have it.
+const (
+	apiVersionHeader = "X-API-Version"
+	legacyAPIVersion = "1"
+	apiV2Version     = "2"
+	apiPathPrefix    = "/api/"
+	apiV2PathPrefix  = "/api/v2/"
+)
+
 var allowedOrigins = []string{"", "http://127.0.0.1:8080", "http://127.0.0.1:3000", "https://bmstusssa.herokuapp.com", "https://bmstusa.ru"}
 
 type Middlewares struct {
@@ -56,11 +69,11 @@ func (m *Middlewares) CORS(next http.Handler) http.Handler {
 		w.Header().Set("Access-Control-Allow-Origin", origin)
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 		w.Header().Set("Access-Control-Allow-Headers",
-			"Accept,Content-Type,Content-Length,Accept-Encoding,X-CSRF-Token,Authorization")
+			"Accept,Content-Type,Content-Length,Accept-Encoding,X-CSRF-Token,Authorization,X-API-Version")
 		w.Header().Set("Content-Type", "application/json")
 		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,DELETE,PUT,OPTIONS,HEAD")
 		w.Header().Set("Access-Control-Expose-Headers",
-			"Accept,Accept-Encoding,X-CSRF-Token,Authorization")
+			"Accept,Accept-Encoding,X-CSRF-Token,Authorization,X-API-Version")
 		if r.Method == http.MethodOptions {
 			return
 		}
@@ -68,6 +81,33 @@ func (m *Middlewares) CORS(next http.Handler) http.Handler {
 	})
 }
 
+// legacyStatusWriter sends 200 whatever status the handler sets
+type legacyStatusWriter struct {
+	http.ResponseWriter
+}
+
+func (w *legacyStatusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(http.StatusOK)
+}
+
+// APIVersion goes before the metrics, so that they get the real status for the old clients too.
+// The version of the response is sent back in X-API-Version.
+func (m *Middlewares) APIVersion(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, apiPathPrefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, apiV2PathPrefix) || r.Header.Get(apiVersionHeader) == apiV2Version {
+			w.Header().Set(apiVersionHeader, apiV2Version)
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set(apiVersionHeader, legacyAPIVersion)
+		next.ServeHTTP(&legacyStatusWriter{ResponseWriter: w}, r)
+	})
+}
+
 func (m *Middlewares) Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -98,21 +138,57 @@ func (m *Middlewares) Auth(next http.Handler) http.Handler {
 		if !response.CheckIfNoError(&w, err, message) {
 			return
 		}
-		userId, err := m.authService.CheckSession(cookie.Value)
+		userId, role, err := m.authService.CheckSession(cookie.Value)
 		if !response.CheckIfNoError(&w, err, message) {
 			return
 		}
 		key := response.CtxString("userId")
 		userCtx := context.WithValue(r.Context(), key, userId)
+		userCtx = context.WithValue(userCtx, response.CtxString("role"), role)
 		next.ServeHTTP(w, r.WithContext(userCtx))
 	})
 }
 
+// Authorize goes after Auth and lets through only the roles that have all the permissions
+func (m *Middlewares) Authorize(permissions ...models.Permission) mux.MiddlewareFunc {
+	message := logMessage + "Authorize:"
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, _ := r.Context().Value(response.CtxString("role")).(string)
+			for _, permission := range permissions {
+				if !models.Can(role, permission) {
+					response.CheckIfNoError(&w, error2.ErrNotAllowed, message)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Verified goes after Auth and lets only users with a confirmed mail through
+func (m *Middlewares) Verified(next http.Handler) http.Handler {
+	message := logMessage + "Verified:"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, _ := r.Context().Value(response.CtxString("userId")).(string)
+		err := m.authService.CheckVerified(userId)
+		if !response.CheckIfNoError(&w, err, message) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (m *Middlewares) CSRF(next http.Handler) http.Handler {
 	message := logMessage + "CSRF:"
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		gottenToken := (*r).Header.Get("X-CSRF-Token")
-		userId, err := m.authService.CheckToken(gottenToken)
+		// The token is valid only with the session it was given for
+		var sessionId string
+		if cookie, err := r.Cookie("session_id"); err == nil {
+			sessionId = cookie.Value
+		}
+		userId, err := m.authService.CheckToken(gottenToken, sessionId)
 		if !response.CheckIfNoError(&w, err, message) {
 			return
 		}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
 	"backend/internal/service/auth/usecase"
 	"bytes"
 	"errors"
@@ -76,8 +78,8 @@ func TestUtils(t *testing.T) {
 		}
 		req.AddCookie(cookie)
 
-		useCaseMock.On("CheckSession", cookie.Value).Return("", test.err)
-		useCaseMock.On("CheckToken", req.Header.Get("X-CSRF-Token")).Return("", test.err)
+		useCaseMock.On("CheckSession", cookie.Value).Return("", "", test.err)
+		useCaseMock.On("CheckToken", req.Header.Get("X-CSRF-Token"), cookie.Value).Return("", test.err)
 
 		r.ServeHTTP(w, req)
 		if test.id != 3 {
@@ -86,3 +88,180 @@ func TestUtils(t *testing.T) {
 		}
 	}
 }
+
+var verifiedTests = []struct {
+	id     int
+	err    error
+	called bool
+}{
+	{
+		1,
+		nil,
+		true,
+	},
+	{
+		2,
+		error2.ErrNotVerified,
+		false,
+	},
+}
+
+func TestVerified(t *testing.T) {
+	for _, test := range verifiedTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+		useCaseMock.On("CheckSession", "test").Return("1", models.RoleUser, nil)
+		useCaseMock.On("CheckVerified", "1").Return(test.err)
+
+		called := false
+		r := mux.NewRouter()
+		r.Handle("/test", middlewares.Auth(middlewares.Verified(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		})))).Methods("POST")
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/test", bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "test",
+		})
+
+		r.ServeHTTP(w, req)
+		require.Equal(t, test.called, called, test.id)
+		if !test.called {
+			require.Contains(t, w.Body.String(), `"status":403`)
+		}
+		useCaseMock.AssertExpectations(t)
+	}
+}
+
+var authorizeTests = []struct {
+	id          int
+	role        string
+	permissions []models.Permission
+	called      bool
+}{
+	{
+		1,
+		models.RoleUser,
+		nil,
+		true,
+	},
+	{
+		2,
+		models.RoleUser,
+		[]models.Permission{models.PermissionModerateEvents},
+		false,
+	},
+	{
+		3,
+		models.RoleModerator,
+		[]models.Permission{models.PermissionModerateEvents},
+		true,
+	},
+	{
+		4,
+		models.RoleModerator,
+		[]models.Permission{models.PermissionModerateEvents, models.PermissionManageRoles},
+		false,
+	},
+	{
+		5,
+		models.RoleAdmin,
+		[]models.Permission{models.PermissionModerateEvents, models.PermissionManageRoles},
+		true,
+	},
+	{
+		6,
+		"",
+		[]models.Permission{models.PermissionModerateEvents},
+		false,
+	},
+	{
+		7,
+		models.RoleUser,
+		[]models.Permission{models.PermissionEditEvents},
+		true,
+	},
+	{
+		8,
+		"",
+		[]models.Permission{models.PermissionEditEvents},
+		false,
+	},
+}
+
+func TestAuthorize(t *testing.T) {
+	for _, test := range authorizeTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+		useCaseMock.On("CheckSession", "test").Return("1", test.role, nil)
+
+		called := false
+		r := mux.NewRouter()
+		r.Handle("/test", middlewares.Auth(middlewares.Authorize(test.permissions...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		})))).Methods("POST")
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/test", bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "test",
+		})
+
+		r.ServeHTTP(w, req)
+		require.Equal(t, test.called, called, test.id)
+		if !test.called {
+			require.Contains(t, w.Body.String(), `"status":403`)
+		}
+		useCaseMock.AssertExpectations(t)
+	}
+}
+
+var apiVersionTests = []struct {
+	id              int
+	path            string
+	version         string
+	statusCode      int
+	responseVersion string
+}{
+	{1, "/api/test", "", http.StatusOK, "1"},
+	{2, "/api/test", "1", http.StatusOK, "1"},
+	{3, "/api/test", "2", http.StatusForbidden, "2"},
+	{4, "/api/v2/test", "", http.StatusForbidden, "2"},
+	{5, "/api/v2/test", "1", http.StatusForbidden, "2"},
+	{6, "/test", "", http.StatusForbidden, ""},
+}
+
+func TestAPIVersion(t *testing.T) {
+	for _, test := range apiVersionTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+		useCaseMock.On("CheckSession", "test").Return("1", models.RoleUser, nil)
+		useCaseMock.On("CheckVerified", "1").Return(error2.ErrNotVerified)
+
+		r := mux.NewRouter()
+		r.Use(middlewares.APIVersion)
+		r.Handle(test.path, middlewares.Auth(middlewares.Verified(http.HandlerFunc(testHandlerFunc)))).Methods("POST")
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", test.path, bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "test",
+		})
+		if test.version != "" {
+			req.Header.Set("X-API-Version", test.version)
+		}
+
+		r.ServeHTTP(w, req)
+		require.Equal(t, test.statusCode, w.Code, test.id)
+		require.Equal(t, test.responseVersion, w.Header().Get("X-API-Version"), test.id)
+		require.Contains(t, w.Body.String(), `"status":403`, test.id)
+		useCaseMock.AssertExpectations(t)
+	}
+}
@@ -0,0 +1,330 @@
+package migrate
+
+import (
+	log "backend/pkg/logger"
+	"backend/schema"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	logMessage = "migrate:"
+	// Any number that the other advisory locks of the database do not use
+	lockId = 7312900413
+
+	lockQuery        = `select pg_advisory_lock($1)`
+	unlockQuery      = `select pg_advisory_unlock($1)`
+	createTableQuery = `create table if not exists schema_migrations (version bigint primary key, applied_at timestamptz default now() not null)`
+	tableExistsQuery = `select to_regclass('schema_migrations') is not null`
+	versionQuery     = `select coalesce(max(version), 0) from schema_migrations`
+	appliedQuery     = `select version from schema_migrations order by version`
+	insertQuery      = `insert into schema_migrations (version) values ($1)`
+	deleteQuery      = `delete from schema_migrations where version = $1`
+	clearQuery       = `delete from schema_migrations`
+)
+
+var (
+	ErrUnknownVersion = errors.New("unknown schema version")
+	ErrSchemaVersion  = errors.New("database schema version does not match the binary")
+	ErrNoDown         = errors.New("migration has no down file")
+	ErrFileName       = errors.New("wrong migration file name")
+)
+
+// Files are named like 000001_init.up.sql and 000001_init.down.sql
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version int
+	Name    string
+	Applied bool
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+// NewMigrator uses the migrations of the schema folder
+func NewMigrator(db *sqlx.DB) (*Migrator, error) {
+	return newMigrator(db, schema.Migrations)
+}
+
+func newMigrator(db *sqlx.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("%w: %s", ErrFileName, entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		text, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("%w: %s", ErrFileName, entry.Name())
+		}
+		if match[3] == "up" {
+			migration.Up = string(text)
+		} else {
+			migration.Down = string(text)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("%w: %06d_%s has no up file", ErrFileName, migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest is the version the binary is built for
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) index(version int) (int, bool) {
+	if version == 0 {
+		return -1, true
+	}
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// withLock runs f on one connection holding the advisory lock, so that the replicas started together migrate one by one
+func (m *Migrator) withLock(f func(conn *sqlx.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.ExecContext(ctx, lockQuery, lockId); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, unlockQuery, lockId); err != nil {
+			log.Error(logMessage+"unlock err = ", err)
+		}
+	}()
+	if _, err = conn.ExecContext(ctx, createTableQuery); err != nil {
+		return err
+	}
+	return f(conn)
+}
+
+func currentVersion(conn *sqlx.Conn) (int, error) {
+	var version int
+	err := conn.GetContext(context.Background(), &version, versionQuery)
+	return version, err
+}
+
+// apply runs the migration and records the version in one transaction
+func apply(conn *sqlx.Conn, query string, recordQuery string, version int) error {
+	ctx := context.Background()
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, query); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, recordQuery, version); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// To migrates up or down to the version, 0 reverts all migrations
+func (m *Migrator) To(version int) error {
+	message := logMessage + "To:"
+	target, ok := m.index(version)
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	return m.withLock(func(conn *sqlx.Conn) error {
+		current, err := currentVersion(conn)
+		if err != nil {
+			return err
+		}
+		from, ok := m.index(current)
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrUnknownVersion, current)
+		}
+		for i := from + 1; i <= target; i++ {
+			migration := m.migrations[i]
+			log.Info(message+"up ", migration.Version, " ", migration.Name)
+			if err := apply(conn, migration.Up, insertQuery, migration.Version); err != nil {
+				return fmt.Errorf("%06d_%s up: %w", migration.Version, migration.Name, err)
+			}
+		}
+		for i := from; i > target; i-- {
+			migration := m.migrations[i]
+			if migration.Down == "" {
+				return fmt.Errorf("%w: %06d_%s", ErrNoDown, migration.Version, migration.Name)
+			}
+			log.Info(message+"down ", migration.Version, " ", migration.Name)
+			if err := apply(conn, migration.Down, deleteQuery, migration.Version); err != nil {
+				return fmt.Errorf("%06d_%s down: %w", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Migrator) Up() error {
+	return m.To(m.Latest())
+}
+
+// Down reverts only the last applied migration
+func (m *Migrator) Down() error {
+	current, err := m.Version()
+	if err != nil {
+		return err
+	}
+	i, ok := m.index(current)
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, current)
+	}
+	if i < 0 {
+		return nil
+	}
+	if i == 0 {
+		return m.To(0)
+	}
+	return m.To(m.migrations[i-1].Version)
+}
+
+// Force records the version without running the migrations, for the databases migrated by hand
+func (m *Migrator) Force(version int) error {
+	target, ok := m.index(version)
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	return m.withLock(func(conn *sqlx.Conn) error {
+		ctx := context.Background()
+		tx, err := conn.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, clearQuery); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		for i := 0; i <= target; i++ {
+			if _, err = tx.ExecContext(ctx, insertQuery, m.migrations[i].Version); err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+// tableExists tells if the database has ever been migrated, the readers do not create the table
+func (m *Migrator) tableExists() (bool, error) {
+	var exists bool
+	err := m.db.Get(&exists, tableExistsQuery)
+	return exists, err
+}
+
+// Version is 0 for an empty database. It takes no lock, so the services can check it while a migration runs
+func (m *Migrator) Version() (int, error) {
+	exists, err := m.tableExists()
+	if err != nil || !exists {
+		return 0, err
+	}
+	var version int
+	err = m.db.Get(&version, versionQuery)
+	return version, err
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	exists, err := m.tableExists()
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int]bool{}
+	if exists {
+		var versions []int
+		if err := m.db.Select(&versions, appliedQuery); err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			applied[version] = true
+		}
+	}
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, Status{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: applied[migration.Version],
+		})
+	}
+	return statuses, nil
+}
+
+// CheckVersion is called by the services on start, they do not work with the schema of another version
+func CheckVersion(db *sqlx.DB) error {
+	m, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version != m.Latest() {
+		return fmt.Errorf("%w: database has %d, binary needs %d, run migrate up", ErrSchemaVersion, version, m.Latest())
+	}
+	return nil
+}
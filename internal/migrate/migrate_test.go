@@ -0,0 +1,182 @@
+package migrate
+
+import (
+	"backend/schema"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMigrations = fstest.MapFS{
+	"000001_init.up.sql":     {Data: []byte("create table a (id int)")},
+	"000001_init.down.sql":   {Data: []byte("drop table a")},
+	"000002_second.up.sql":   {Data: []byte("create table b (id int)")},
+	"000002_second.down.sql": {Data: []byte("drop table b")},
+}
+
+func newMigratorTest(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	m, err := newMigrator(sqlx.NewDb(db, "sqlmock"), testMigrations)
+	assert.NoError(t, err)
+	return m, mock
+}
+
+func expectLock(mock sqlmock.Sqlmock, version int) {
+	mock.ExpectExec(lockQuery).WithArgs(lockId).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createTableQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(versionQuery).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(version))
+}
+
+func expectVersion(mock sqlmock.Sqlmock, version int) {
+	mock.ExpectQuery(tableExistsQuery).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(versionQuery).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(version))
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(unlockQuery).WithArgs(lockId).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectApply(mock sqlmock.Sqlmock, query string, recordQuery string, version int) {
+	mock.ExpectBegin()
+	mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(recordQuery).WithArgs(version).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := load(schema.Migrations)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+	for i, migration := range migrations {
+		assert.Equal(t, i+1, migration.Version, migration.Name)
+		assert.NotEmpty(t, migration.Down, migration.Name)
+	}
+
+	_, err = load(fstest.MapFS{"init.sql": {Data: []byte("")}})
+	assert.True(t, errors.Is(err, ErrFileName))
+
+	_, err = load(fstest.MapFS{"000001_init.down.sql": {Data: []byte("drop table a")}})
+	assert.True(t, errors.Is(err, ErrFileName))
+}
+
+func TestUp(t *testing.T) {
+	m, mock := newMigratorTest(t)
+	assert.Equal(t, 2, m.Latest())
+
+	expectLock(mock, 0)
+	expectApply(mock, "create table a (id int)", insertQuery, 1)
+	expectApply(mock, "create table b (id int)", insertQuery, 2)
+	expectUnlock(mock)
+	assert.NoError(t, m.Up())
+
+	expectLock(mock, 2)
+	expectUnlock(mock)
+	assert.NoError(t, m.Up())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpFailed(t *testing.T) {
+	m, mock := newMigratorTest(t)
+
+	expectLock(mock, 1)
+	mock.ExpectBegin()
+	mock.ExpectExec("create table b (id int)").WillReturnError(errors.New("test_err"))
+	mock.ExpectRollback()
+	expectUnlock(mock)
+	assert.Error(t, m.Up())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDown(t *testing.T) {
+	m, mock := newMigratorTest(t)
+
+	expectVersion(mock, 2)
+	expectLock(mock, 2)
+	expectApply(mock, "drop table b", deleteQuery, 2)
+	expectUnlock(mock)
+	assert.NoError(t, m.Down())
+
+	expectLock(mock, 2)
+	expectApply(mock, "drop table b", deleteQuery, 2)
+	expectApply(mock, "drop table a", deleteQuery, 1)
+	expectUnlock(mock)
+	assert.NoError(t, m.To(0))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTo(t *testing.T) {
+	m, mock := newMigratorTest(t)
+
+	assert.True(t, errors.Is(m.To(3), ErrUnknownVersion))
+
+	// The database is newer than the binary
+	expectLock(mock, 3)
+	expectUnlock(mock)
+	assert.True(t, errors.Is(m.To(2), ErrUnknownVersion))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestForce(t *testing.T) {
+	m, mock := newMigratorTest(t)
+
+	mock.ExpectExec(lockQuery).WithArgs(lockId).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createTableQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec(clearQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insertQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlock(mock)
+	assert.NoError(t, m.Force(2))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatus(t *testing.T) {
+	m, mock := newMigratorTest(t)
+
+	mock.ExpectQuery(tableExistsQuery).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(appliedQuery).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	statuses, err := m.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, []Status{
+		{Version: 1, Name: "init", Applied: true},
+		{Version: 2, Name: "second", Applied: false},
+	}, statuses)
+
+	mock.ExpectQuery(tableExistsQuery).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	statuses, err = m.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, []Status{
+		{Version: 1, Name: "init", Applied: false},
+		{Version: 2, Name: "second", Applied: false},
+	}, statuses)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCheckVersion(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+	migrations, err := load(schema.Migrations)
+	assert.NoError(t, err)
+	latest := migrations[len(migrations)-1].Version
+
+	expectVersion(mock, latest)
+	assert.NoError(t, CheckVersion(sqlx.NewDb(db, "sqlmock")))
+
+	expectVersion(mock, latest-1)
+	assert.True(t, errors.Is(CheckVersion(sqlx.NewDb(db, "sqlmock")), ErrSchemaVersion))
+
+	// An empty database has no schema_migrations table and is not changed by the check
+	mock.ExpectQuery(tableExistsQuery).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	assert.True(t, errors.Is(CheckVersion(sqlx.NewDb(db, "sqlmock")), ErrSchemaVersion))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
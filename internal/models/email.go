@@ -5,4 +5,5 @@ type Info struct {
 	Mail    string
 	Title   string
 	Img_url string
+	Link    string
 }
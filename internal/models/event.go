@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type Event struct {
 	ID          string
 	Title       string
@@ -10,9 +12,32 @@ type Event struct {
 	Viewed      int
 	ImgUrl      string
 	Tag         []string
-	Date        string
-	Geo         string
+	StartDate   time.Time
+	EndDate     time.Time
+	TimeZone    string
+	Latitude    float64
+	Longitude   float64
 	Address     string
 	AuthorId    string
 	IsVisited   bool
+	Snippet     string
+	//Zero capacity means that the number of visitors is not limited
+	Capacity         int
+	SeatsLeft        int
+	WaitlistPosition int
+	//Occurrences of a recurring event share the series
+	SeriesId string
+	RRule    string
+	ExDates  []time.Time
+	//Calendar apps use it to find out that the event has changed
+	UpdatedAt time.Time
+}
+
+type Series struct {
+	ID       string
+	AuthorId string
+	RRule    string
+	ExDates  []time.Time
+	// The first occurrence, zero for the series created before it was stored
+	Start time.Time
 }
@@ -0,0 +1,34 @@
+package models
+
+type GeoCircle struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+// The box crosses the antimeridian if MinLongitude > MaxLongitude
+type BBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+type MapPin struct {
+	EventId   string
+	Title     string
+	Category  string
+	Latitude  float64
+	Longitude float64
+}
+
+type MapCluster struct {
+	Latitude  float64
+	Longitude float64
+	Count     int
+}
+
+type EventMap struct {
+	Pins     []*MapPin
+	Clusters []*MapCluster
+}
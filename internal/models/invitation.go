@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Pending invitation expires when the event ends, expired status is not stored
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+	InvitationExpired  = "expired"
+)
+
+type Invitation struct {
+	ID         string
+	EventId    string
+	EventTitle string
+	InviterId  string
+	ReceiverId string
+	Status     string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// OAuthAccount is an account of an identity provider linked to the user
+type OAuthAccount struct {
+	Provider  string
+	Mail      string
+	CreatedAt time.Time
+}
+
+// OAuthLogin is the result of the callback: a login, a login waiting for the second factor or a linked account
+type OAuthLogin struct {
+	UserId       string
+	PreAuthToken string
+	Linked       bool
+}
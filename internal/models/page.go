@@ -0,0 +1,6 @@
+package models
+
+type Page struct {
+	Limit  int
+	Cursor string
+}
@@ -0,0 +1,40 @@
+package models
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+type Permission string
+
+const (
+	// Create events and edit and delete the own ones
+	PermissionEditEvents Permission = "events:edit"
+	// Edit and delete the events of other users
+	PermissionModerateEvents Permission = "events:moderate"
+	// Change the roles of other users
+	PermissionManageRoles Permission = "users:roles"
+)
+
+// Whose event it is is checked by the event service, so every role may edit events on the routes
+var rolePermissions = map[string][]Permission{
+	RoleUser:      {PermissionEditEvents},
+	RoleModerator: {PermissionEditEvents, PermissionModerateEvents},
+	RoleAdmin:     {PermissionEditEvents, PermissionModerateEvents, PermissionManageRoles},
+}
+
+func IsRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Can tells if the role has the permission, an unknown role has none
+func Can(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
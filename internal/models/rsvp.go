@@ -0,0 +1,19 @@
+package models
+
+const (
+	RSVPGoing    = "going"
+	RSVPMaybe    = "maybe"
+	RSVPDeclined = "declined"
+)
+
+// Waitlisted users answered "going", but there is no free seat for them yet
+type RSVP struct {
+	Status           string
+	WaitlistPosition int
+}
+
+type Visitors struct {
+	Going    []*User
+	Maybe    []*User
+	Declined []*User
+}
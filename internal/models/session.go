@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type Session struct {
+	ID        string
+	UserAgent string
+	IP        string
+	CreatedAt time.Time
+	LastSeen  time.Time
+	Current   bool
+}
@@ -8,4 +8,7 @@ type User struct {
 	Password string
 	About    string
 	ImgUrl   string
+	Verified bool
+	// One of RoleUser, RoleModerator and RoleAdmin
+	Role string
 }
@@ -2,18 +2,73 @@ package register
 
 import (
 	"backend/internal/middleware"
+	"backend/internal/models"
 	authHttp "backend/internal/service/auth/delivery/http"
 	eventHttp "backend/internal/service/event/delivery/http"
 	userHttp "backend/internal/service/user/delivery/http"
+	_ "embed"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
+// openAPI describes the routes of APIEndpoints, TestOpenAPI checks that every route is there
+//
+//go:embed openapi.json
+var openAPI []byte
+
+// APIEndpoints registers the whole REST API, the gateway serves it both at /api and /api/v2.
+// Every POST and DELETE route that needs a session checks the CSRF token right after Auth
+func APIEndpoints(r *mux.Router, authDelivery *authHttp.Delivery, userDelivery *userHttp.Delivery, eventDelivery *eventHttp.Delivery, mws *middleware.Middlewares) {
+	authRouter := r.PathPrefix("/auth").Subrouter()
+	AuthHTTPEndpoints(authRouter, authDelivery, mws)
+	eventRouter := r.PathPrefix("/events").Subrouter()
+	EventHTTPEndpoints(eventRouter, eventDelivery, userDelivery, mws)
+	userRouter := r.PathPrefix("/user").Subrouter()
+	UserHTTPEndpoints(userRouter, userDelivery, eventDelivery, mws)
+}
+
+func OpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(openAPI)
+}
+
 func AuthHTTPEndpoints(r *mux.Router, delivery *authHttp.Delivery, middlewares *middleware.Middlewares) {
 	r.HandleFunc("/signup", delivery.SignUp).Methods("POST")
 	r.HandleFunc("/login", delivery.SignIn).Methods("POST")
+	r.HandleFunc("/login/2fa", delivery.SignInTwoFactor).Methods("POST")
+	r.HandleFunc("/password/forgot", delivery.ForgotPassword).Methods("POST")
+	r.HandleFunc("/password/reset", delivery.ResetPassword).Methods("POST")
 	logoutHandlerFunc := http.HandlerFunc(delivery.Logout)
 	r.Handle("/logout", middlewares.Auth(logoutHandlerFunc))
+
+	getSessionsHandlerFunc := middlewares.Auth(http.HandlerFunc(delivery.GetSessions))
+	r.Handle("/sessions", getSessionsHandlerFunc).Methods("GET")
+	revokeOtherSessionsHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.RevokeOtherSessions)))
+	r.Handle("/sessions", revokeOtherSessionsHandlerFunc).Methods("DELETE")
+	revokeSessionHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.RevokeSession)))
+	r.Handle("/sessions/{id:[0-9a-f]+}", revokeSessionHandlerFunc).Methods("DELETE")
+	r.HandleFunc("/verification/confirm", delivery.ConfirmEmail).Methods("POST")
+	resendVerificationHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.ResendVerification)))
+	r.Handle("/verification/resend", resendVerificationHandlerFunc).Methods("POST")
+
+	setupTwoFactorHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.SetupTwoFactor)))
+	r.Handle("/2fa/setup", setupTwoFactorHandlerFunc).Methods("POST")
+	confirmTwoFactorHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.ConfirmTwoFactor)))
+	r.Handle("/2fa/confirm", confirmTwoFactorHandlerFunc).Methods("POST")
+	disableTwoFactorHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.DisableTwoFactor)))
+	r.Handle("/2fa/disable", disableTwoFactorHandlerFunc).Methods("POST")
+	recoveryCodesHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.RegenerateRecoveryCodes)))
+	r.Handle("/2fa/recovery", recoveryCodesHandlerFunc).Methods("POST")
+
+	r.HandleFunc("/oauth/{provider:[a-z]+}/start", delivery.StartOAuth).Methods("POST")
+	r.HandleFunc("/oauth/{provider:[a-z]+}/callback", delivery.OAuthCallback).Methods("POST")
+	linkOAuthHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.LinkOAuth)))
+	r.Handle("/oauth/{provider:[a-z]+}/link", linkOAuthHandlerFunc).Methods("POST")
+	getOAuthAccountsHandlerFunc := middlewares.Auth(http.HandlerFunc(delivery.GetOAuthAccounts))
+	r.Handle("/oauth/accounts", getOAuthAccountsHandlerFunc).Methods("GET")
+	unlinkOAuthHandlerFunc := middlewares.Auth(middlewares.CSRF(http.HandlerFunc(delivery.UnlinkOAuth)))
+	r.Handle("/oauth/{provider:[a-z]+}", unlinkOAuthHandlerFunc).Methods("DELETE")
 }
 
 func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *eventHttp.Delivery, mws *middleware.Middlewares) {
@@ -23,20 +78,25 @@ func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *e
 	r.HandleFunc("/{id:[0-9]+}/subscribers", uDelivery.GetSubscribers).Methods("GET")
 	r.HandleFunc("/{id:[0-9]+}/subscriptions", uDelivery.GetSubscribes).Methods("GET")
 	r.HandleFunc("/{id:[0-9]+}/friends", uDelivery.GetFriends).Methods("GET")
+	r.HandleFunc("/{id:[0-9]+}/calendar.ics", eDelivery.GetCalendarFeed).Methods("GET")
 
 	getUserHandlerFunc := mws.Auth(http.HandlerFunc(uDelivery.GetUser))
 	r.Handle("", getUserHandlerFunc).Methods("GET")
 
-	updateUserInfoHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserInfo)))
+	updateUserInfoHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserInfo))))
 	r.Handle("/info", updateUserInfoHandlerFunc).Methods("POST")
 
-	updateUserPasswordHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserPassword)))
+	updateUserPasswordHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserPassword))))
 	r.Handle("/password", updateUserPasswordHandlerFunc).Methods("POST")
 
-	subscribeHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.Subscribe)))
+	manageRoles := mws.Authorize(models.PermissionManageRoles)
+	updateUserRoleHandlerFunc := mws.Auth(mws.CSRF(manageRoles(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserRole)))))
+	r.Handle("/{id:[0-9]+}/role", updateUserRoleHandlerFunc).Methods("POST")
+
+	subscribeHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(uDelivery.Subscribe))))
 	r.Handle("/{id:[0-9]+}/subscription", subscribeHandlerFunc).Methods("POST")
 
-	unsubscribeHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.Unsubscribe)))
+	unsubscribeHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(uDelivery.Unsubscribe))))
 	r.Handle("/{id:[0-9]+}/subscription", unsubscribeHandlerFunc).Methods("DELETE")
 
 	isSubscribedHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.IsSubscribed)))
@@ -48,33 +108,71 @@ func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *e
 	getNewNotificationsHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.GetNewNotifications)))
 	r.Handle("/notifications/new", getNewNotificationsHandlerFunc).Methods("GET")
 
-	updateNotificationsStatusHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.UpdateNotificationsStatus)))
+	updateNotificationsStatusHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(uDelivery.UpdateNotificationsStatus))))
 	r.Handle("/notifications/all", updateNotificationsStatusHandlerFunc).Methods("POST")
 
 	getFriendsHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.GetFriends)))
 	r.Handle("/friends", getFriendsHandlerFunc).Methods("GET")
 
-	inviteHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.Invite)))
+	inviteHandlerFunc := mws.Auth(mws.CSRF(mws.Verified(mws.GetVars(http.HandlerFunc(eDelivery.Invite)))))
 	r.Handle("/invite", inviteHandlerFunc).Methods("POST")
+
+	getCalendarTokenHandlerFunc := mws.Auth(http.HandlerFunc(eDelivery.GetCalendarToken))
+	r.Handle("/calendar", getCalendarTokenHandlerFunc).Methods("GET")
+
+	regenerateCalendarTokenHandlerFunc := mws.Auth(mws.CSRF(http.HandlerFunc(eDelivery.RegenerateCalendarToken)))
+	r.Handle("/calendar/token", regenerateCalendarTokenHandlerFunc).Methods("POST")
+
+	getUserInvitationsHandlerFunc := mws.Auth(http.HandlerFunc(eDelivery.GetUserInvitations))
+	r.Handle("/invitations", getUserInvitationsHandlerFunc).Methods("GET")
+
+	acceptInvitationHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(eDelivery.AcceptInvitation))))
+	r.Handle("/invitations/{id:[0-9]+}/accept", acceptInvitationHandlerFunc).Methods("POST")
+
+	declineInvitationHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(eDelivery.DeclineInvitation))))
+	r.Handle("/invitations/{id:[0-9]+}/decline", declineInvitationHandlerFunc).Methods("POST")
 }
 
-func EventHTTPEndpoints(r *mux.Router, delivery *eventHttp.Delivery, mws *middleware.Middlewares) {
+func EventHTTPEndpoints(r *mux.Router, delivery *eventHttp.Delivery, uDelivery *userHttp.Delivery, mws *middleware.Middlewares) {
 	r.HandleFunc("", delivery.GetEvents).Methods("GET")
 	r.HandleFunc("/cities", delivery.GetCities).Methods("GET")
+	r.HandleFunc("/map", delivery.GetEventsMap).Methods("GET")
 	r.HandleFunc("/{id:[0-9]+}", delivery.GetEventById).Methods("GET")
-	updateEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.UpdateEvent)))
+	r.HandleFunc("/{id:[0-9]+}.ics", delivery.GetEventICS).Methods("GET")
+	getVisitorsHandlerFunc := mws.GetVars(http.HandlerFunc(uDelivery.GetVisitors))
+	r.Handle("/{id:[0-9]+}/visitors", getVisitorsHandlerFunc).Methods("GET")
+	editEvents := mws.Authorize(models.PermissionEditEvents)
+	updateEventHandlerFunc := mws.Auth(mws.CSRF(editEvents(mws.GetVars(http.HandlerFunc(delivery.UpdateEvent)))))
 	r.Handle("/{id:[0-9]+}", updateEventHandlerFunc).Methods("POST")
-	deleteEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.DeleteEvent)))
+	deleteEventHandlerFunc := mws.Auth(mws.CSRF(editEvents(mws.GetVars(http.HandlerFunc(delivery.DeleteEvent)))))
 	r.Handle("/{id:[0-9]+}", deleteEventHandlerFunc).Methods("DELETE")
-	createEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.CreateEvent)))
+	createEventHandlerFunc := mws.Auth(mws.CSRF(editEvents(mws.Verified(mws.GetVars(http.HandlerFunc(delivery.CreateEvent))))))
 	r.Handle("", createEventHandlerFunc).Methods("POST")
 
-	visitHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.Visit)))
+	updateSeriesHandlerFunc := mws.Auth(mws.CSRF(editEvents(mws.GetVars(http.HandlerFunc(delivery.UpdateSeries)))))
+	r.Handle("/{id:[0-9]+}/series", updateSeriesHandlerFunc).Methods("POST")
+
+	deleteSeriesHandlerFunc := mws.Auth(mws.CSRF(editEvents(mws.GetVars(http.HandlerFunc(delivery.DeleteSeries)))))
+	r.Handle("/{id:[0-9]+}/series", deleteSeriesHandlerFunc).Methods("DELETE")
+
+	visitHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(delivery.Visit))))
 	r.Handle("/{id:[0-9]+}/favourite", visitHandlerFunc).Methods("POST")
 
-	unvisitHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.Unvisit)))
+	unvisitHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(delivery.Unvisit))))
 	r.Handle("/{id:[0-9]+}/favourite", unvisitHandlerFunc).Methods("DELETE")
 
 	isVisitedHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.IsVisited)))
 	r.Handle("/{id:[0-9]+}/favourite", isVisitedHandlerFunc).Methods("GET")
+
+	setRSVPHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(delivery.SetRSVP))))
+	r.Handle("/{id:[0-9]+}/rsvp", setRSVPHandlerFunc).Methods("POST")
+
+	deleteRSVPHandlerFunc := mws.Auth(mws.CSRF(mws.GetVars(http.HandlerFunc(delivery.Unvisit))))
+	r.Handle("/{id:[0-9]+}/rsvp", deleteRSVPHandlerFunc).Methods("DELETE")
+
+	getRSVPHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetRSVP)))
+	r.Handle("/{id:[0-9]+}/rsvp", getRSVPHandlerFunc).Methods("GET")
+
+	getEventInvitationsHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetEventInvitations)))
+	r.Handle("/{id:[0-9]+}/invitations", getEventInvitationsHandlerFunc).Methods("GET")
 }
@@ -1,7 +1,17 @@
 package register
 
 import (
+	"backend/internal/middleware"
+	authError "backend/internal/service/auth/error"
+	authUseCase "backend/internal/service/auth/usecase"
+	"encoding/json"
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -9,5 +19,164 @@ func TestRegister(t *testing.T) {
 	r := mux.NewRouter()
 	AuthHTTPEndpoints(r, nil, nil)
 	UserHTTPEndpoints(r, nil, nil, nil)
-	EventHTTPEndpoints(r, nil, nil)
+	EventHTTPEndpoints(r, nil, nil, nil)
+}
+
+// {id:[0-9]+} of the router is {id} in the spec
+var pathVariableRegexp = regexp.MustCompile(`\{([^}:]+):[^}]*\}`)
+
+type openAPISpec struct {
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+func registeredOperations(t *testing.T) map[string]bool {
+	r := mux.NewRouter()
+	APIEndpoints(r, nil, nil, nil, nil)
+
+	operations := map[string]bool{}
+	err := r.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		if route.GetHandler() == nil {
+			return nil
+		}
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		path = pathVariableRegexp.ReplaceAllString(path, "{$1}")
+		methods, err := route.GetMethods()
+		if err != nil {
+			// The route takes any method
+			operations[path+" *"] = true
+			return nil
+		}
+		for _, method := range methods {
+			operations[path+" "+strings.ToLower(method)] = true
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return operations
+}
+
+func TestOpenAPI(t *testing.T) {
+	spec := openAPISpec{}
+	require.NoError(t, json.Unmarshal(openAPI, &spec))
+
+	documented := map[string]bool{}
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented[path+" "+method] = true
+		}
+	}
+
+	var missing []string
+	for operation := range registeredOperations(t) {
+		if strings.HasSuffix(operation, " *") {
+			path := strings.TrimSuffix(operation, " *")
+			if len(spec.Paths[path]) == 0 {
+				missing = append(missing, operation)
+			}
+			continue
+		}
+		if !documented[operation] {
+			missing = append(missing, operation)
+		}
+		delete(documented, operation)
+	}
+	sort.Strings(missing)
+	require.Empty(t, missing, "routes are missing from openapi.json")
+
+	registered := registeredOperations(t)
+	var unknown []string
+	for operation := range documented {
+		path := strings.Fields(operation)[0]
+		if !registered[path+" *"] {
+			unknown = append(unknown, operation)
+		}
+	}
+	sort.Strings(unknown)
+	require.Empty(t, unknown, "openapi.json has routes that are not registered")
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/openapi.json", nil)
+	require.NoError(t, err)
+
+	OpenAPI(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.True(t, json.Valid(w.Body.Bytes()))
+}
+
+// The mutating routes that work without a session and so without a CSRF token
+var publicRoutes = map[string]bool{
+	"/api/auth/signup":                    true,
+	"/api/auth/login":                     true,
+	"/api/auth/login/2fa":                 true,
+	"/api/auth/password/forgot":           true,
+	"/api/auth/password/reset":            true,
+	"/api/auth/verification/confirm":      true,
+	"/api/auth/oauth/{provider}/start":    true,
+	"/api/auth/oauth/{provider}/callback": true,
+}
+
+var pathExampleReplacer = strings.NewReplacer("{id}", "1", "{provider}", "vk")
+
+// mutatingPaths gives the POST and DELETE routes under the prefix with the variables filled in
+func mutatingPaths(t *testing.T, r *mux.Router, prefix string) map[string][]string {
+	paths := map[string][]string{}
+	err := r.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil || route.GetHandler() == nil || !strings.HasPrefix(path, prefix) {
+			return nil
+		}
+		path = pathVariableRegexp.ReplaceAllString(path, "{$1}")
+		if publicRoutes["/api"+strings.TrimPrefix(path, prefix)] {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		for _, method := range methods {
+			if method == "POST" || method == "DELETE" {
+				paths[pathExampleReplacer.Replace(path)] = append(paths[pathExampleReplacer.Replace(path)], method)
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return paths
+}
+
+// The same routes are served at /api/v2, which must check the token as /api does
+func TestCSRF(t *testing.T) {
+	for _, prefix := range []string{"/api", "/api/v2"} {
+		useCaseMock := new(authUseCase.UseCaseMock)
+		useCaseMock.On("CheckSession", "session").Return("1", "admin", nil)
+		useCaseMock.On("CheckToken", "", "session").Return("", authError.ErrCSRFToken)
+		mws := middleware.NewMiddlewares(useCaseMock)
+
+		r := mux.NewRouter()
+		APIEndpoints(r.PathPrefix(prefix).Subrouter(), nil, nil, nil, mws)
+
+		paths := mutatingPaths(t, r, prefix)
+		require.NotEmpty(t, paths, prefix)
+		for path, methods := range paths {
+			for _, method := range methods {
+				calls := len(useCaseMock.Calls)
+				req, err := http.NewRequest(method, path, nil)
+				require.NoError(t, err)
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+				w := httptest.NewRecorder()
+
+				// The deliveries are nil, so a handler reached without the token panics
+				r.ServeHTTP(w, req)
+				require.Contains(t, w.Body.String(), `"status":403`, method+" "+path)
+				require.Greater(t, len(useCaseMock.Calls), calls, method+" "+path)
+				require.Equal(t, "CheckToken", useCaseMock.Calls[len(useCaseMock.Calls)-1].Method, method+" "+path)
+			}
+		}
+	}
 }
@@ -1,7 +1,11 @@
 package response
 
 import (
+	"backend/internal/errcode"
+	error2 "backend/internal/error"
 	models "backend/internal/models"
+	"net/http"
+	"time"
 )
 
 //easyjson -all response.go
@@ -14,6 +18,7 @@ type CtxString string
 type Response struct {
 	Status  HttpStatus  `json:"status"`
 	Message string      `json:"message,omitempty"`
+	Code    string      `json:"code,omitempty"`
 	Body    interface{} `json:"body,omitempty"`
 }
 
@@ -25,6 +30,71 @@ type UserResponseBody struct {
 	ImgUrl   string `json:"imgUrl,omitempty" valid:"type(string)" san:"xss"`
 	Mail     string `json:"email,omitempty" valid:"email,length(0|150)" san:"xss"`
 	Password string `json:"password,omitempty" valid:"type(string),length(0|150)" san:"xss"`
+	Verified bool   `json:"verified"`
+	Role     string `json:"role,omitempty"`
+}
+
+type SignInResponseBody struct {
+	Mail     string `json:"email" valid:"email,length(0|150)" san:"xss"`
+	Password string `json:"password" valid:"type(string),length(0|150)" san:"xss"`
+	Remember bool   `json:"remember"`
+}
+
+type TwoFactorResponseBody struct {
+	Token    string `json:"token" valid:"type(string),length(0|128)"`
+	Code     string `json:"code" valid:"type(string),length(0|32)"`
+	Remember bool   `json:"remember"`
+}
+
+type PreAuthResponseBody struct {
+	TwoFactor bool   `json:"twoFactor"`
+	Token     string `json:"token"`
+}
+
+type LoginLockedResponseBody struct {
+	RetryAfter int64 `json:"retryAfter"`
+}
+
+type TwoFactorSetupResponseBody struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type RecoveryCodesResponseBody struct {
+	Codes []string `json:"recoveryCodes"`
+}
+
+type OAuthCallbackResponseBody struct {
+	Code     string `json:"code" valid:"type(string),length(0|2048)"`
+	State    string `json:"state" valid:"type(string),length(0|128)"`
+	Remember bool   `json:"remember"`
+}
+
+type OAuthStartResponseBody struct {
+	URL string `json:"url"`
+}
+
+type OAuthLinkedResponseBody struct {
+	Linked bool `json:"linked"`
+}
+
+type OAuthAccountResponseBody struct {
+	Provider  string `json:"provider"`
+	Mail      string `json:"email"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type OAuthAccountListResponseBody struct {
+	Accounts []OAuthAccountResponseBody `json:"accounts"`
+}
+
+type PasswordResetResponseBody struct {
+	Token    string `json:"token" valid:"type(string),length(0|128)"`
+	Password string `json:"password" valid:"type(string),length(0|150)" san:"xss"`
+}
+
+type VerificationResponseBody struct {
+	Token string `json:"token" valid:"type(string),length(0|1024)"`
 }
 
 type UserListResponseBody struct {
@@ -49,15 +119,46 @@ type EventResponseBody struct {
 	Viewed      int      `json:"viewed" valid:"type(int)" san:"xss"`
 	ImgUrl      string   `json:"imgUrl" valid:"type(string),length(0|255)" san:"xss"`
 	Tag         []string `json:"tag" san:"xss"`
-	Date        string   `json:"date" valid:"type(string),length(0|10)" san:"xss"`
+	StartDate   string   `json:"startDate" valid:"type(string),length(0|35)"`
+	EndDate     string   `json:"endDate" valid:"type(string),length(0|35)"`
+	TimeZone    string   `json:"timeZone" valid:"type(string),length(0|64)" san:"xss"`
 	Geo         string   `json:"geo" valid:"type(string),length(0|255)"`
 	Address     string   `json:"address" valid:"type(string), length(0|520)" san:"xss"`
 	AuthorID    string   `json:"authorid" san:"xss"`
 	IsVisited   bool     `json:"favourite"`
+	Snippet     string   `json:"snippet,omitempty"`
+	Capacity    int      `json:"capacity" valid:"type(int)"`
+	//Only set for the events with limited capacity
+	SeatsLeft        *int `json:"seatsLeft,omitempty"`
+	WaitlistPosition int  `json:"waitlistPosition,omitempty"`
+	//Recurrence rule and excluded dates (YYYY-MM-DD) of the series the event belongs to
+	SeriesId string   `json:"seriesId,omitempty"`
+	RRule    string   `json:"rrule,omitempty" valid:"type(string),length(0|255)"`
+	ExDates  []string `json:"exdates,omitempty"`
 }
 
 type EventListResponseBody struct {
-	Events []EventResponseBody `json:"events"`
+	Events     []EventResponseBody `json:"events"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+}
+
+type MapPinResponseBody struct {
+	EventId   string  `json:"eventId"`
+	Title     string  `json:"title"`
+	Category  string  `json:"category"`
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+}
+
+type MapClusterResponseBody struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+	Count     int     `json:"count"`
+}
+
+type EventMapResponseBody struct {
+	Pins     []MapPinResponseBody     `json:"pins"`
+	Clusters []MapClusterResponseBody `json:"clusters"`
 }
 
 type SubscribedResponseBody struct {
@@ -65,7 +166,34 @@ type SubscribedResponseBody struct {
 }
 
 type FavouriteResponseBody struct {
-	Result bool `json:"result"`
+	Result           bool `json:"result"`
+	WaitlistPosition int  `json:"waitlistPosition,omitempty"`
+}
+
+type RSVPResponseBody struct {
+	Status           string `json:"status" valid:"type(string)" san:"xss"`
+	WaitlistPosition int    `json:"waitlistPosition,omitempty"`
+}
+
+type VisitorsResponseBody struct {
+	Going    []UserResponseBody `json:"going"`
+	Maybe    []UserResponseBody `json:"maybe"`
+	Declined []UserResponseBody `json:"declined"`
+}
+
+type InvitationResponseBody struct {
+	ID               string `json:"id"`
+	EventId          string `json:"eventId"`
+	EventTitle       string `json:"eventTitle"`
+	InviterId        string `json:"inviterId"`
+	ReceiverId       string `json:"receiverId"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"createdAt"`
+	WaitlistPosition int    `json:"waitlistPosition,omitempty"`
+}
+
+type InvitationListResponseBody struct {
+	Invitations []InvitationResponseBody `json:"invitations"`
 }
 
 type CitiesResponseBody struct {
@@ -83,6 +211,28 @@ type NotificationResponseBody struct {
 	EventTitle  string `json:"eventTitle,omitempty"`
 }
 
+type SessionResponseBody struct {
+	ID        string `json:"id"`
+	UserAgent string `json:"userAgent"`
+	IP        string `json:"ip"`
+	CreatedAt string `json:"createdAt"`
+	LastSeen  string `json:"lastSeen"`
+	Current   bool   `json:"current"`
+}
+
+type SessionListResponseBody struct {
+	Sessions []SessionResponseBody `json:"sessions"`
+}
+
+type CalendarResponseBody struct {
+	URL string `json:"url"`
+}
+
+// HealthResponseBody has the errors of the failed checks by name
+type HealthResponseBody struct {
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
 type NotificationListResponseBody struct {
 	Notifications []NotificationResponseBody `json:"notifications"`
 }
@@ -93,6 +243,14 @@ func StatusResponse(status HttpStatus) *Response {
 	}
 }
 
+// ErrorResponse has the error code, so the frontend does not have to tell the errors apart by the status
+func ErrorResponse(status HttpStatus, code errcode.Code) *Response {
+	return &Response{
+		Status: status,
+		Code:   string(code),
+	}
+}
+
 func OkResponse() *Response {
 	return &Response{
 		Status:  200,
@@ -107,6 +265,79 @@ func UserResponse(user *models.User) *Response {
 	}
 }
 
+// LoginLockedResponse has the message, so the frontend does not have to build it from retryAfter
+func LoginLockedResponse(status HttpStatus, code errcode.Code, err *error2.LoginLockedError) *Response {
+	return &Response{
+		Status:  status,
+		Message: err.Error(),
+		Code:    string(code),
+		Body: &LoginLockedResponseBody{
+			RetryAfter: int64(err.RetryAfter / time.Second),
+		},
+	}
+}
+
+func PreAuthResponse(token string) *Response {
+	return &Response{
+		Status: 200,
+		Body: PreAuthResponseBody{
+			TwoFactor: true,
+			Token:     token,
+		},
+	}
+}
+
+func TwoFactorSetupResponse(secret, uri string) *Response {
+	return &Response{
+		Status: 200,
+		Body: TwoFactorSetupResponseBody{
+			Secret: secret,
+			URI:    uri,
+		},
+	}
+}
+
+func RecoveryCodesResponse(codes []string) *Response {
+	return &Response{
+		Status: 200,
+		Body: RecoveryCodesResponseBody{
+			Codes: codes,
+		},
+	}
+}
+
+func OAuthStartResponse(url string) *Response {
+	return &Response{
+		Status: 200,
+		Body: OAuthStartResponseBody{
+			URL: url,
+		},
+	}
+}
+
+func OAuthLinkedResponse() *Response {
+	return &Response{
+		Status: 200,
+		Body: OAuthLinkedResponseBody{
+			Linked: true,
+		},
+	}
+}
+
+func OAuthAccountListResponse(accounts []*models.OAuthAccount) *Response {
+	return &Response{
+		Status: 200,
+		Body:   MakeOAuthAccountListResponseBody(accounts),
+	}
+}
+
+func SessionListResponse(sessions []*models.Session) *Response {
+	return &Response{
+		Status: 200,
+		Body:   MakeSessionListResponseBody(sessions),
+	}
+}
+
 func UserListResponse(users []*models.User) *Response {
 	return &Response{
 		Status: 200,
@@ -121,6 +352,13 @@ func EventResponse(event *models.Event) *Response {
 	}
 }
 
+func EventMapResponse(eventMap *models.EventMap) *Response {
+	return &Response{
+		Status: 200,
+		Body:   MakeEventMapResponseBody(eventMap),
+	}
+}
+
 func EventIdResponse(eventID string) *Response {
 	return &Response{
 		Status: 200,
@@ -130,10 +368,10 @@ func EventIdResponse(eventID string) *Response {
 	}
 }
 
-func EventListResponse(events []*models.Event) *Response {
+func EventListResponse(events []*models.Event, nextCursor string) *Response {
 	return &Response{
 		Status: 200,
-		Body:   MakeEventListResponseBody(events),
+		Body:   MakeEventListResponseBody(events, nextCursor),
 	}
 }
 
@@ -146,15 +384,49 @@ func SubscribedResponse(result bool) *Response {
 	}
 }
 
-func FavouriteResponse(result bool) *Response {
+func FavouriteResponse(result bool, waitlistPosition int) *Response {
 	return &Response{
 		Status: 200,
 		Body: FavouriteResponseBody{
-			Result: result,
+			Result:           result,
+			WaitlistPosition: waitlistPosition,
 		},
 	}
 }
 
+func RSVPResponse(rsvp *models.RSVP) *Response {
+	return &Response{
+		Status: 200,
+		Body: RSVPResponseBody{
+			Status:           rsvp.Status,
+			WaitlistPosition: rsvp.WaitlistPosition,
+		},
+	}
+}
+
+func VisitorsResponse(visitors *models.Visitors) *Response {
+	return &Response{
+		Status: 200,
+		Body:   MakeVisitorsResponseBody(visitors),
+	}
+}
+
+func InvitationResponse(invitation *models.Invitation, waitlistPosition int) *Response {
+	body := MakeInvitationResponseBody(invitation)
+	body.WaitlistPosition = waitlistPosition
+	return &Response{
+		Status: 200,
+		Body:   body,
+	}
+}
+
+func InvitationListResponse(invitations []*models.Invitation) *Response {
+	return &Response{
+		Status: 200,
+		Body:   MakeInvitationListResponseBody(invitations),
+	}
+}
+
 func CitiesResponse(cities []string) *Response {
 	return &Response{
 		Status: 200,
@@ -164,9 +436,32 @@ func CitiesResponse(cities []string) *Response {
 	}
 }
 
+func CalendarResponse(url string) *Response {
+	return &Response{
+		Status: 200,
+		Body: CalendarResponseBody{
+			URL: url,
+		},
+	}
+}
+
 func NotificationListResponse(notifications []*models.Notification) *Response {
 	return &Response{
 		Status: 200,
 		Body:   MakeNotificationListResponseBody(notifications),
 	}
 }
+
+func HealthResponse(failed map[string]error) *Response {
+	body := HealthResponseBody{
+		Failed: make(map[string]string, len(failed)),
+	}
+	for name, err := range failed {
+		body.Failed[name] = err.Error()
+	}
+	return &Response{
+		Status: http.StatusServiceUnavailable,
+		Code:   string(errcode.CodeUnavailable),
+		Body:   body,
+	}
+}
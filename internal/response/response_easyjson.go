@@ -17,7 +17,249 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6ff3ac1dDecodeBackendInternalResponse(in *jlexer.Lexer, out *UsersIdResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse(in *jlexer.Lexer, out *VisitorsResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "going":
+			if in.IsNull() {
+				in.Skip()
+				out.Going = nil
+			} else {
+				in.Delim('[')
+				if out.Going == nil {
+					if !in.IsDelim(']') {
+						out.Going = make([]UserResponseBody, 0, 0)
+					} else {
+						out.Going = []UserResponseBody{}
+					}
+				} else {
+					out.Going = (out.Going)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 UserResponseBody
+					(v1).UnmarshalEasyJSON(in)
+					out.Going = append(out.Going, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "maybe":
+			if in.IsNull() {
+				in.Skip()
+				out.Maybe = nil
+			} else {
+				in.Delim('[')
+				if out.Maybe == nil {
+					if !in.IsDelim(']') {
+						out.Maybe = make([]UserResponseBody, 0, 0)
+					} else {
+						out.Maybe = []UserResponseBody{}
+					}
+				} else {
+					out.Maybe = (out.Maybe)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 UserResponseBody
+					(v2).UnmarshalEasyJSON(in)
+					out.Maybe = append(out.Maybe, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "declined":
+			if in.IsNull() {
+				in.Skip()
+				out.Declined = nil
+			} else {
+				in.Delim('[')
+				if out.Declined == nil {
+					if !in.IsDelim(']') {
+						out.Declined = make([]UserResponseBody, 0, 0)
+					} else {
+						out.Declined = []UserResponseBody{}
+					}
+				} else {
+					out.Declined = (out.Declined)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 UserResponseBody
+					(v3).UnmarshalEasyJSON(in)
+					out.Declined = append(out.Declined, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse(out *jwriter.Writer, in VisitorsResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"going\":"
+		out.RawString(prefix[1:])
+		if in.Going == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Going {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"maybe\":"
+		out.RawString(prefix)
+		if in.Maybe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Maybe {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"declined\":"
+		out.RawString(prefix)
+		if in.Declined == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Declined {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VisitorsResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VisitorsResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VisitorsResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VisitorsResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse1(in *jlexer.Lexer, out *VerificationResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse1(out *jwriter.Writer, in VerificationResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VerificationResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VerificationResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VerificationResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VerificationResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse1(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse2(in *jlexer.Lexer, out *UsersIdResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -52,9 +294,9 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse(in *jlexer.Lexer, out *UsersI
 					out.UsersId = (out.UsersId)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 string
-					v1 = string(in.String())
-					out.UsersId = append(out.UsersId, v1)
+					var v10 string
+					v10 = string(in.String())
+					out.UsersId = append(out.UsersId, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -69,7 +311,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse(in *jlexer.Lexer, out *UsersI
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse(out *jwriter.Writer, in UsersIdResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse2(out *jwriter.Writer, in UsersIdResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -80,11 +322,11 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse(out *jwriter.Writer, in Users
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.UsersId {
-				if v2 > 0 {
+			for v11, v12 := range in.UsersId {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -95,27 +337,27 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse(out *jwriter.Writer, in Users
 // MarshalJSON supports json.Marshaler interface
 func (v UsersIdResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UsersIdResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UsersIdResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UsersIdResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse2(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse1(in *jlexer.Lexer, out *UserResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse3(in *jlexer.Lexer, out *UserResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -148,6 +390,10 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse1(in *jlexer.Lexer, out *UserR
 			out.Mail = string(in.String())
 		case "password":
 			out.Password = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -158,7 +404,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse1(in *jlexer.Lexer, out *UserR
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse1(out *jwriter.Writer, in UserResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse3(out *jwriter.Writer, in UserResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -228,33 +474,48 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse1(out *jwriter.Writer, in User
 		}
 		out.String(string(in.Password))
 	}
+	{
+		const prefix string = ",\"verified\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Verified))
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v UserResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse1(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse1(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse1(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse1(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse3(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse2(in *jlexer.Lexer, out *UserListResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse4(in *jlexer.Lexer, out *UserListResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -289,9 +550,9 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse2(in *jlexer.Lexer, out *UserL
 					out.Users = (out.Users)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 UserResponseBody
-					(v4).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v4)
+					var v13 UserResponseBody
+					(v13).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -306,7 +567,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse2(in *jlexer.Lexer, out *UserL
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse2(out *jwriter.Writer, in UserListResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse4(out *jwriter.Writer, in UserListResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -317,11 +578,11 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse2(out *jwriter.Writer, in User
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Users {
-				if v5 > 0 {
+			for v14, v15 := range in.Users {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v15).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -332,27 +593,27 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse2(out *jwriter.Writer, in User
 // MarshalJSON supports json.Marshaler interface
 func (v UserListResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse2(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse2(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserListResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse2(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse2(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse4(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse3(in *jlexer.Lexer, out *SubscribedResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse5(in *jlexer.Lexer, out *TwoFactorSetupResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -371,8 +632,10 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse3(in *jlexer.Lexer, out *Subsc
 			continue
 		}
 		switch key {
-		case "result":
-			out.Result = bool(in.Bool())
+		case "secret":
+			out.Secret = string(in.String())
+		case "uri":
+			out.URI = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -383,42 +646,47 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse3(in *jlexer.Lexer, out *Subsc
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse3(out *jwriter.Writer, in SubscribedResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse5(out *jwriter.Writer, in TwoFactorSetupResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"result\":"
+		const prefix string = ",\"secret\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Result))
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"uri\":"
+		out.RawString(prefix)
+		out.String(string(in.URI))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SubscribedResponseBody) MarshalJSON() ([]byte, error) {
+func (v TwoFactorSetupResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse3(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SubscribedResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse3(w, v)
+func (v TwoFactorSetupResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SubscribedResponseBody) UnmarshalJSON(data []byte) error {
+func (v *TwoFactorSetupResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse3(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SubscribedResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse3(l, v)
+func (v *TwoFactorSetupResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse5(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse4(in *jlexer.Lexer, out *Response) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse6(in *jlexer.Lexer, out *TwoFactorResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -437,18 +705,12 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse4(in *jlexer.Lexer, out *Respo
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = HttpStatus(in.Int())
-		case "message":
-			out.Message = string(in.String())
-		case "body":
-			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Body.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Body = in.Interface()
-			}
+		case "token":
+			out.Token = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		case "remember":
+			out.Remember = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -459,58 +721,118 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse4(in *jlexer.Lexer, out *Respo
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse4(out *jwriter.Writer, in Response) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse6(out *jwriter.Writer, in TwoFactorResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Status))
+		out.String(string(in.Token))
 	}
-	if in.Message != "" {
-		const prefix string = ",\"message\":"
+	{
+		const prefix string = ",\"code\":"
 		out.RawString(prefix)
-		out.String(string(in.Message))
+		out.String(string(in.Code))
 	}
-	if in.Body != nil {
-		const prefix string = ",\"body\":"
+	{
+		const prefix string = ",\"remember\":"
 		out.RawString(prefix)
-		if m, ok := in.Body.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Body.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Body))
+		out.Bool(bool(in.Remember))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse6(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse7(in *jlexer.Lexer, out *SubscribedResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			out.Result = bool(in.Bool())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse7(out *jwriter.Writer, in SubscribedResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Result))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Response) MarshalJSON() ([]byte, error) {
+func (v SubscribedResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse4(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse4(w, v)
+func (v SubscribedResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Response) UnmarshalJSON(data []byte) error {
+func (v *SubscribedResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse4(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse4(l, v)
+func (v *SubscribedResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse7(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse5(in *jlexer.Lexer, out *NotificationResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse8(in *jlexer.Lexer, out *SignInResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -529,22 +851,12 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse5(in *jlexer.Lexer, out *Notif
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "seen":
-			out.Seen = bool(in.Bool())
-		case "userId":
-			out.UserId = string(in.String())
-		case "userName":
-			out.UserName = string(in.String())
-		case "userSurname":
-			out.UserSurname = string(in.String())
-		case "userImgUrl":
-			out.UserImgUrl = string(in.String())
-		case "eventId":
-			out.EventId = string(in.String())
-		case "eventTitle":
-			out.EventTitle = string(in.String())
+		case "email":
+			out.Mail = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "remember":
+			out.Remember = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -555,77 +867,153 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse5(in *jlexer.Lexer, out *Notif
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse5(out *jwriter.Writer, in NotificationResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse8(out *jwriter.Writer, in SignInResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Mail))
 	}
 	{
-		const prefix string = ",\"seen\":"
+		const prefix string = ",\"password\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Seen))
+		out.String(string(in.Password))
 	}
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"remember\":"
 		out.RawString(prefix)
-		out.String(string(in.UserId))
+		out.Bool(bool(in.Remember))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SignInResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SignInResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SignInResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SignInResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse8(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse9(in *jlexer.Lexer, out *SessionResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "userAgent":
+			out.UserAgent = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "lastSeen":
+			out.LastSeen = string(in.String())
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse9(out *jwriter.Writer, in SessionResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"userName\":"
+		const prefix string = ",\"userAgent\":"
 		out.RawString(prefix)
-		out.String(string(in.UserName))
+		out.String(string(in.UserAgent))
 	}
 	{
-		const prefix string = ",\"userSurname\":"
+		const prefix string = ",\"ip\":"
 		out.RawString(prefix)
-		out.String(string(in.UserSurname))
+		out.String(string(in.IP))
 	}
 	{
-		const prefix string = ",\"userImgUrl\":"
+		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
-		out.String(string(in.UserImgUrl))
+		out.String(string(in.CreatedAt))
 	}
-	if in.EventId != "" {
-		const prefix string = ",\"eventId\":"
+	{
+		const prefix string = ",\"lastSeen\":"
 		out.RawString(prefix)
-		out.String(string(in.EventId))
+		out.String(string(in.LastSeen))
 	}
-	if in.EventTitle != "" {
-		const prefix string = ",\"eventTitle\":"
+	{
+		const prefix string = ",\"current\":"
 		out.RawString(prefix)
-		out.String(string(in.EventTitle))
+		out.Bool(bool(in.Current))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NotificationResponseBody) MarshalJSON() ([]byte, error) {
+func (v SessionResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse5(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NotificationResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse5(w, v)
+func (v SessionResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NotificationResponseBody) UnmarshalJSON(data []byte) error {
+func (v *SessionResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse5(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NotificationResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse5(l, v)
+func (v *SessionResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse9(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse6(in *jlexer.Lexer, out *NotificationListResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse10(in *jlexer.Lexer, out *SessionListResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -644,25 +1032,25 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse6(in *jlexer.Lexer, out *Notif
 			continue
 		}
 		switch key {
-		case "notifications":
+		case "sessions":
 			if in.IsNull() {
 				in.Skip()
-				out.Notifications = nil
+				out.Sessions = nil
 			} else {
 				in.Delim('[')
-				if out.Notifications == nil {
+				if out.Sessions == nil {
 					if !in.IsDelim(']') {
-						out.Notifications = make([]NotificationResponseBody, 0, 0)
+						out.Sessions = make([]SessionResponseBody, 0, 0)
 					} else {
-						out.Notifications = []NotificationResponseBody{}
+						out.Sessions = []SessionResponseBody{}
 					}
 				} else {
-					out.Notifications = (out.Notifications)[:0]
+					out.Sessions = (out.Sessions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 NotificationResponseBody
-					(v7).UnmarshalEasyJSON(in)
-					out.Notifications = append(out.Notifications, v7)
+					var v16 SessionResponseBody
+					(v16).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -677,22 +1065,22 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse6(in *jlexer.Lexer, out *Notif
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse6(out *jwriter.Writer, in NotificationListResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse10(out *jwriter.Writer, in SessionListResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notifications\":"
+		const prefix string = ",\"sessions\":"
 		out.RawString(prefix[1:])
-		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Notifications {
-				if v8 > 0 {
+			for v17, v18 := range in.Sessions {
+				if v17 > 0 {
 					out.RawByte(',')
 				}
-				(v9).MarshalEasyJSON(out)
+				(v18).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -701,29 +1089,29 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse6(out *jwriter.Writer, in Noti
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NotificationListResponseBody) MarshalJSON() ([]byte, error) {
+func (v SessionListResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse6(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NotificationListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse6(w, v)
+func (v SessionListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NotificationListResponseBody) UnmarshalJSON(data []byte) error {
+func (v *SessionListResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse6(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NotificationListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse6(l, v)
+func (v *SessionListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse10(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse7(in *jlexer.Lexer, out *FavouriteResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse11(in *jlexer.Lexer, out *Response) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -742,8 +1130,20 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse7(in *jlexer.Lexer, out *Favou
 			continue
 		}
 		switch key {
-		case "result":
-			out.Result = bool(in.Bool())
+		case "status":
+			out.Status = HttpStatus(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		case "body":
+			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Body.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Body = in.Interface()
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -754,42 +1154,1509 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse7(in *jlexer.Lexer, out *Favou
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse7(out *jwriter.Writer, in FavouriteResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse11(out *jwriter.Writer, in Response) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"result\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Result))
+		out.Int(int(in.Status))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v FavouriteResponseBody) MarshalJSON() ([]byte, error) {
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	if in.Body != nil {
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		if m, ok := in.Body.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Body.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Body))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Response) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Response) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse11(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse12(in *jlexer.Lexer, out *RecoveryCodesResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recoveryCodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]string, 0, 4)
+					} else {
+						out.Codes = []string{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 string
+					v19 = string(in.String())
+					out.Codes = append(out.Codes, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse12(out *jwriter.Writer, in RecoveryCodesResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recoveryCodes\":"
+		out.RawString(prefix[1:])
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Codes {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodesResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodesResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodesResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodesResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse12(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse13(in *jlexer.Lexer, out *RSVPResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "waitlistPosition":
+			out.WaitlistPosition = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse13(out *jwriter.Writer, in RSVPResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.WaitlistPosition != 0 {
+		const prefix string = ",\"waitlistPosition\":"
+		out.RawString(prefix)
+		out.Int(int(in.WaitlistPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RSVPResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RSVPResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RSVPResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RSVPResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse13(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse14(in *jlexer.Lexer, out *PreAuthResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "twoFactor":
+			out.TwoFactor = bool(in.Bool())
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse14(out *jwriter.Writer, in PreAuthResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"twoFactor\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.TwoFactor))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PreAuthResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PreAuthResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PreAuthResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PreAuthResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse14(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse15(in *jlexer.Lexer, out *PasswordResetResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse15(out *jwriter.Writer, in PasswordResetResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordResetResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordResetResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordResetResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordResetResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse15(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse16(in *jlexer.Lexer, out *OAuthStartResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse16(out *jwriter.Writer, in OAuthStartResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthStartResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthStartResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthStartResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthStartResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse16(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse17(in *jlexer.Lexer, out *OAuthLinkedResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "linked":
+			out.Linked = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse17(out *jwriter.Writer, in OAuthLinkedResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"linked\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Linked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthLinkedResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthLinkedResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthLinkedResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthLinkedResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse17(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse18(in *jlexer.Lexer, out *OAuthCallbackResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "remember":
+			out.Remember = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse18(out *jwriter.Writer, in OAuthCallbackResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"remember\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Remember))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthCallbackResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthCallbackResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthCallbackResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthCallbackResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse18(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse19(in *jlexer.Lexer, out *OAuthAccountResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "email":
+			out.Mail = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse19(out *jwriter.Writer, in OAuthAccountResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"provider\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Mail))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthAccountResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthAccountResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthAccountResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthAccountResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse19(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse20(in *jlexer.Lexer, out *OAuthAccountListResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "accounts":
+			if in.IsNull() {
+				in.Skip()
+				out.Accounts = nil
+			} else {
+				in.Delim('[')
+				if out.Accounts == nil {
+					if !in.IsDelim(']') {
+						out.Accounts = make([]OAuthAccountResponseBody, 0, 1)
+					} else {
+						out.Accounts = []OAuthAccountResponseBody{}
+					}
+				} else {
+					out.Accounts = (out.Accounts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 OAuthAccountResponseBody
+					(v22).UnmarshalEasyJSON(in)
+					out.Accounts = append(out.Accounts, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse20(out *jwriter.Writer, in OAuthAccountListResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"accounts\":"
+		out.RawString(prefix[1:])
+		if in.Accounts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Accounts {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				(v24).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthAccountListResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthAccountListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthAccountListResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthAccountListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse20(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse21(in *jlexer.Lexer, out *NotificationResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "seen":
+			out.Seen = bool(in.Bool())
+		case "userId":
+			out.UserId = string(in.String())
+		case "userName":
+			out.UserName = string(in.String())
+		case "userSurname":
+			out.UserSurname = string(in.String())
+		case "userImgUrl":
+			out.UserImgUrl = string(in.String())
+		case "eventId":
+			out.EventId = string(in.String())
+		case "eventTitle":
+			out.EventTitle = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse21(out *jwriter.Writer, in NotificationResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"seen\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Seen))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	{
+		const prefix string = ",\"userName\":"
+		out.RawString(prefix)
+		out.String(string(in.UserName))
+	}
+	{
+		const prefix string = ",\"userSurname\":"
+		out.RawString(prefix)
+		out.String(string(in.UserSurname))
+	}
+	{
+		const prefix string = ",\"userImgUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.UserImgUrl))
+	}
+	if in.EventId != "" {
+		const prefix string = ",\"eventId\":"
+		out.RawString(prefix)
+		out.String(string(in.EventId))
+	}
+	if in.EventTitle != "" {
+		const prefix string = ",\"eventTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.EventTitle))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse21(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse22(in *jlexer.Lexer, out *NotificationListResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Notifications = nil
+			} else {
+				in.Delim('[')
+				if out.Notifications == nil {
+					if !in.IsDelim(']') {
+						out.Notifications = make([]NotificationResponseBody, 0, 0)
+					} else {
+						out.Notifications = []NotificationResponseBody{}
+					}
+				} else {
+					out.Notifications = (out.Notifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 NotificationResponseBody
+					(v25).UnmarshalEasyJSON(in)
+					out.Notifications = append(out.Notifications, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse22(out *jwriter.Writer, in NotificationListResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notifications\":"
+		out.RawString(prefix[1:])
+		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Notifications {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationListResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationListResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse22(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse23(in *jlexer.Lexer, out *MapPinResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "eventId":
+			out.EventId = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "lat":
+			out.Latitude = float64(in.Float64())
+		case "lng":
+			out.Longitude = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse23(out *jwriter.Writer, in MapPinResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"eventId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.EventId))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"lat\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"lng\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapPinResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapPinResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapPinResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapPinResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse23(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse24(in *jlexer.Lexer, out *MapClusterResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lat":
+			out.Latitude = float64(in.Float64())
+		case "lng":
+			out.Longitude = float64(in.Float64())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse24(out *jwriter.Writer, in MapClusterResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lat\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"lng\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapClusterResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapClusterResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapClusterResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapClusterResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse24(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse25(in *jlexer.Lexer, out *LoginLockedResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "retryAfter":
+			out.RetryAfter = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse25(out *jwriter.Writer, in LoginLockedResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"retryAfter\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.RetryAfter))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginLockedResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginLockedResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginLockedResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginLockedResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse25(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse26(in *jlexer.Lexer, out *InvitationResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "eventId":
+			out.EventId = string(in.String())
+		case "eventTitle":
+			out.EventTitle = string(in.String())
+		case "inviterId":
+			out.InviterId = string(in.String())
+		case "receiverId":
+			out.ReceiverId = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "waitlistPosition":
+			out.WaitlistPosition = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse26(out *jwriter.Writer, in InvitationResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"eventId\":"
+		out.RawString(prefix)
+		out.String(string(in.EventId))
+	}
+	{
+		const prefix string = ",\"eventTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.EventTitle))
+	}
+	{
+		const prefix string = ",\"inviterId\":"
+		out.RawString(prefix)
+		out.String(string(in.InviterId))
+	}
+	{
+		const prefix string = ",\"receiverId\":"
+		out.RawString(prefix)
+		out.String(string(in.ReceiverId))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	if in.WaitlistPosition != 0 {
+		const prefix string = ",\"waitlistPosition\":"
+		out.RawString(prefix)
+		out.Int(int(in.WaitlistPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InvitationResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InvitationResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InvitationResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InvitationResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse26(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse27(in *jlexer.Lexer, out *InvitationListResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "invitations":
+			if in.IsNull() {
+				in.Skip()
+				out.Invitations = nil
+			} else {
+				in.Delim('[')
+				if out.Invitations == nil {
+					if !in.IsDelim(']') {
+						out.Invitations = make([]InvitationResponseBody, 0, 0)
+					} else {
+						out.Invitations = []InvitationResponseBody{}
+					}
+				} else {
+					out.Invitations = (out.Invitations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 InvitationResponseBody
+					(v28).UnmarshalEasyJSON(in)
+					out.Invitations = append(out.Invitations, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse27(out *jwriter.Writer, in InvitationListResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"invitations\":"
+		out.RawString(prefix[1:])
+		if in.Invitations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Invitations {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InvitationListResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InvitationListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InvitationListResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InvitationListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse27(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse28(in *jlexer.Lexer, out *FavouriteResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			out.Result = bool(in.Bool())
+		case "waitlistPosition":
+			out.WaitlistPosition = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse28(out *jwriter.Writer, in FavouriteResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Result))
+	}
+	if in.WaitlistPosition != 0 {
+		const prefix string = ",\"waitlistPosition\":"
+		out.RawString(prefix)
+		out.Int(int(in.WaitlistPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FavouriteResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse7(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FavouriteResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse7(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FavouriteResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse7(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FavouriteResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse7(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse28(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse8(in *jlexer.Lexer, out *EventResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse29(in *jlexer.Lexer, out *EventResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -827,36 +2694,329 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse8(in *jlexer.Lexer, out *Event
 		case "tag":
 			if in.IsNull() {
 				in.Skip()
-				out.Tag = nil
+				out.Tag = nil
+			} else {
+				in.Delim('[')
+				if out.Tag == nil {
+					if !in.IsDelim(']') {
+						out.Tag = make([]string, 0, 4)
+					} else {
+						out.Tag = []string{}
+					}
+				} else {
+					out.Tag = (out.Tag)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 string
+					v31 = string(in.String())
+					out.Tag = append(out.Tag, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "startDate":
+			out.StartDate = string(in.String())
+		case "endDate":
+			out.EndDate = string(in.String())
+		case "timeZone":
+			out.TimeZone = string(in.String())
+		case "geo":
+			out.Geo = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "authorid":
+			out.AuthorID = string(in.String())
+		case "favourite":
+			out.IsVisited = bool(in.Bool())
+		case "snippet":
+			out.Snippet = string(in.String())
+		case "capacity":
+			out.Capacity = int(in.Int())
+		case "seatsLeft":
+			if in.IsNull() {
+				in.Skip()
+				out.SeatsLeft = nil
+			} else {
+				if out.SeatsLeft == nil {
+					out.SeatsLeft = new(int)
+				}
+				*out.SeatsLeft = int(in.Int())
+			}
+		case "waitlistPosition":
+			out.WaitlistPosition = int(in.Int())
+		case "seriesId":
+			out.SeriesId = string(in.String())
+		case "rrule":
+			out.RRule = string(in.String())
+		case "exdates":
+			if in.IsNull() {
+				in.Skip()
+				out.ExDates = nil
+			} else {
+				in.Delim('[')
+				if out.ExDates == nil {
+					if !in.IsDelim(']') {
+						out.ExDates = make([]string, 0, 4)
+					} else {
+						out.ExDates = []string{}
+					}
+				} else {
+					out.ExDates = (out.ExDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 string
+					v32 = string(in.String())
+					out.ExDates = append(out.ExDates, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse29(out *jwriter.Writer, in EventResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"viewed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Viewed))
+	}
+	{
+		const prefix string = ",\"imgUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.ImgUrl))
+	}
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix)
+		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Tag {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v34))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"startDate\":"
+		out.RawString(prefix)
+		out.String(string(in.StartDate))
+	}
+	{
+		const prefix string = ",\"endDate\":"
+		out.RawString(prefix)
+		out.String(string(in.EndDate))
+	}
+	{
+		const prefix string = ",\"timeZone\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeZone))
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		out.String(string(in.Geo))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"authorid\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"favourite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsVisited))
+	}
+	if in.Snippet != "" {
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix)
+		out.String(string(in.Snippet))
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Capacity))
+	}
+	if in.SeatsLeft != nil {
+		const prefix string = ",\"seatsLeft\":"
+		out.RawString(prefix)
+		out.Int(int(*in.SeatsLeft))
+	}
+	if in.WaitlistPosition != 0 {
+		const prefix string = ",\"waitlistPosition\":"
+		out.RawString(prefix)
+		out.Int(int(in.WaitlistPosition))
+	}
+	if in.SeriesId != "" {
+		const prefix string = ",\"seriesId\":"
+		out.RawString(prefix)
+		out.String(string(in.SeriesId))
+	}
+	if in.RRule != "" {
+		const prefix string = ",\"rrule\":"
+		out.RawString(prefix)
+		out.String(string(in.RRule))
+	}
+	if len(in.ExDates) != 0 {
+		const prefix string = ",\"exdates\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v35, v36 := range in.ExDates {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v36))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EventResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EventResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EventResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EventResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse29(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse30(in *jlexer.Lexer, out *EventMapResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pins":
+			if in.IsNull() {
+				in.Skip()
+				out.Pins = nil
 			} else {
 				in.Delim('[')
-				if out.Tag == nil {
+				if out.Pins == nil {
 					if !in.IsDelim(']') {
-						out.Tag = make([]string, 0, 4)
+						out.Pins = make([]MapPinResponseBody, 0, 1)
 					} else {
-						out.Tag = []string{}
+						out.Pins = []MapPinResponseBody{}
 					}
 				} else {
-					out.Tag = (out.Tag)[:0]
+					out.Pins = (out.Pins)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Tag = append(out.Tag, v10)
+					var v37 MapPinResponseBody
+					(v37).UnmarshalEasyJSON(in)
+					out.Pins = append(out.Pins, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "clusters":
+			if in.IsNull() {
+				in.Skip()
+				out.Clusters = nil
+			} else {
+				in.Delim('[')
+				if out.Clusters == nil {
+					if !in.IsDelim(']') {
+						out.Clusters = make([]MapClusterResponseBody, 0, 2)
+					} else {
+						out.Clusters = []MapClusterResponseBody{}
+					}
+				} else {
+					out.Clusters = (out.Clusters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 MapClusterResponseBody
+					(v38).UnmarshalEasyJSON(in)
+					out.Clusters = append(out.Clusters, v38)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "date":
-			out.Date = string(in.String())
-		case "geo":
-			out.Geo = string(in.String())
-		case "address":
-			out.Address = string(in.String())
-		case "authorid":
-			out.AuthorID = string(in.String())
-		case "favourite":
-			out.IsVisited = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -867,124 +3027,69 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse8(in *jlexer.Lexer, out *Event
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse8(out *jwriter.Writer, in EventResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse30(out *jwriter.Writer, in EventMapResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.ID != "" {
-		const prefix string = ",\"id\":"
-		first = false
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
 	{
-		const prefix string = ",\"title\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		const prefix string = ",\"pins\":"
+		out.RawString(prefix[1:])
+		if in.Pins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			out.RawByte('[')
+			for v39, v40 := range in.Pins {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				(v40).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"city\":"
-		out.RawString(prefix)
-		out.String(string(in.City))
-	}
-	{
-		const prefix string = ",\"category\":"
-		out.RawString(prefix)
-		out.String(string(in.Category))
-	}
-	{
-		const prefix string = ",\"viewed\":"
-		out.RawString(prefix)
-		out.Int(int(in.Viewed))
-	}
-	{
-		const prefix string = ",\"imgUrl\":"
-		out.RawString(prefix)
-		out.String(string(in.ImgUrl))
 	}
 	{
-		const prefix string = ",\"tag\":"
+		const prefix string = ",\"clusters\":"
 		out.RawString(prefix)
-		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Clusters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Tag {
-				if v11 > 0 {
+			for v41, v42 := range in.Clusters {
+				if v41 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				(v42).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"date\":"
-		out.RawString(prefix)
-		out.String(string(in.Date))
-	}
-	{
-		const prefix string = ",\"geo\":"
-		out.RawString(prefix)
-		out.String(string(in.Geo))
-	}
-	{
-		const prefix string = ",\"address\":"
-		out.RawString(prefix)
-		out.String(string(in.Address))
-	}
-	{
-		const prefix string = ",\"authorid\":"
-		out.RawString(prefix)
-		out.String(string(in.AuthorID))
-	}
-	{
-		const prefix string = ",\"favourite\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsVisited))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EventResponseBody) MarshalJSON() ([]byte, error) {
+func (v EventMapResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse8(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EventResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse8(w, v)
+func (v EventMapResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EventResponseBody) UnmarshalJSON(data []byte) error {
+func (v *EventMapResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse8(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EventResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse8(l, v)
+func (v *EventMapResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse30(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse9(in *jlexer.Lexer, out *EventListResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse31(in *jlexer.Lexer, out *EventListResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1019,13 +3124,15 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse9(in *jlexer.Lexer, out *Event
 					out.Events = (out.Events)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 EventResponseBody
-					(v13).UnmarshalEasyJSON(in)
-					out.Events = append(out.Events, v13)
+					var v43 EventResponseBody
+					(v43).UnmarshalEasyJSON(in)
+					out.Events = append(out.Events, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1036,7 +3143,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse9(in *jlexer.Lexer, out *Event
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse9(out *jwriter.Writer, in EventListResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse31(out *jwriter.Writer, in EventListResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1047,42 +3154,47 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse9(out *jwriter.Writer, in Even
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Events {
-				if v14 > 0 {
+			for v44, v45 := range in.Events {
+				if v44 > 0 {
 					out.RawByte(',')
 				}
-				(v15).MarshalEasyJSON(out)
+				(v45).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v EventListResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse9(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v EventListResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse9(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *EventListResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse9(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *EventListResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse9(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse31(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse10(in *jlexer.Lexer, out *EventIDResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse32(in *jlexer.Lexer, out *EventIDResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1113,7 +3225,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse10(in *jlexer.Lexer, out *Even
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse10(out *jwriter.Writer, in EventIDResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse32(out *jwriter.Writer, in EventIDResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1128,27 +3240,27 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse10(out *jwriter.Writer, in Eve
 // MarshalJSON supports json.Marshaler interface
 func (v EventIDResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse10(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v EventIDResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse10(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *EventIDResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse10(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *EventIDResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse10(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse32(l, v)
 }
-func easyjson6ff3ac1dDecodeBackendInternalResponse11(in *jlexer.Lexer, out *CitiesResponseBody) {
+func easyjson6ff3ac1dDecodeBackendInternalResponse33(in *jlexer.Lexer, out *CitiesResponseBody) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1183,9 +3295,9 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse11(in *jlexer.Lexer, out *Citi
 					out.Cities = (out.Cities)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Cities = append(out.Cities, v16)
+					var v46 string
+					v46 = string(in.String())
+					out.Cities = append(out.Cities, v46)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1200,7 +3312,7 @@ func easyjson6ff3ac1dDecodeBackendInternalResponse11(in *jlexer.Lexer, out *Citi
 		in.Consumed()
 	}
 }
-func easyjson6ff3ac1dEncodeBackendInternalResponse11(out *jwriter.Writer, in CitiesResponseBody) {
+func easyjson6ff3ac1dEncodeBackendInternalResponse33(out *jwriter.Writer, in CitiesResponseBody) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1211,11 +3323,11 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse11(out *jwriter.Writer, in Cit
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Cities {
-				if v17 > 0 {
+			for v47, v48 := range in.Cities {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v18))
+				out.String(string(v48))
 			}
 			out.RawByte(']')
 		}
@@ -1226,23 +3338,184 @@ func easyjson6ff3ac1dEncodeBackendInternalResponse11(out *jwriter.Writer, in Cit
 // MarshalJSON supports json.Marshaler interface
 func (v CitiesResponseBody) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6ff3ac1dEncodeBackendInternalResponse11(&w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CitiesResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6ff3ac1dEncodeBackendInternalResponse11(w, v)
+	easyjson6ff3ac1dEncodeBackendInternalResponse33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CitiesResponseBody) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6ff3ac1dDecodeBackendInternalResponse11(&r, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CitiesResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6ff3ac1dDecodeBackendInternalResponse11(l, v)
+	easyjson6ff3ac1dDecodeBackendInternalResponse33(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse34(in *jlexer.Lexer, out *CalendarResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse34(out *jwriter.Writer, in CalendarResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CalendarResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CalendarResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CalendarResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CalendarResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse34(l, v)
+}
+func easyjson6ff3ac1dDecodeBackendInternalResponse35(in *jlexer.Lexer, out *HealthResponseBody) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "failed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Failed = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.Failed)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeBackendInternalResponse35(out *jwriter.Writer, in HealthResponseBody) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Failed) != 0 {
+		const prefix string = ",\"failed\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Failed {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HealthResponseBody) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeBackendInternalResponse35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HealthResponseBody) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeBackendInternalResponse35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HealthResponseBody) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeBackendInternalResponse35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HealthResponseBody) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeBackendInternalResponse35(l, v)
 }
@@ -1,14 +1,19 @@
 package response
 
 import (
+	"backend/internal/errcode"
 	error2 "backend/internal/error"
 	models "backend/internal/models"
+	authError "backend/internal/service/auth/error"
+	"backend/pkg/ical"
 	log "backend/pkg/logger"
 	"errors"
 	json "github.com/mailru/easyjson"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/go-sanitize/sanitize"
@@ -60,6 +65,91 @@ func GetUserFromRequest(r io.Reader) (*models.User, error) {
 	return result, nil
 }
 
+func GetSignInFromRequest(r io.Reader) (*models.User, bool, error) {
+	signInInput := new(SignInResponseBody)
+	err := json.UnmarshalFromReader(r, signInInput)
+	if err != nil {
+		return nil, false, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(signInInput)
+	if err != nil {
+		return nil, false, err
+	}
+	result := &models.User{
+		Mail:     signInInput.Mail,
+		Password: signInInput.Password,
+	}
+	return result, signInInput.Remember, nil
+}
+
+// GetUserRoleFromRequest returns the role from the body of a role change request
+func GetUserRoleFromRequest(r io.Reader) (string, error) {
+	userInput := new(UserResponseBody)
+	err := json.UnmarshalFromReader(r, userInput)
+	if err != nil {
+		return "", ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(userInput)
+	if err != nil {
+		return "", err
+	}
+	return userInput.Role, nil
+}
+
+// GetTwoFactorFromRequest returns the pre-auth token, the code and the "remember me" flag
+func GetTwoFactorFromRequest(r io.Reader) (string, string, bool, error) {
+	twoFactorInput := new(TwoFactorResponseBody)
+	err := json.UnmarshalFromReader(r, twoFactorInput)
+	if err != nil {
+		return "", "", false, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(twoFactorInput)
+	if err != nil {
+		return "", "", false, err
+	}
+	return twoFactorInput.Token, strings.TrimSpace(twoFactorInput.Code), twoFactorInput.Remember, nil
+}
+
+// GetOAuthCallbackFromRequest returns the code, the state and the "remember me" flag
+func GetOAuthCallbackFromRequest(r io.Reader) (string, string, bool, error) {
+	callbackInput := new(OAuthCallbackResponseBody)
+	err := json.UnmarshalFromReader(r, callbackInput)
+	if err != nil {
+		return "", "", false, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(callbackInput)
+	if err != nil {
+		return "", "", false, err
+	}
+	return callbackInput.Code, callbackInput.State, callbackInput.Remember, nil
+}
+
+func GetPasswordResetFromRequest(r io.Reader) (string, string, error) {
+	resetInput := new(PasswordResetResponseBody)
+	err := json.UnmarshalFromReader(r, resetInput)
+	if err != nil {
+		return "", "", ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(resetInput)
+	if err != nil {
+		return "", "", err
+	}
+	return resetInput.Token, resetInput.Password, nil
+}
+
+func GetVerificationTokenFromRequest(r io.Reader) (string, error) {
+	verificationInput := new(VerificationResponseBody)
+	err := json.UnmarshalFromReader(r, verificationInput)
+	if err != nil {
+		return "", ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(verificationInput)
+	if err != nil {
+		return "", err
+	}
+	return verificationInput.Token, nil
+}
+
 func GetUsersIdFromRequest(r io.Reader) ([]string, error) {
 	message := logMessage + "GetUsersIdFromRequest:"
 	_ = message
@@ -86,6 +176,39 @@ func MakeUserResponseBody(u *models.User) UserResponseBody {
 		ImgUrl:   u.ImgUrl,
 		Mail:     u.Mail,
 		Password: u.Password,
+		Verified: u.Verified,
+		Role:     u.Role,
+	}
+}
+
+func MakeSessionListResponseBody(sessions []*models.Session) SessionListResponseBody {
+	result := make([]SessionResponseBody, len(sessions))
+	for i, s := range sessions {
+		result[i] = SessionResponseBody{
+			ID:        s.ID,
+			UserAgent: s.UserAgent,
+			IP:        s.IP,
+			CreatedAt: s.CreatedAt.UTC().Format(time.RFC3339),
+			LastSeen:  s.LastSeen.UTC().Format(time.RFC3339),
+			Current:   s.Current,
+		}
+	}
+	return SessionListResponseBody{
+		Sessions: result,
+	}
+}
+
+func MakeOAuthAccountListResponseBody(accounts []*models.OAuthAccount) OAuthAccountListResponseBody {
+	result := make([]OAuthAccountResponseBody, len(accounts))
+	for i, a := range accounts {
+		result[i] = OAuthAccountResponseBody{
+			Provider:  a.Provider,
+			Mail:      a.Mail,
+			CreatedAt: a.CreatedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	return OAuthAccountListResponseBody{
+		Accounts: result,
 	}
 }
 
@@ -99,6 +222,96 @@ func MakeUserListResponseBody(users []*models.User) UserListResponseBody {
 	}
 }
 
+func MakeVisitorsResponseBody(visitors *models.Visitors) VisitorsResponseBody {
+	return VisitorsResponseBody{
+		Going:    MakeUserListResponseBody(visitors.Going).Users,
+		Maybe:    MakeUserListResponseBody(visitors.Maybe).Users,
+		Declined: MakeUserListResponseBody(visitors.Declined).Users,
+	}
+}
+
+func MakeInvitationResponseBody(i *models.Invitation) InvitationResponseBody {
+	return InvitationResponseBody{
+		ID:         i.ID,
+		EventId:    i.EventId,
+		EventTitle: i.EventTitle,
+		InviterId:  i.InviterId,
+		ReceiverId: i.ReceiverId,
+		Status:     i.Status,
+		CreatedAt:  formatEventTime(i.CreatedAt, ""),
+	}
+}
+
+func MakeInvitationListResponseBody(invitations []*models.Invitation) InvitationListResponseBody {
+	result := make([]InvitationResponseBody, len(invitations))
+	for i := 0; i < len(invitations); i++ {
+		result[i] = MakeInvitationResponseBody(invitations[i])
+	}
+	return InvitationListResponseBody{
+		Invitations: result,
+	}
+}
+
+func GetRSVPStatusFromRequest(r io.Reader) (string, error) {
+	rsvpInput := new(RSVPResponseBody)
+	err := json.UnmarshalFromReader(r, rsvpInput)
+	if err != nil {
+		return "", ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(rsvpInput)
+	if err != nil {
+		return "", err
+	}
+	return rsvpInput.Status, nil
+}
+
+func parseEventTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, ErrValidation
+	}
+	return t, nil
+}
+
+func formatEventTime(t time.Time, timeZone string) string {
+	if t.IsZero() {
+		return ""
+	}
+	if location, err := time.LoadLocation(timeZone); err == nil {
+		t = t.In(location)
+	}
+	return t.Format(time.RFC3339)
+}
+
+const exDateLayout = "2006-01-02"
+
+// Coordinates are passed as "(latitude, longitude)"
+func parseEventGeo(value string) (float64, float64, error) {
+	if value == "" {
+		return 0, 0, nil
+	}
+	coords := strings.Split(strings.Trim(value, "() "), ",")
+	if len(coords) != 2 {
+		return 0, 0, ErrValidation
+	}
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(coords[0]), 64)
+	if err != nil {
+		return 0, 0, ErrValidation
+	}
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(coords[1]), 64)
+	if err != nil {
+		return 0, 0, ErrValidation
+	}
+	return latitude, longitude, nil
+}
+
+func formatEventGeo(latitude, longitude float64) string {
+	return "(" + strconv.FormatFloat(latitude, 'f', -1, 64) + ", " + strconv.FormatFloat(longitude, 'f', -1, 64) + ")"
+}
+
 func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 	eventInput := new(EventResponseBody)
 	err := json.UnmarshalFromReader(r, eventInput)
@@ -110,6 +323,26 @@ func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 	if err != nil {
 		return nil, err
 	}
+	startDate, err := parseEventTime(eventInput.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseEventTime(eventInput.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	latitude, longitude, err := parseEventGeo(eventInput.Geo)
+	if err != nil {
+		return nil, err
+	}
+	var exDates []time.Time
+	for _, d := range eventInput.ExDates {
+		exDate, err := time.Parse(exDateLayout, d)
+		if err != nil {
+			return nil, ErrValidation
+		}
+		exDates = append(exDates, exDate)
+	}
 	result := &models.Event{
 		ID:          eventInput.ID,
 		Title:       eventInput.Title,
@@ -120,39 +353,86 @@ func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 		Viewed:      eventInput.Viewed,
 		ImgUrl:      eventInput.ImgUrl,
 		Tag:         eventInput.Tag,
-		Date:        eventInput.Date,
-		Geo:         eventInput.Geo,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		TimeZone:    eventInput.TimeZone,
+		Latitude:    latitude,
+		Longitude:   longitude,
 		Address:     eventInput.Address,
+		Capacity:    eventInput.Capacity,
+		RRule:       eventInput.RRule,
+		ExDates:     exDates,
 	}
 	return result, nil
 }
 
 func MakeEventResponseBody(e *models.Event) EventResponseBody {
-	return EventResponseBody{
-		ID:          e.ID,
-		Title:       e.Title,
-		Description: e.Description,
-		Text:        e.Text,
-		City:        e.City,
-		Category:    e.Category,
-		Viewed:      e.Viewed,
-		ImgUrl:      e.ImgUrl,
-		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
-		Address:     e.Address,
-		AuthorID:    e.AuthorId,
-		IsVisited:   e.IsVisited,
-	}
-}
-
-func MakeEventListResponseBody(events []*models.Event) EventListResponseBody {
+	body := EventResponseBody{
+		ID:               e.ID,
+		Title:            e.Title,
+		Description:      e.Description,
+		Text:             e.Text,
+		City:             e.City,
+		Category:         e.Category,
+		Viewed:           e.Viewed,
+		ImgUrl:           e.ImgUrl,
+		Tag:              e.Tag,
+		StartDate:        formatEventTime(e.StartDate, e.TimeZone),
+		EndDate:          formatEventTime(e.EndDate, e.TimeZone),
+		TimeZone:         e.TimeZone,
+		Geo:              formatEventGeo(e.Latitude, e.Longitude),
+		Address:          e.Address,
+		AuthorID:         e.AuthorId,
+		IsVisited:        e.IsVisited,
+		Snippet:          e.Snippet,
+		Capacity:         e.Capacity,
+		WaitlistPosition: e.WaitlistPosition,
+		SeriesId:         e.SeriesId,
+		RRule:            e.RRule,
+	}
+	for _, d := range e.ExDates {
+		body.ExDates = append(body.ExDates, d.Format(exDateLayout))
+	}
+	if e.Capacity > 0 {
+		seatsLeft := e.SeatsLeft
+		body.SeatsLeft = &seatsLeft
+	}
+	return body
+}
+
+func MakeEventListResponseBody(events []*models.Event, nextCursor string) EventListResponseBody {
 	result := make([]EventResponseBody, len(events))
 	for i := 0; i < len(events); i++ {
 		result[i] = MakeEventResponseBody(events[i])
 	}
 	return EventListResponseBody{
-		Events: result,
+		Events:     result,
+		NextCursor: nextCursor,
+	}
+}
+
+func MakeEventMapResponseBody(m *models.EventMap) EventMapResponseBody {
+	pins := make([]MapPinResponseBody, len(m.Pins))
+	for i, pin := range m.Pins {
+		pins[i] = MapPinResponseBody{
+			EventId:   pin.EventId,
+			Title:     pin.Title,
+			Category:  pin.Category,
+			Latitude:  pin.Latitude,
+			Longitude: pin.Longitude,
+		}
+	}
+	clusters := make([]MapClusterResponseBody, len(m.Clusters))
+	for i, cluster := range m.Clusters {
+		clusters[i] = MapClusterResponseBody{
+			Latitude:  cluster.Latitude,
+			Longitude: cluster.Longitude,
+			Count:     cluster.Count,
+		}
+	}
+	return EventMapResponseBody{
+		Pins:     pins,
+		Clusters: clusters,
 	}
 }
 
@@ -179,9 +459,42 @@ func MakeNotificationListResponseBody(notifications []*models.Notification) Noti
 	}
 }
 
+func MakeICalEvent(e *models.Event, domain string) ical.Event {
+	location := e.Address
+	if location == "" {
+		location = e.City
+	}
+	return ical.Event{
+		UID:          "event-" + e.ID + "@" + domain,
+		Summary:      e.Title,
+		Description:  e.Description,
+		Location:     location,
+		Categories:   e.Tag,
+		Start:        e.StartDate,
+		End:          e.EndDate,
+		LastModified: e.UpdatedAt,
+		Latitude:     e.Latitude,
+		Longitude:    e.Longitude,
+	}
+}
+
+// Calendar is not cached, so that subscribed calendar apps get the changes of the events
+func SendCalendar(w http.ResponseWriter, filename string, calendar *ical.Calendar) {
+	message := logMessage + "SendCalendar:"
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(calendar.Marshal())
+	if err != nil {
+		log.Error(message+"err =", err)
+	}
+}
+
+// The status is sent both in the header and in the body, APIVersion keeps 200 in the header for the old clients
 func SendResponse(w http.ResponseWriter, response *Response) {
 	message := logMessage + "SendResponse:"
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(int(response.Status))
 	b, err := json.Marshal(response)
 	if err != nil {
 		log.Error(message+"err =", err)
@@ -193,52 +506,91 @@ func SendResponse(w http.ResponseWriter, response *Response) {
 	}
 }
 
-func refactorError(err error) (error, HttpStatus) {
+type httpError struct {
+	err    error
+	status HttpStatus
+}
+
+// The message for the user and the status of every error code
+var httpErrors = map[errcode.Code]httpError{
+	errcode.CodeUnavailable:        {error2.ErrInternal, http.StatusInternalServerError},
+	errcode.CodeEmptyData:          {error2.ErrEmptyData, http.StatusBadRequest},
+	errcode.CodeDatabase:           {error2.ErrPostgres, http.StatusInternalServerError},
+	errcode.CodeNotNumber:          {error2.ErrAtoi, http.StatusBadRequest},
+	errcode.CodeNotAllowed:         {error2.ErrNotAllowed, http.StatusForbidden},
+	errcode.CodeNotFound:           {error2.ErrNoRows, http.StatusNotFound},
+	errcode.CodeUserNotFound:       {error2.ErrUserNotFound, http.StatusNotFound},
+	errcode.CodeUserExists:         {error2.ErrUserExists, http.StatusConflict},
+	errcode.CodeNoCookie:           {error2.ErrCookie, http.StatusUnauthorized},
+	errcode.CodeSessionNotFound:    {error2.ErrSessionNotFound, http.StatusUnauthorized},
+	errcode.CodeUnknownSession:     {error2.ErrUnknownSession, http.StatusNotFound},
+	errcode.CodeRole:               {error2.ErrRole, http.StatusBadRequest},
+	errcode.CodeCursor:             {error2.ErrCursor, http.StatusBadRequest},
+	errcode.CodeDateFormat:         {error2.ErrDateFormat, http.StatusBadRequest},
+	errcode.CodeTimeZone:           {error2.ErrTimeZone, http.StatusBadRequest},
+	errcode.CodeDateRange:          {error2.ErrDateRange, http.StatusBadRequest},
+	errcode.CodeStartDate:          {error2.ErrStartDate, http.StatusBadRequest},
+	errcode.CodeGeo:                {error2.ErrGeo, http.StatusBadRequest},
+	errcode.CodeBBox:               {error2.ErrBBox, http.StatusBadRequest},
+	errcode.CodeGeocoder:           {error2.ErrGeocoder, http.StatusBadGateway},
+	errcode.CodeCapacity:           {error2.ErrCapacity, http.StatusBadRequest},
+	errcode.CodeRSVPStatus:         {error2.ErrRSVPStatus, http.StatusBadRequest},
+	errcode.CodeInvitationExists:   {error2.ErrInvitationExists, http.StatusConflict},
+	errcode.CodeInvitationExpired:  {error2.ErrInvitationExpired, http.StatusBadRequest},
+	errcode.CodeInvitationAnswered: {error2.ErrInvitationAnswered, http.StatusConflict},
+	errcode.CodeInvitationStatus:   {error2.ErrInvitationStatus, http.StatusBadRequest},
+	errcode.CodeRRule:              {error2.ErrRRule, http.StatusBadRequest},
+	errcode.CodeNotSeries:          {error2.ErrNotSeries, http.StatusBadRequest},
+	errcode.CodeSeriesTooLong:      {error2.ErrSeriesTooLong, http.StatusBadRequest},
+	errcode.CodeCalendarToken:      {error2.ErrCalendarToken, http.StatusForbidden},
+	errcode.CodeResetToken:         {error2.ErrResetToken, http.StatusBadRequest},
+	errcode.CodeTooManyRequests:    {error2.ErrTooManyRequests, http.StatusTooManyRequests},
+	errcode.CodeVerificationToken:  {error2.ErrVerificationToken, http.StatusBadRequest},
+	errcode.CodeNotVerified:        {error2.ErrNotVerified, http.StatusForbidden},
+	errcode.CodeAlreadyVerified:    {error2.ErrAlreadyVerified, http.StatusConflict},
+	errcode.CodeTwoFactorCode:      {error2.ErrTwoFactorCode, http.StatusBadRequest},
+	errcode.CodePreAuthToken:       {error2.ErrPreAuthToken, http.StatusUnauthorized},
+	errcode.CodeTwoFactorEnabled:   {error2.ErrTwoFactorEnabled, http.StatusConflict},
+	errcode.CodeTwoFactorDisabled:  {error2.ErrTwoFactorDisabled, http.StatusConflict},
+	errcode.CodeOAuthProvider:      {error2.ErrOAuthProvider, http.StatusNotFound},
+	errcode.CodeOAuthState:         {error2.ErrOAuthState, http.StatusBadRequest},
+	errcode.CodeOAuthFailed:        {error2.ErrOAuthFailed, http.StatusBadGateway},
+	errcode.CodeOAuthNoEmail:       {error2.ErrOAuthNoEmail, http.StatusBadRequest},
+	errcode.CodeOAuthEmailExists:   {error2.ErrOAuthEmailExists, http.StatusConflict},
+	errcode.CodeOAuthLinked:        {error2.ErrOAuthLinked, http.StatusConflict},
+	errcode.CodeOAuthNotLinked:     {error2.ErrOAuthNotLinked, http.StatusNotFound},
+	errcode.CodeCSRFToken:          {error2.ErrCSRFToken, http.StatusForbidden},
+}
+
+func refactorError(err error) (error, HttpStatus, errcode.Code) {
 	if err == nil {
-		return nil, http.StatusOK
-	}
-	errStr := err.Error()
-	if strings.Contains(errStr, "user already exists") {
-		return error2.ErrUserExists, http.StatusConflict
-	}
-	if strings.Contains(errStr, "user not found") {
-		return error2.ErrUserNotFound, http.StatusNotFound
-	}
-	if strings.Contains(errStr, "internal DB server error") {
-		return error2.ErrPostgres, http.StatusInternalServerError
-	}
-	if strings.Contains(errStr, "cookie") {
-		return error2.ErrCookie, http.StatusUnauthorized
-	}
-	if strings.Contains(errStr, "required data is empty") {
-		return error2.ErrEmptyData, http.StatusBadRequest
-	}
-	if strings.Contains(errStr, "cant cast string to int") {
-		return error2.ErrAtoi, http.StatusBadRequest
-	}
-	if strings.Contains(errStr, "user is not allowed to do this") {
-		return error2.ErrNotAllowed, http.StatusForbidden
-	}
-	if strings.Contains(errStr, "no rows in a query result") {
-		return error2.ErrNoRows, http.StatusNotFound
+		return nil, http.StatusOK, ""
 	}
-	if strings.Contains(errStr, "Error while dialing dial tcp") {
-		return error2.ErrInternal, http.StatusInternalServerError
+	var locked *authError.LoginLockedError
+	if errors.As(err, &locked) {
+		return &error2.LoginLockedError{RetryAfter: locked.RetryAfter}, http.StatusLocked, errcode.CodeLoginLocked
 	}
-	if strings.Contains(errStr, "session was not found") {
-		return error2.ErrSessionNotFound, http.StatusUnauthorized
+	code := errcode.Of(err)
+	if e, ok := httpErrors[code]; ok {
+		return e.err, e.status, code
 	}
-	return err, http.StatusBadRequest
+	return err, http.StatusBadRequest, code
 }
 
 func CheckIfNoError(w *http.ResponseWriter, err error, msg string) bool {
 	if err != nil {
 		log.Error(msg+"err = ", err)
 	}
-	errRefactored, status := refactorError(err)
+	errRefactored, status, code := refactorError(err)
 	if err != nil {
 		log.Error(msg+"refactored err = ", errRefactored)
-		SendResponse(*w, StatusResponse(status))
+		var locked *error2.LoginLockedError
+		if errors.As(errRefactored, &locked) {
+			(*w).Header().Set("Retry-After", strconv.FormatInt(int64(locked.RetryAfter/time.Second), 10))
+			SendResponse(*w, LoginLockedResponse(status, code, locked))
+			return false
+		}
+		SendResponse(*w, ErrorResponse(status, code))
 		return false
 	}
 	return true
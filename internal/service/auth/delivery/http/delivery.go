@@ -5,10 +5,23 @@ import (
 	"backend/internal/service/auth"
 	error2 "backend/internal/service/auth/error"
 	log "backend/pkg/logger"
+	"crypto/subtle"
+	"net"
 	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
 )
 
-const logMessage = "service:auth:delivery:http:"
+const (
+	logMessage = "service:auth:delivery:http:"
+	// Matches the absolute session lifetime of the auth service
+	rememberCookieMaxAge = 30 * 24 * 60 * 60
+	// Matches the lifetime of the oauth state in the auth service
+	oauthStateCookieName   = "oauth_state"
+	oauthStateCookieMaxAge = 10 * 60
+)
 
 type Delivery struct {
 	UseCase auth.UseCase
@@ -20,7 +33,8 @@ func NewDelivery(useCase auth.UseCase) *Delivery {
 	}
 }
 
-func setSessionIdCookie(w http.ResponseWriter, sessionId string) {
+// Without "remember me" the cookie is dropped when the browser is closed
+func setSessionIdCookie(w http.ResponseWriter, sessionId string, remember bool) {
 	cookie := &http.Cookie{
 		Name:     "session_id",
 		Value:    sessionId,
@@ -29,6 +43,9 @@ func setSessionIdCookie(w http.ResponseWriter, sessionId string) {
 		SameSite: http.SameSiteNoneMode,
 		Path:     "/",
 	}
+	if remember {
+		cookie.MaxAge = rememberCookieMaxAge
+	}
 	http.SetCookie(w, cookie)
 }
 
@@ -44,6 +61,86 @@ func setExpiredCookie(w http.ResponseWriter) {
 	http.SetCookie(w, cookie)
 }
 
+// The state is also kept in a cookie, so that the callback is accepted only in the browser that started the login
+func setOAuthStateCookie(w http.ResponseWriter, state string, maxAge int) {
+	cookie := &http.Cookie{
+		Name:     oauthStateCookieName,
+		Value:    state,
+		HttpOnly: true,
+		Secure:   true,
+		MaxAge:   maxAge,
+		SameSite: http.SameSiteNoneMode,
+		Path:     "/",
+	}
+	http.SetCookie(w, cookie)
+}
+
+// trustedProxy reports if the address is in trusted_proxies, the list holds addresses and CIDRs
+func trustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range viper.GetStringSlice("trusted_proxies") {
+		if !strings.Contains(proxy, "/") {
+			if ip.Equal(net.ParseIP(proxy)) {
+				return true
+			}
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			log.Error(logMessage+"trustedProxy:err =", err)
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP trusts X-Real-IP and X-Forwarded-For only from trusted_proxies,
+// otherwise the client could send any address and get around the IP lockout
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !trustedProxy(host) {
+		return host
+	}
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		// Every proxy appends the address it got the request from, the first untrusted one from the right is the client
+		addrs := strings.Split(forwarded, ",")
+		for i := len(addrs) - 1; i >= 0; i-- {
+			addr := strings.TrimSpace(addrs[i])
+			if i == 0 || !trustedProxy(addr) {
+				return addr
+			}
+		}
+	}
+	return host
+}
+
+// startSession logs the user in: sets the session cookie and sends a CSRF token of the new session
+func (h *Delivery) startSession(w http.ResponseWriter, r *http.Request, userId string, remember bool) error {
+	sessionId, err := h.UseCase.CreateSession(userId, r.UserAgent(), clientIP(r), remember)
+	if err != nil {
+		return err
+	}
+	CSRFToken, err := h.UseCase.CreateToken(sessionId)
+	if err != nil {
+		return err
+	}
+	setSessionIdCookie(w, sessionId, remember)
+	w.Header().Set("X-CSRF-Token", CSRFToken)
+	return nil
+}
+
 func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "SignUp:"
 	log.Debug(message + "started")
@@ -55,17 +152,10 @@ func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	sessionId, err := h.UseCase.CreateSession(userId)
-	if !response.CheckIfNoError(&w, err, message) {
-		return
-	}
-	CSRFToken, err := h.UseCase.CreateToken(userId)
+	err = h.startSession(w, r, userId, false)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	//template := viper.GetString("reg_html")
-	setSessionIdCookie(w, sessionId)
-	w.Header().Set("X-CSRF-Token", CSRFToken)
 	response.SendResponse(w, response.OkResponse())
 	log.Debug(message + "ended")
 }
@@ -73,24 +163,43 @@ func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 func (h *Delivery) SignIn(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "SignIn:"
 	log.Debug(message + "started")
-	u, err := response.GetUserFromRequest(r.Body)
+	u, remember, err := response.GetSignInFromRequest(r.Body)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	userId, err := h.UseCase.SignIn(u)
+	userId, preAuthToken, err := h.UseCase.SignIn(u, clientIP(r))
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	sessionId, err := h.UseCase.CreateSession(userId)
+	// The session is created by SignInTwoFactor after the code is checked
+	if preAuthToken != "" {
+		response.SendResponse(w, response.PreAuthResponse(preAuthToken))
+		log.Debug(message + "ended")
+		return
+	}
+	err = h.startSession(w, r, userId, remember)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	CSRFToken, err := h.UseCase.CreateToken(userId)
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) SignInTwoFactor(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "SignInTwoFactor:"
+	log.Debug(message + "started")
+	token, code, remember, err := response.GetTwoFactorFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	userId, err := h.UseCase.VerifyTwoFactor(token, code, clientIP(r))
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.startSession(w, r, userId, remember)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	setSessionIdCookie(w, sessionId)
-	w.Header().Set("X-CSRF-Token", CSRFToken)
 	response.SendResponse(w, response.OkResponse())
 	log.Debug(message + "ended")
 }
@@ -113,3 +222,273 @@ func (h *Delivery) Logout(w http.ResponseWriter, r *http.Request) {
 	response.SendResponse(w, response.OkResponse())
 	log.Debug(message + "ended")
 }
+
+func (h *Delivery) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ForgotPassword:"
+	log.Debug(message + "started")
+	u, err := response.GetUserFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.UseCase.RequestPasswordReset(u.Mail)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ResetPassword:"
+	log.Debug(message + "started")
+	token, password, err := response.GetPasswordResetFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.UseCase.ResetPassword(token, password)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	// The current session is gone too, if there was one
+	setExpiredCookie(w)
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmEmail:"
+	log.Debug(message + "started")
+	token, err := response.GetVerificationTokenFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.UseCase.ConfirmEmail(token)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ResendVerification:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	err := h.UseCase.ResendVerification(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetSessions(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetSessions:"
+	log.Debug(message + "started")
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	sessions, err := h.UseCase.ListSessions(cookie.Value)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.SessionListResponse(sessions))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RevokeSession:"
+	log.Debug(message + "started")
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	id := mux.Vars(r)["id"]
+	err = h.UseCase.RevokeSession(cookie.Value, id)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RevokeOtherSessions:"
+	log.Debug(message + "started")
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.UseCase.RevokeOtherSessions(cookie.Value)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) SetupTwoFactor(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "SetupTwoFactor:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	secret, uri, err := h.UseCase.SetupTwoFactor(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.TwoFactorSetupResponse(secret, uri))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmTwoFactor:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	_, code, _, err := response.GetTwoFactorFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	codes, err := h.UseCase.ConfirmTwoFactor(userId, code)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.RecoveryCodesResponse(codes))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DisableTwoFactor:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	_, code, _, err := response.GetTwoFactorFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.UseCase.DisableTwoFactor(userId, code)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RegenerateRecoveryCodes:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	_, code, _, err := response.GetTwoFactorFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	codes, err := h.UseCase.RegenerateRecoveryCodes(userId, code)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.RecoveryCodesResponse(codes))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) startOAuth(w http.ResponseWriter, r *http.Request, userId string) error {
+	provider := mux.Vars(r)["provider"]
+	authURL, state, err := h.UseCase.StartOAuth(provider, userId)
+	if err != nil {
+		return err
+	}
+	setOAuthStateCookie(w, state, oauthStateCookieMaxAge)
+	response.SendResponse(w, response.OAuthStartResponse(authURL))
+	return nil
+}
+
+// StartOAuth returns the url of the provider login page, the frontend redirects the user there
+func (h *Delivery) StartOAuth(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "StartOAuth:"
+	log.Debug(message + "started")
+	err := h.startOAuth(w, r, "")
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	log.Debug(message + "ended")
+}
+
+// LinkOAuth is StartOAuth for a logged in user, the account is linked to them after the callback
+func (h *Delivery) LinkOAuth(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "LinkOAuth:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	err := h.startOAuth(w, r, userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	log.Debug(message + "ended")
+}
+
+// OAuthCallback gets the code and the state the provider has redirected the user with
+func (h *Delivery) OAuthCallback(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "OAuthCallback:"
+	log.Debug(message + "started")
+	code, state, remember, err := response.GetOAuthCallbackFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	cookie, err := r.Cookie(oauthStateCookieName)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		err = error2.ErrOAuthState
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	setOAuthStateCookie(w, "", -1)
+	login, err := h.UseCase.FinishOAuth(mux.Vars(r)["provider"], code, state)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	if login.Linked {
+		response.SendResponse(w, response.OAuthLinkedResponse())
+		log.Debug(message + "ended")
+		return
+	}
+	if login.PreAuthToken != "" {
+		response.SendResponse(w, response.PreAuthResponse(login.PreAuthToken))
+		log.Debug(message + "ended")
+		return
+	}
+	err = h.startSession(w, r, login.UserId, remember)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetOAuthAccounts(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetOAuthAccounts:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	accounts, err := h.UseCase.ListOAuthAccounts(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OAuthAccountListResponse(accounts))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) UnlinkOAuth(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "UnlinkOAuth:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	err := h.UseCase.UnlinkOAuthAccount(userId, mux.Vars(r)["provider"])
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
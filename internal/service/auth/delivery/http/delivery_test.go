@@ -3,17 +3,21 @@ package http
 import (
 	models "backend/internal/models"
 	"backend/internal/response"
+	error2 "backend/internal/service/auth/error"
 	"backend/internal/service/auth/usecase"
 	log "backend/pkg/logger"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,7 +92,7 @@ func TestSignUp(t *testing.T) {
 		userModel.Mail = test.input.Mail
 
 		useCaseMock.On("SignUp", userModel).Return("", test.useCaseErr1)
-		useCaseMock.On("CreateSession", "").Return("", test.useCaseErr2)
+		useCaseMock.On("CreateSession", "", "", "", false).Return("", test.useCaseErr2)
 		useCaseMock.On("CreateToken", "").Return("", test.useCaseErr3)
 
 		r := mux.NewRouter()
@@ -103,14 +107,14 @@ func TestSignUp(t *testing.T) {
 
 var signInTests = []struct {
 	id          int
-	input       *response.UserResponseBody
+	input       *response.SignInResponseBody
 	useCaseErr1 error
 	useCaseErr2 error
 	useCaseErr3 error
 }{
 	{
 		1,
-		&response.UserResponseBody{
+		&response.SignInResponseBody{
 			Mail:     "testMail@mail.ru",
 			Password: "testPassword",
 		},
@@ -120,7 +124,7 @@ var signInTests = []struct {
 	},
 	{
 		2,
-		&response.UserResponseBody{
+		&response.SignInResponseBody{
 			Mail:     "testMail",
 			Password: "testPassword",
 		},
@@ -130,7 +134,7 @@ var signInTests = []struct {
 	},
 	{
 		3,
-		&response.UserResponseBody{
+		&response.SignInResponseBody{
 			Mail:     "testMail@mail.ru",
 			Password: "testPassword",
 		},
@@ -140,7 +144,7 @@ var signInTests = []struct {
 	},
 	{
 		4,
-		&response.UserResponseBody{
+		&response.SignInResponseBody{
 			Mail:     "testMail@mail.ru",
 			Password: "testPassword",
 		},
@@ -150,7 +154,7 @@ var signInTests = []struct {
 	},
 	{
 		5,
-		&response.UserResponseBody{
+		&response.SignInResponseBody{
 			Mail:     "testMail@mail.ru",
 			Password: "testPassword",
 		},
@@ -158,6 +162,17 @@ var signInTests = []struct {
 		nil,
 		errors.New("test_err"),
 	},
+	{
+		6,
+		&response.SignInResponseBody{
+			Mail:     "testMail@mail.ru",
+			Password: "testPassword",
+			Remember: true,
+		},
+		nil,
+		nil,
+		nil,
+	},
 }
 
 func TestSignIn(t *testing.T) {
@@ -169,8 +184,8 @@ func TestSignIn(t *testing.T) {
 		userModel.Mail = test.input.Mail
 		userModel.Password = test.input.Password
 
-		useCaseMock.On("SignIn", userModel).Return("", test.useCaseErr1)
-		useCaseMock.On("CreateSession", "").Return("", test.useCaseErr2)
+		useCaseMock.On("SignIn", userModel, "").Return("", "", test.useCaseErr1)
+		useCaseMock.On("CreateSession", "", "", "", test.input.Remember).Return("", test.useCaseErr2)
 		useCaseMock.On("CreateToken", "").Return("", test.useCaseErr3)
 
 		bodyUserJSON, err := json.Marshal(test.input)
@@ -183,6 +198,14 @@ func TestSignIn(t *testing.T) {
 
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
+
+		switch test.id {
+		case 1:
+			require.Contains(t, w.Header().Get("Set-Cookie"), "session_id=")
+			require.NotContains(t, w.Header().Get("Set-Cookie"), "Max-Age")
+		case 6:
+			require.Contains(t, w.Header().Get("Set-Cookie"), "Max-Age=2592000")
+		}
 	}
 }
 
@@ -254,3 +277,546 @@ func TestLogout(t *testing.T) {
 		r.ServeHTTP(w, req)
 	}
 }
+
+var forgotPasswordTests = []struct {
+	id         int
+	input      string
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		`{"email":"testMail@mail.ru"}`,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		`{"email":"testMail"}`,
+		nil,
+		http.StatusBadRequest,
+	},
+	{
+		3,
+		`{"email":"testMail@mail.ru"}`,
+		error2.ErrTooManyRequests,
+		http.StatusTooManyRequests,
+	},
+}
+
+func TestForgotPassword(t *testing.T) {
+	for _, test := range forgotPasswordTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("RequestPasswordReset", "testMail@mail.ru").Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/password/forgot", deliveryTest.ForgotPassword).Methods("POST")
+		req, err := http.NewRequest("POST", "/password/forgot", bytes.NewBufferString(test.input))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var resetPasswordTests = []struct {
+	id         int
+	input      string
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		`{"token":"token","password":"newPassword"}`,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		`{"token":`,
+		nil,
+		http.StatusBadRequest,
+	},
+	{
+		3,
+		`{"token":"token","password":"newPassword"}`,
+		error2.ErrResetToken,
+		http.StatusBadRequest,
+	},
+}
+
+func TestResetPassword(t *testing.T) {
+	for _, test := range resetPasswordTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("ResetPassword", "token", "newPassword").Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/password/reset", deliveryTest.ResetPassword).Methods("POST")
+		req, err := http.NewRequest("POST", "/password/reset", bytes.NewBufferString(test.input))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.status == http.StatusOK {
+			require.Contains(t, w.Header().Get("Set-Cookie"), "Max-Age=0")
+		}
+	}
+}
+
+var confirmEmailTests = []struct {
+	id         int
+	input      string
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		`{"token":"token"}`,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		`{"token":"token"}`,
+		error2.ErrVerificationToken,
+		http.StatusBadRequest,
+	},
+}
+
+func TestConfirmEmail(t *testing.T) {
+	for _, test := range confirmEmailTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("ConfirmEmail", "token").Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/verification/confirm", deliveryTest.ConfirmEmail).Methods("POST")
+		req, err := http.NewRequest("POST", "/verification/confirm", bytes.NewBufferString(test.input))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var resendVerificationTests = []struct {
+	id         int
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		error2.ErrAlreadyVerified,
+		http.StatusConflict,
+	},
+}
+
+func TestResendVerification(t *testing.T) {
+	for _, test := range resendVerificationTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("ResendVerification", "1").Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/verification/resend", deliveryTest.ResendVerification).Methods("POST")
+		req, err := http.NewRequest("POST", "/verification/resend", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req = req.WithContext(context.WithValue(req.Context(), response.CtxString("userId"), "1"))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var revokeSessionTests = []struct {
+	id         int
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		error2.ErrUnknownSession,
+		http.StatusNotFound,
+	},
+}
+
+func TestRevokeSession(t *testing.T) {
+	for _, test := range revokeSessionTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("RevokeSession", "session", "abcdef").Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/sessions/{id:[0-9a-f]+}", deliveryTest.RevokeSession).Methods("DELETE")
+		req, err := http.NewRequest("DELETE", "/sessions/abcdef", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+func TestGetSessions(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	sessions := []*models.Session{{
+		ID:        "abcdef",
+		UserAgent: "Firefox",
+		IP:        "127.0.0.1",
+		CreatedAt: time.Unix(1637000000, 0),
+		LastSeen:  time.Unix(1637000100, 0),
+		Current:   true,
+	}}
+	useCaseMock.On("ListSessions", "session").Return(sessions, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/sessions", deliveryTest.GetSessions).Methods("GET")
+	req, err := http.NewRequest("GET", "/sessions", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.JSONEq(t, `{"status":200,"body":{"sessions":[{"id":"abcdef","userAgent":"Firefox","ip":"127.0.0.1",`+
+		`"createdAt":"2021-11-15T18:13:20Z","lastSeen":"2021-11-15T18:15:00Z","current":true}]}}`, w.Body.String())
+}
+
+func TestClientIP(t *testing.T) {
+	viper.Set("trusted_proxies", []string{"10.0.0.1", "172.16.0.0/12"})
+	defer viper.Set("trusted_proxies", nil)
+	tests := []struct {
+		name       string
+		remoteAddr string
+		realIP     string
+		forwarded  string
+		output     string
+	}{
+		{"No proxy", "1.2.3.4:5000", "", "", "1.2.3.4"},
+		{"Forged headers", "1.2.3.4:5000", "5.6.7.8", "5.6.7.8", "1.2.3.4"},
+		{"X-Real-IP from a trusted proxy", "10.0.0.1:5000", "5.6.7.8", "9.9.9.9", "5.6.7.8"},
+		{"X-Forwarded-For from a trusted proxy", "172.17.0.2:5000", "", "9.9.9.9, 5.6.7.8, 172.18.0.3", "5.6.7.8"},
+		{"Only trusted proxies", "172.17.0.2:5000", "", "172.18.0.3", "172.18.0.3"},
+		{"Trusted proxy without headers", "10.0.0.1:5000", "", "", "10.0.0.1"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("POST", "/login", nil)
+		r.RemoteAddr = test.remoteAddr
+		if test.realIP != "" {
+			r.Header.Set("X-Real-IP", test.realIP)
+		}
+		if test.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", test.forwarded)
+		}
+		require.Equal(t, test.output, clientIP(r), test.name)
+	}
+}
+
+func TestSignInWithTwoFactor(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	userModel := &models.User{
+		Mail:     "testMail@mail.ru",
+		Password: "testPassword",
+	}
+	useCaseMock.On("SignIn", userModel, "").Return("", "token", nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/login", deliveryTest.SignIn).Methods("POST")
+	body := `{"email":"testMail@mail.ru","password":"testPassword"}`
+	req, err := http.NewRequest("POST", "/login", bytes.NewBufferString(body))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.JSONEq(t, `{"status":200,"body":{"twoFactor":true,"token":"token"}}`, w.Body.String())
+	require.Empty(t, w.Header().Get("Set-Cookie"))
+	useCaseMock.AssertNotCalled(t, "CreateSession", "", "", "", false)
+}
+
+func TestSignInLocked(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	userModel := &models.User{
+		Mail:     "testMail@mail.ru",
+		Password: "testPassword",
+	}
+	useCaseMock.On("SignIn", userModel, "203.0.113.5").
+		Return("", "", error2.LoginLocked(600*time.Second))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/login", deliveryTest.SignIn).Methods("POST")
+	body := `{"email":"testMail@mail.ru","password":"testPassword"}`
+	req, err := http.NewRequest("POST", "/login", bytes.NewBufferString(body))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req.RemoteAddr = "203.0.113.5:1234"
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.JSONEq(t, `{"status":423,"code":"login_locked","message":"Слишком много неудачных попыток входа, попробуйте через 10 мин.","body":{"retryAfter":600}}`, w.Body.String())
+	require.Equal(t, "600", w.Header().Get("Retry-After"))
+	useCaseMock.AssertExpectations(t)
+}
+
+var signInTwoFactorTests = []struct {
+	id         int
+	input      string
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		`{"token":"token","code":" 123456 ","remember":true}`,
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		`{"token":"token","code":" 123456 ","remember":true}`,
+		error2.ErrTwoFactorCode,
+		http.StatusBadRequest,
+	},
+	{
+		3,
+		`{"token":"token","code":" 123456 ","remember":true}`,
+		error2.ErrPreAuthToken,
+		http.StatusUnauthorized,
+	},
+}
+
+func TestSignInTwoFactor(t *testing.T) {
+	for _, test := range signInTwoFactorTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("VerifyTwoFactor", "token", "123456", "").Return("1", test.useCaseErr)
+		useCaseMock.On("CreateSession", "1", "", "", true).Return("session", nil)
+		useCaseMock.On("CreateToken", "session").Return("csrf", nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/login/2fa", deliveryTest.SignInTwoFactor).Methods("POST")
+		req, err := http.NewRequest("POST", "/login/2fa", bytes.NewBufferString(test.input))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.status == http.StatusOK {
+			require.Contains(t, w.Header().Get("Set-Cookie"), "session_id=session")
+			require.Equal(t, "csrf", w.Header().Get("X-CSRF-Token"))
+		} else {
+			require.Empty(t, w.Header().Get("Set-Cookie"))
+		}
+	}
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("ConfirmTwoFactor", "1", "123456").Return([]string{"abcde-fghij"}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/2fa/confirm", deliveryTest.ConfirmTwoFactor).Methods("POST")
+	req, err := http.NewRequest("POST", "/2fa/confirm", bytes.NewBufferString(`{"code":"123456"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+	r.ServeHTTP(w, req.WithContext(userIdContext))
+
+	require.JSONEq(t, `{"status":200,"body":{"recoveryCodes":["abcde-fghij"]}}`, w.Body.String())
+}
+
+func TestSetupTwoFactor(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("SetupTwoFactor", "1").Return("", "", error2.ErrTwoFactorEnabled)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/2fa/setup", deliveryTest.SetupTwoFactor).Methods("POST")
+	req, err := http.NewRequest("POST", "/2fa/setup", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+	r.ServeHTTP(w, req.WithContext(userIdContext))
+
+	resp := response.Response{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, response.HttpStatus(http.StatusConflict), resp.Status)
+}
+
+func TestStartOAuth(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("StartOAuth", "vk", "").Return("https://oauth.vk.com/authorize?state=state", "state", nil)
+	useCaseMock.On("StartOAuth", "ok", "").Return("", "", error2.ErrOAuthProvider)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/oauth/{provider:[a-z]+}/start", deliveryTest.StartOAuth).Methods("POST")
+	req, err := http.NewRequest("POST", "/oauth/vk/start", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.JSONEq(t, `{"status":200,"body":{"url":"https://oauth.vk.com/authorize?state=state"}}`, w.Body.String())
+	require.Contains(t, w.Header().Get("Set-Cookie"), "oauth_state=state")
+
+	req, err = http.NewRequest("POST", "/oauth/ok/start", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	resp := response.Response{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, response.HttpStatus(http.StatusNotFound), resp.Status)
+	require.Empty(t, w.Header().Get("Set-Cookie"))
+}
+
+var oauthCallbackTests = []struct {
+	id          int
+	cookie      string
+	login       *models.OAuthLogin
+	useCaseErr  error
+	status      response.HttpStatus
+	body        string
+	sessionSent bool
+}{
+	{1, "state", &models.OAuthLogin{UserId: "1"}, nil, http.StatusOK, `{"status":200,"message":"OK"}`, true},
+	{2, "state", &models.OAuthLogin{PreAuthToken: "token"}, nil, http.StatusOK,
+		`{"status":200,"body":{"twoFactor":true,"token":"token"}}`, false},
+	{3, "state", &models.OAuthLogin{UserId: "1", Linked: true}, nil, http.StatusOK,
+		`{"status":200,"body":{"linked":true}}`, false},
+	{4, "other", &models.OAuthLogin{}, nil, http.StatusBadRequest, "", false},
+	{5, "", &models.OAuthLogin{}, nil, http.StatusBadRequest, "", false},
+	{6, "state", (*models.OAuthLogin)(nil), error2.ErrOAuthLinked, http.StatusConflict, "", false},
+}
+
+func TestOAuthCallback(t *testing.T) {
+	for _, test := range oauthCallbackTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("FinishOAuth", "vk", "code", "state").Return(test.login, test.useCaseErr)
+		useCaseMock.On("CreateSession", "1", "", "", true).Return("session", nil)
+		useCaseMock.On("CreateToken", "session").Return("csrf", nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/oauth/{provider:[a-z]+}/callback", deliveryTest.OAuthCallback).Methods("POST")
+		req, err := http.NewRequest("POST", "/oauth/vk/callback",
+			bytes.NewBufferString(`{"code":"code","state":"state","remember":true}`))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		if test.cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "oauth_state", Value: test.cookie})
+		}
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := response.Response{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.body != "" {
+			require.JSONEq(t, test.body, w.Body.String(), test.id)
+		}
+		if test.sessionSent {
+			require.Equal(t, "csrf", w.Header().Get("X-CSRF-Token"), test.id)
+		} else {
+			useCaseMock.AssertNotCalled(t, "CreateSession", "1", "", "", true)
+		}
+		if test.status == http.StatusBadRequest {
+			useCaseMock.AssertNotCalled(t, "FinishOAuth", "vk", "code", "state")
+		}
+	}
+}
+
+func TestGetOAuthAccounts(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	accounts := []*models.OAuthAccount{{
+		Provider:  "vk",
+		Mail:      "test@vk.com",
+		CreatedAt: time.Unix(1637000000, 0),
+	}}
+	useCaseMock.On("ListOAuthAccounts", "1").Return(accounts, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/oauth/accounts", deliveryTest.GetOAuthAccounts).Methods("GET")
+	req, err := http.NewRequest("GET", "/oauth/accounts", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+	r.ServeHTTP(w, req.WithContext(userIdContext))
+
+	require.JSONEq(t, `{"status":200,"body":{"accounts":[{"provider":"vk","email":"test@vk.com",`+
+		`"createdAt":"2021-11-15T18:13:20Z"}]}}`, w.Body.String())
+}
+
+func TestUnlinkOAuth(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("UnlinkOAuthAccount", "1", "vk").Return(error2.ErrOAuthNotLinked)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/oauth/{provider:[a-z]+}", deliveryTest.UnlinkOAuth).Methods("DELETE")
+	req, err := http.NewRequest("DELETE", "/oauth/vk", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+	r.ServeHTTP(w, req.WithContext(userIdContext))
+
+	resp := response.Response{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, response.HttpStatus(http.StatusNotFound), resp.Status)
+}
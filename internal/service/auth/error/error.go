@@ -1,10 +1,59 @@
 package error
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
-	ErrUserNotFound = errors.New("user not found")
-	ErrCookie       = errors.New("error with cookie")
-	ErrPostgres     = errors.New("internal DB server error")
-	ErrUserExists   = errors.New("user already exists")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrCookie            = errors.New("error with cookie")
+	ErrPostgres          = errors.New("internal DB server error")
+	ErrUserExists        = errors.New("user already exists")
+	ErrEmptyData         = errors.New("required data is empty")
+	ErrResetToken        = errors.New("invalid password reset token")
+	ErrTooManyRequests   = errors.New("too many requests")
+	ErrVerificationToken = errors.New("invalid verification token")
+	ErrNotVerified       = errors.New("email is not verified")
+	ErrAlreadyVerified   = errors.New("email is already verified")
+	ErrTwoFactorCode     = errors.New("invalid two-factor code")
+	ErrPreAuthToken      = errors.New("invalid pre-auth token")
+	ErrTwoFactorEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorDisabled = errors.New("two-factor authentication is not enabled")
+	ErrOAuthProvider     = errors.New("unknown oauth provider")
+	ErrOAuthState        = errors.New("invalid oauth state")
+	ErrOAuthFailed       = errors.New("oauth login failed")
+	ErrOAuthNoEmail      = errors.New("oauth account has no email")
+	ErrOAuthEmailExists  = errors.New("oauth email belongs to another account")
+	ErrOAuthLinked       = errors.New("oauth account is already linked")
+	ErrOAuthNotLinked    = errors.New("oauth account is not linked")
+	ErrLoginLocked       = errors.New("login is temporarily locked")
+	ErrCSRFToken         = errors.New("invalid csrf token")
+	ErrNotAllowed        = errors.New("user is not allowed to do this")
+	ErrEmptySessionId    = errors.New("session id is empty")
+	ErrSessionNotFound   = errors.New("session was not found")
+	ErrUnknownSession    = errors.New("unknown session")
 )
+
+// LoginLockedError is ErrLoginLocked with the time left, the gateway shows it to the user
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry in %d seconds", ErrLoginLocked, int64(e.RetryAfter/time.Second))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+// LoginLocked rounds the time left up to whole seconds
+func LoginLocked(retryAfter time.Duration) error {
+	seconds := (retryAfter + time.Second - 1) / time.Second
+	if seconds < 1 {
+		seconds = 1
+	}
+	return &LoginLockedError{RetryAfter: seconds * time.Second}
+}
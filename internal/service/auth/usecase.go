@@ -6,10 +6,33 @@ import (
 
 type UseCase interface {
 	SignUp(u *models.User) (string, error)
-	SignIn(u *models.User) (string, error)
-	CreateSession(userId string) (string, error)
-	CheckSession(SessionId string) (string, error)
+	// SignIn returns a pre-auth token instead of the user id if the second factor is required
+	SignIn(u *models.User, ip string) (string, string, error)
+	CreateSession(userId, userAgent, ip string, remember bool) (string, error)
+	// CheckSession returns the user id and the role of the session owner
+	CheckSession(SessionId string) (string, string, error)
 	DeleteSession(SessionId string) error
-	CreateToken(userId string) (string, error)
-	CheckToken(csrfToken string) (string, error)
+	// CreateToken gives a CSRF token that is valid only with this session
+	CreateToken(sessionId string) (string, error)
+	CheckToken(csrfToken, sessionId string) (string, error)
+	RequestPasswordReset(mail string) error
+	ResetPassword(token, password string) error
+	// ChangePassword also revokes all the other sessions of the user
+	ChangePassword(sessionId, password string) error
+	ConfirmEmail(token string) error
+	ResendVerification(userId string) error
+	CheckVerified(userId string) error
+	ListSessions(sessionId string) ([]*models.Session, error)
+	RevokeSession(sessionId, id string) error
+	RevokeOtherSessions(sessionId string) error
+	VerifyTwoFactor(preAuthToken, code, ip string) (string, error)
+	SetupTwoFactor(userId string) (string, string, error)
+	ConfirmTwoFactor(userId, code string) ([]string, error)
+	DisableTwoFactor(userId, code string) error
+	RegenerateRecoveryCodes(userId, code string) ([]string, error)
+	// StartOAuth returns the provider login url and the state, userId is set to link an account instead of a login
+	StartOAuth(provider, userId string) (string, string, error)
+	FinishOAuth(provider, code, state string) (*models.OAuthLogin, error)
+	ListOAuthAccounts(userId string) ([]*models.OAuthAccount, error)
+	UnlinkOAuthAccount(userId, provider string) error
 }
@@ -14,19 +14,19 @@ func (m *UseCaseMock) SignUp(u *models.User) (string, error) {
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *UseCaseMock) SignIn(u *models.User) (string, error) {
-	args := m.Called(u)
-	return args.Get(0).(string), args.Error(1)
+func (m *UseCaseMock) SignIn(u *models.User, ip string) (string, string, error) {
+	args := m.Called(u, ip)
+	return args.Get(0).(string), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) CreateSession(userId string) (string, error) {
-	args := m.Called(userId)
+func (m *UseCaseMock) CreateSession(userId, userAgent, ip string, remember bool) (string, error) {
+	args := m.Called(userId, userAgent, ip, remember)
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *UseCaseMock) CheckSession(SessionId string) (string, error) {
+func (m *UseCaseMock) CheckSession(SessionId string) (string, string, error) {
 	args := m.Called(SessionId)
-	return args.Get(0).(string), args.Error(1)
+	return args.Get(0).(string), args.Get(1).(string), args.Error(2)
 }
 
 func (m *UseCaseMock) DeleteSession(SessionId string) error {
@@ -34,12 +34,102 @@ func (m *UseCaseMock) DeleteSession(SessionId string) error {
 	return args.Error(0)
 }
 
-func (m *UseCaseMock) CreateToken(userId string) (string, error) {
-	args := m.Called(userId)
+func (m *UseCaseMock) CreateToken(sessionId string) (string, error) {
+	args := m.Called(sessionId)
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *UseCaseMock) CheckToken(csrfToken string) (string, error) {
-	args := m.Called(csrfToken)
+func (m *UseCaseMock) CheckToken(csrfToken, sessionId string) (string, error) {
+	args := m.Called(csrfToken, sessionId)
 	return args.Get(0).(string), args.Error(1)
 }
+
+func (m *UseCaseMock) RequestPasswordReset(mail string) error {
+	args := m.Called(mail)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) ResetPassword(token, password string) error {
+	args := m.Called(token, password)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) ChangePassword(sessionId, password string) error {
+	args := m.Called(sessionId, password)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) ConfirmEmail(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) ResendVerification(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) CheckVerified(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) ListSessions(sessionId string) ([]*models.Session, error) {
+	args := m.Called(sessionId)
+	return args.Get(0).([]*models.Session), args.Error(1)
+}
+
+func (m *UseCaseMock) RevokeSession(sessionId, id string) error {
+	args := m.Called(sessionId, id)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) RevokeOtherSessions(sessionId string) error {
+	args := m.Called(sessionId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) VerifyTwoFactor(preAuthToken, code, ip string) (string, error) {
+	args := m.Called(preAuthToken, code, ip)
+	return args.String(0), args.Error(1)
+}
+
+func (m *UseCaseMock) SetupTwoFactor(userId string) (string, string, error) {
+	args := m.Called(userId)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) ConfirmTwoFactor(userId, code string) ([]string, error) {
+	args := m.Called(userId, code)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *UseCaseMock) DisableTwoFactor(userId, code string) error {
+	args := m.Called(userId, code)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) RegenerateRecoveryCodes(userId, code string) ([]string, error) {
+	args := m.Called(userId, code)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *UseCaseMock) StartOAuth(provider, userId string) (string, string, error) {
+	args := m.Called(provider, userId)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) FinishOAuth(provider, code, state string) (*models.OAuthLogin, error) {
+	args := m.Called(provider, code, state)
+	return args.Get(0).(*models.OAuthLogin), args.Error(1)
+}
+
+func (m *UseCaseMock) ListOAuthAccounts(userId string) ([]*models.OAuthAccount, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*models.OAuthAccount), args.Error(1)
+}
+
+func (m *UseCaseMock) UnlinkOAuthAccount(userId, provider string) error {
+	args := m.Called(userId, provider)
+	return args.Error(0)
+}
@@ -3,7 +3,9 @@ package usecase
 import (
 	protoAuth "backend/internal/microservice/auth/proto"
 	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
 	"context"
+	"time"
 )
 
 type UseCase struct {
@@ -31,22 +33,25 @@ func (s *UseCase) SignUp(u *models.User) (string, error) {
 	return userId, nil
 }
 
-func (s *UseCase) SignIn(u *models.User) (string, error) {
+func (s *UseCase) SignIn(u *models.User, ip string) (string, string, error) {
 	in := &protoAuth.SignInRequest{
 		Mail:     u.Mail,
 		Password: u.Password,
+		IP:       ip,
 	}
 	out, err := s.client.SignIn(context.Background(), in)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	userId := out.ID
-	return userId, nil
+	return out.ID, out.PreAuthToken, nil
 }
 
-func (s *UseCase) CreateSession(userId string) (string, error) {
-	in := &protoAuth.UserId{
-		ID: userId,
+func (s *UseCase) CreateSession(userId, userAgent, ip string, remember bool) (string, error) {
+	in := &protoAuth.SessionRequest{
+		UserId:    userId,
+		UserAgent: userAgent,
+		IP:        ip,
+		Remember:  remember,
 	}
 	out, err := s.client.CreateSession(context.Background(), in)
 	if err != nil {
@@ -56,16 +61,16 @@ func (s *UseCase) CreateSession(userId string) (string, error) {
 	return sessionId, nil
 }
 
-func (s *UseCase) CheckSession(SessionId string) (string, error) {
+func (s *UseCase) CheckSession(SessionId string) (string, string, error) {
 	in := &protoAuth.Session{
 		Session: SessionId,
 	}
 	out, err := s.client.CheckSession(context.Background(), in)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	userId := out.ID
-	return userId, nil
+	return userId, out.Role, nil
 }
 
 func (s *UseCase) DeleteSession(SessionId string) error {
@@ -79,9 +84,9 @@ func (s *UseCase) DeleteSession(SessionId string) error {
 	return nil
 }
 
-func (s *UseCase) CreateToken(userId string) (string, error) {
-	in := &protoAuth.UserId{
-		ID: userId,
+func (s *UseCase) CreateToken(sessionId string) (string, error) {
+	in := &protoAuth.Session{
+		Session: sessionId,
 	}
 	out, err := s.client.CreateToken(context.Background(), in)
 	if err != nil {
@@ -91,9 +96,10 @@ func (s *UseCase) CreateToken(userId string) (string, error) {
 	return token, nil
 }
 
-func (s *UseCase) CheckToken(csrfToken string) (string, error) {
+func (s *UseCase) CheckToken(csrfToken, sessionId string) (string, error) {
 	in := &protoAuth.CSRFToken{
 		CSRFToken: csrfToken,
+		Session:   sessionId,
 	}
 	out, err := s.client.CheckToken(context.Background(), in)
 	if err != nil {
@@ -102,3 +108,213 @@ func (s *UseCase) CheckToken(csrfToken string) (string, error) {
 	userId := out.ID
 	return userId, nil
 }
+
+func (s *UseCase) RequestPasswordReset(mail string) error {
+	in := &protoAuth.PasswordResetRequest{
+		Mail: mail,
+	}
+	_, err := s.client.RequestPasswordReset(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) ResetPassword(token, password string) error {
+	in := &protoAuth.ResetPasswordRequest{
+		Token:    token,
+		Password: password,
+	}
+	_, err := s.client.ResetPassword(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) ChangePassword(sessionId, password string) error {
+	in := &protoAuth.ChangePasswordRequest{
+		Session:  sessionId,
+		Password: password,
+	}
+	_, err := s.client.ChangePassword(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) ConfirmEmail(token string) error {
+	in := &protoAuth.VerificationToken{
+		Token: token,
+	}
+	_, err := s.client.ConfirmEmail(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) ResendVerification(userId string) error {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	_, err := s.client.ResendVerification(context.Background(), in)
+	return err
+}
+
+// CheckVerified returns ErrNotVerified if the user has not confirmed the mail yet
+func (s *UseCase) CheckVerified(userId string) error {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.IsVerified(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	if !out.Verified {
+		return error2.ErrNotVerified
+	}
+	return nil
+}
+
+func (s *UseCase) ListSessions(sessionId string) ([]*models.Session, error) {
+	in := &protoAuth.Session{
+		Session: sessionId,
+	}
+	out, err := s.client.ListSessions(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Session, len(out.Sessions))
+	for i, session := range out.Sessions {
+		result[i] = &models.Session{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			IP:        session.IP,
+			CreatedAt: time.Unix(session.CreatedAt, 0),
+			LastSeen:  time.Unix(session.LastSeen, 0),
+			Current:   session.Current,
+		}
+	}
+	return result, nil
+}
+
+func (s *UseCase) RevokeSession(sessionId, id string) error {
+	in := &protoAuth.RevokeSessionRequest{
+		Session: sessionId,
+		ID:      id,
+	}
+	_, err := s.client.RevokeSession(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) RevokeOtherSessions(sessionId string) error {
+	in := &protoAuth.Session{
+		Session: sessionId,
+	}
+	_, err := s.client.RevokeOtherSessions(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) VerifyTwoFactor(preAuthToken, code, ip string) (string, error) {
+	in := &protoAuth.TwoFactorLogin{
+		PreAuthToken: preAuthToken,
+		Code:         code,
+		IP:           ip,
+	}
+	out, err := s.client.VerifyTwoFactor(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
+
+func (s *UseCase) SetupTwoFactor(userId string) (string, string, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.SetupTwoFactor(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.Secret, out.URI, nil
+}
+
+func (s *UseCase) ConfirmTwoFactor(userId, code string) ([]string, error) {
+	in := &protoAuth.TwoFactorCode{
+		UserId: userId,
+		Code:   code,
+	}
+	out, err := s.client.ConfirmTwoFactor(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return out.Codes, nil
+}
+
+func (s *UseCase) DisableTwoFactor(userId, code string) error {
+	in := &protoAuth.TwoFactorCode{
+		UserId: userId,
+		Code:   code,
+	}
+	_, err := s.client.DisableTwoFactor(context.Background(), in)
+	return err
+}
+
+func (s *UseCase) RegenerateRecoveryCodes(userId, code string) ([]string, error) {
+	in := &protoAuth.TwoFactorCode{
+		UserId: userId,
+		Code:   code,
+	}
+	out, err := s.client.RegenerateRecoveryCodes(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return out.Codes, nil
+}
+
+func (s *UseCase) StartOAuth(provider, userId string) (string, string, error) {
+	in := &protoAuth.OAuthStartRequest{
+		Provider: provider,
+		UserId:   userId,
+	}
+	out, err := s.client.StartOAuth(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.URL, out.State, nil
+}
+
+func (s *UseCase) FinishOAuth(provider, code, state string) (*models.OAuthLogin, error) {
+	in := &protoAuth.OAuthCallback{
+		Provider: provider,
+		Code:     code,
+		State:    state,
+	}
+	out, err := s.client.FinishOAuth(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OAuthLogin{
+		UserId:       out.ID,
+		PreAuthToken: out.PreAuthToken,
+		Linked:       out.Linked,
+	}, nil
+}
+
+func (s *UseCase) ListOAuthAccounts(userId string) ([]*models.OAuthAccount, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.ListOAuthAccounts(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.OAuthAccount, len(out.Accounts))
+	for i, account := range out.Accounts {
+		result[i] = &models.OAuthAccount{
+			Provider:  account.Provider,
+			Mail:      account.Mail,
+			CreatedAt: time.Unix(account.CreatedAt, 0),
+		}
+	}
+	return result, nil
+}
+
+func (s *UseCase) UnlinkOAuthAccount(userId, provider string) error {
+	in := &protoAuth.OAuthUnlinkRequest{
+		UserId:   userId,
+		Provider: provider,
+	}
+	_, err := s.client.UnlinkOAuthAccount(context.Background(), in)
+	return err
+}
@@ -4,10 +4,12 @@ import (
 	protoAuth "backend/internal/microservice/auth/proto"
 	"backend/internal/microservice/auth/usecase"
 	"backend/internal/models"
+	error2 "backend/internal/service/auth/error"
 	"context"
 	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 var signUpTests = []struct {
@@ -54,18 +56,54 @@ func TestSignUp(t *testing.T) {
 	}
 }
 
+var signInTests = []struct {
+	id           int
+	clientRes    *protoAuth.SignInResponse
+	clientErr    error
+	output       string
+	preAuthToken string
+}{
+	{
+		1,
+		&protoAuth.SignInResponse{
+			ID: "test",
+		},
+		nil,
+		"test",
+		"",
+	},
+	{
+		2,
+		&protoAuth.SignInResponse{
+			PreAuthToken: "token",
+		},
+		nil,
+		"",
+		"token",
+	},
+	{
+		3,
+		&protoAuth.SignInResponse{},
+		errors.New("test_err"),
+		"",
+		"",
+	},
+}
+
 func TestSignIn(t *testing.T) {
-	for _, test := range signUpTests {
+	for _, test := range signInTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
 		in := &protoAuth.SignInRequest{
-			Mail:     test.input.Mail,
-			Password: test.input.Password,
+			Mail:     "test@mail.ru",
+			Password: "12345678",
+			IP:       "1.2.3.4",
 		}
 		clientMock.On("SignIn", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.SignIn(test.input)
+		res, preAuthToken, err := useCaseTest.SignIn(&models.User{Mail: "test@mail.ru", Password: "12345678"}, "1.2.3.4")
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
+		require.Equal(t, test.preAuthToken, preAuthToken)
 	}
 }
 
@@ -100,11 +138,14 @@ func TestCreateSession(t *testing.T) {
 	for _, test := range createSessionTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
-		in := &protoAuth.UserId{
-			ID: test.input,
+		in := &protoAuth.SessionRequest{
+			UserId:    test.input,
+			UserAgent: "Mozilla/5.0",
+			IP:        "127.0.0.1",
+			Remember:  true,
 		}
 		clientMock.On("CreateSession", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.CreateSession(test.input)
+		res, err := useCaseTest.CreateSession(test.input, "Mozilla/5.0", "127.0.0.1", true)
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
 	}
@@ -116,15 +157,18 @@ var checkSessionTests = []struct {
 	clientRes *protoAuth.UserId
 	clientErr error
 	output    string
+	role      string
 }{
 	{
 		1,
 		"test",
 		&protoAuth.UserId{
-			ID: "test",
+			ID:   "test",
+			Role: models.RoleAdmin,
 		},
 		nil,
 		"test",
+		models.RoleAdmin,
 	},
 	{
 		2,
@@ -134,6 +178,7 @@ var checkSessionTests = []struct {
 		},
 		errors.New("test_err"),
 		"",
+		"",
 	},
 }
 
@@ -145,9 +190,10 @@ func TestCheckSession(t *testing.T) {
 			Session: test.input,
 		}
 		clientMock.On("CheckSession", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.CheckSession(test.input)
+		res, role, err := useCaseTest.CheckSession(test.input)
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
+		require.Equal(t, test.role, role)
 	}
 }
 
@@ -219,8 +265,8 @@ func TestCreateToken(t *testing.T) {
 	for _, test := range createTokenTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
-		in := &protoAuth.UserId{
-			ID: test.input,
+		in := &protoAuth.Session{
+			Session: test.input,
 		}
 		clientMock.On("CreateToken", context.Background(), in).Return(test.clientRes, test.clientErr)
 		res, err := useCaseTest.CreateToken(test.input)
@@ -235,10 +281,354 @@ func TestCheckToken(t *testing.T) {
 		useCaseTest := NewUseCase(clientMock)
 		in := &protoAuth.CSRFToken{
 			CSRFToken: test.input,
+			Session:   "session",
 		}
 		clientMock.On("CheckToken", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.CheckToken(test.input)
+		res, err := useCaseTest.CheckToken(test.input, "session")
+		require.Equal(t, test.clientErr, err)
+		require.Equal(t, test.output, res)
+	}
+}
+
+var requestPasswordResetTests = []struct {
+	id        int
+	input     string
+	clientErr error
+}{
+	{
+		1,
+		"test@mail.ru",
+		nil,
+	},
+	{
+		2,
+		"test@mail.ru",
+		errors.New("test_err"),
+	},
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.PasswordResetRequest{
+			Mail: test.input,
+		}
+		clientMock.On("RequestPasswordReset", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.RequestPasswordReset(test.input)
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+var resetPasswordTests = []struct {
+	id        int
+	token     string
+	password  string
+	clientErr error
+}{
+	{
+		1,
+		"token",
+		"password",
+		nil,
+	},
+	{
+		2,
+		"token",
+		"password",
+		errors.New("test_err"),
+	},
+}
+
+func TestResetPassword(t *testing.T) {
+	for _, test := range resetPasswordTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.ResetPasswordRequest{
+			Token:    test.token,
+			Password: test.password,
+		}
+		clientMock.On("ResetPassword", context.Background(), in).Return(&protoAuth.UserId{}, test.clientErr)
+		err := useCaseTest.ResetPassword(test.token, test.password)
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+var checkVerifiedTests = []struct {
+	id        int
+	clientRes *protoAuth.Verified
+	clientErr error
+	outputErr error
+}{
+	{
+		1,
+		&protoAuth.Verified{Verified: true},
+		nil,
+		nil,
+	},
+	{
+		2,
+		&protoAuth.Verified{Verified: false},
+		nil,
+		error2.ErrNotVerified,
+	},
+	{
+		3,
+		&protoAuth.Verified{},
+		errors.New("test_err"),
+		errors.New("test_err"),
+	},
+}
+
+func TestCheckVerified(t *testing.T) {
+	for _, test := range checkVerifiedTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.UserId{
+			ID: "1",
+		}
+		clientMock.On("IsVerified", context.Background(), in).Return(test.clientRes, test.clientErr)
+		err := useCaseTest.CheckVerified("1")
+		require.Equal(t, test.outputErr, err)
+	}
+}
+
+func TestConfirmEmail(t *testing.T) {
+	for _, test := range resetPasswordTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.VerificationToken{
+			Token: test.token,
+		}
+		clientMock.On("ConfirmEmail", context.Background(), in).Return(&protoAuth.UserId{}, test.clientErr)
+		err := useCaseTest.ConfirmEmail(test.token)
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.UserId{
+			ID: test.input,
+		}
+		clientMock.On("ResendVerification", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.ResendVerification(test.input)
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.Session{
+		Session: "session",
+	}
+	out := &protoAuth.SessionList{
+		Sessions: []*protoAuth.SessionInfo{{
+			ID:        "abcdef",
+			UserAgent: "Firefox",
+			IP:        "127.0.0.1",
+			CreatedAt: 1637000000,
+			LastSeen:  1637000100,
+			Current:   true,
+		}},
+	}
+	clientMock.On("ListSessions", context.Background(), in).Return(out, nil)
+	res, err := useCaseTest.ListSessions("session")
+	require.NoError(t, err)
+	require.Equal(t, []*models.Session{{
+		ID:        "abcdef",
+		UserAgent: "Firefox",
+		IP:        "127.0.0.1",
+		CreatedAt: time.Unix(1637000000, 0),
+		LastSeen:  time.Unix(1637000100, 0),
+		Current:   true,
+	}}, res)
+}
+
+func TestRevokeSession(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.RevokeSessionRequest{
+			Session: test.input,
+			ID:      "abcdef",
+		}
+		clientMock.On("RevokeSession", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.RevokeSession(test.input, "abcdef")
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.Session{
+			Session: test.input,
+		}
+		clientMock.On("RevokeOtherSessions", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.RevokeOtherSessions(test.input)
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.ChangePasswordRequest{
+			Session:  test.input,
+			Password: "87654321",
+		}
+		clientMock.On("ChangePassword", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.ChangePassword(test.input, "87654321")
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+	for _, test := range signUpTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.TwoFactorLogin{
+			PreAuthToken: "token",
+			Code:         "123456",
+			IP:           "127.0.0.1",
+		}
+		clientMock.On("VerifyTwoFactor", context.Background(), in).Return(test.clientRes, test.clientErr)
+		res, err := useCaseTest.VerifyTwoFactor("token", "123456", "127.0.0.1")
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
 	}
 }
+
+func TestSetupTwoFactor(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	out := &protoAuth.TwoFactorSetup{
+		Secret: "secret",
+		URI:    "otpauth://totp/BMSTUSA:test",
+	}
+	clientMock.On("SetupTwoFactor", context.Background(), &protoAuth.UserId{ID: "1"}).Return(out, nil)
+	secret, uri, err := useCaseTest.SetupTwoFactor("1")
+	require.NoError(t, err)
+	require.Equal(t, "secret", secret)
+	require.Equal(t, "otpauth://totp/BMSTUSA:test", uri)
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.TwoFactorCode{
+		UserId: "1",
+		Code:   "123456",
+	}
+	out := &protoAuth.RecoveryCodes{
+		Codes: []string{"abcde-fghij"},
+	}
+	clientMock.On("ConfirmTwoFactor", context.Background(), in).Return(out, nil)
+	clientMock.On("RegenerateRecoveryCodes", context.Background(), in).Return(&protoAuth.RecoveryCodes{}, errors.New("test_err"))
+	codes, err := useCaseTest.ConfirmTwoFactor("1", "123456")
+	require.NoError(t, err)
+	require.Equal(t, []string{"abcde-fghij"}, codes)
+	codes, err = useCaseTest.RegenerateRecoveryCodes("1", "123456")
+	require.Error(t, err)
+	require.Nil(t, codes)
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.TwoFactorCode{
+			UserId: "1",
+			Code:   "123456",
+		}
+		clientMock.On("DisableTwoFactor", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.DisableTwoFactor("1", "123456")
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+func TestStartOAuth(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.OAuthStartRequest{
+		Provider: "vk",
+		UserId:   "1",
+	}
+	out := &protoAuth.OAuthStart{
+		URL:   "https://oauth.vk.com/authorize",
+		State: "state",
+	}
+	clientMock.On("StartOAuth", context.Background(), in).Return(out, nil)
+	authURL, state, err := useCaseTest.StartOAuth("vk", "1")
+	require.NoError(t, err)
+	require.Equal(t, "https://oauth.vk.com/authorize", authURL)
+	require.Equal(t, "state", state)
+}
+
+func TestFinishOAuth(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.OAuthCallback{
+		Provider: "vk",
+		Code:     "code",
+		State:    "state",
+	}
+	out := &protoAuth.OAuthLogin{
+		ID:     "1",
+		Linked: true,
+	}
+	clientMock.On("FinishOAuth", context.Background(), in).Return(out, nil)
+	res, err := useCaseTest.FinishOAuth("vk", "code", "state")
+	require.NoError(t, err)
+	require.Equal(t, &models.OAuthLogin{UserId: "1", Linked: true}, res)
+
+	other := &protoAuth.OAuthCallback{
+		Provider: "vk",
+		Code:     "code",
+		State:    "other",
+	}
+	clientMock.On("FinishOAuth", context.Background(), other).Return(&protoAuth.OAuthLogin{}, errors.New("test_err"))
+	res, err = useCaseTest.FinishOAuth("vk", "code", "other")
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func TestListOAuthAccounts(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	out := &protoAuth.OAuthAccountList{
+		Accounts: []*protoAuth.OAuthAccount{{
+			Provider:  "vk",
+			Mail:      "test@vk.com",
+			CreatedAt: 1637000000,
+		}},
+	}
+	clientMock.On("ListOAuthAccounts", context.Background(), &protoAuth.UserId{ID: "1"}).Return(out, nil)
+	res, err := useCaseTest.ListOAuthAccounts("1")
+	require.NoError(t, err)
+	require.Equal(t, []*models.OAuthAccount{{
+		Provider:  "vk",
+		Mail:      "test@vk.com",
+		CreatedAt: time.Unix(1637000000, 0),
+	}}, res)
+}
+
+func TestUnlinkOAuthAccount(t *testing.T) {
+	for _, test := range deleteSessionTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.OAuthUnlinkRequest{
+			UserId:   "1",
+			Provider: "vk",
+		}
+		clientMock.On("UnlinkOAuthAccount", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.UnlinkOAuthAccount("1", "vk")
+		require.Equal(t, test.clientErr, err)
+	}
+}
@@ -34,7 +34,8 @@ func SendEmail(theme, htmlTemplate string, info []*models.Info) {
 
 	ts, err := template.ParseFiles(htmlTemplate)
 	if err != nil {
-		fmt.Println(err)
+		log.Error(err)
+		return
 	}
 
 	for _, reciever := range info {
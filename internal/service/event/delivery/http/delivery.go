@@ -1,19 +1,33 @@
 package http
 
 import (
+	"backend/internal/models"
 	"backend/internal/response"
 	"backend/internal/service/event"
+	error2 "backend/internal/service/event/error"
 	"backend/internal/utils"
+	"backend/pkg/ical"
 	log "backend/pkg/logger"
 	"backend/pkg/notificator"
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
 )
 
-const logMessage = "service:event:delivery:http:"
+const (
+	logMessage = "service:event:delivery:http:"
+	//Without zoom map pins are not grouped into clusters
+	defaultMapZoom = 22
+	//Used in the event uids if the site url is not configured
+	defaultCalendarDomain = "bmstusa.ru"
+	calendarName          = "BMSTUSA"
+)
 
 type Delivery struct {
 	useCase     event.UseCase
@@ -27,28 +41,110 @@ func NewDelivery(useCase event.UseCase, notificator notificator.NotificationMana
 	}
 }
 
-func (h *Delivery) CreateEvent(w http.ResponseWriter, r *http.Request) {
-	message := logMessage + "CreateEvent:"
-	log.Debug(message + "started")
-	userId := r.Context().Value(response.CtxString("userId")).(string)
+func getPageFromQuery(q url.Values) (*models.Page, error) {
+	page := &models.Page{}
+	if len(q["limit"]) > 0 {
+		limit, err := strconv.Atoi(q["limit"][0])
+		if err != nil {
+			return nil, error2.ErrAtoi
+		}
+		page.Limit = limit
+	}
+	if len(q["cursor"]) > 0 {
+		page.Cursor = q["cursor"][0]
+	}
+	return page, nil
+}
+
+func getTimeFromQuery(q url.Values, key string) (time.Time, error) {
+	if len(q[key]) == 0 || q[key][0] == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, q[key][0])
+	if err != nil {
+		return time.Time{}, error2.ErrDateFormat
+	}
+	return t, nil
+}
+
+func getFloatFromQuery(q url.Values, key string) (float64, error) {
+	value, err := strconv.ParseFloat(q.Get(key), 64)
+	if err != nil {
+		return 0, error2.ErrGeo
+	}
+	return value, nil
+}
+
+// Radius filter is applied only when lat, lng and radius are all passed
+func getNearFromQuery(q url.Values) (*models.GeoCircle, error) {
+	if q.Get("lat") == "" && q.Get("lng") == "" && q.Get("radius") == "" {
+		return nil, nil
+	}
+	var err error
+	near := &models.GeoCircle{}
+	if near.Latitude, err = getFloatFromQuery(q, "lat"); err != nil {
+		return nil, err
+	}
+	if near.Longitude, err = getFloatFromQuery(q, "lng"); err != nil {
+		return nil, err
+	}
+	if near.RadiusKm, err = getFloatFromQuery(q, "radius"); err != nil {
+		return nil, err
+	}
+	return near, nil
+}
+
+// Bounding box is passed as "minLng,minLat,maxLng,maxLat"
+func getBBoxFromQuery(q url.Values) (*models.BBox, error) {
+	values := strings.Split(q.Get("bbox"), ",")
+	if len(values) != 4 {
+		return nil, error2.ErrBBox
+	}
+	coords := make([]float64, len(values))
+	for i, value := range values {
+		coord, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, error2.ErrBBox
+		}
+		coords[i] = coord
+	}
+	return &models.BBox{
+		MinLongitude: coords[0],
+		MinLatitude:  coords[1],
+		MaxLongitude: coords[2],
+		MaxLatitude:  coords[3],
+	}, nil
+}
+
+// Event is passed as json in the multipart form, the image is optional
+func getEventFromForm(r *http.Request) (*models.Event, error) {
 	err := r.ParseMultipartForm(5 << 20)
-	if !response.CheckIfNoError(&w, err, message) {
-		return
+	if err != nil {
+		return nil, err
 	}
 	eventReader := strings.NewReader(r.FormValue("json"))
 	eventFromRequest, err := response.GetEventFromRequest(eventReader)
-	if !response.CheckIfNoError(&w, err, message) {
-		return
+	if err != nil {
+		return nil, err
 	}
-
 	imgUrl, err := utils.SaveImageFromRequest(r, "file")
 	if err == utils.ErrFileExt {
-		response.CheckIfNoError(&w, err, message)
-		return
+		return nil, err
 	}
 	if err == nil {
 		eventFromRequest.ImgUrl = imgUrl
 	}
+	return eventFromRequest, nil
+}
+
+func (h *Delivery) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CreateEvent:"
+	log.Debug(message + "started")
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	eventFromRequest, err := getEventFromForm(r)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
 	eventFromRequest.AuthorId = userId
 	eventID, err := h.useCase.CreateEvent(eventFromRequest)
 	if !response.CheckIfNoError(&w, err, message) {
@@ -59,31 +155,53 @@ func (h *Delivery) CreateEvent(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "ended")
 }
 
+// Updates a single event, an occurrence of a series is detached from it
 func (h *Delivery) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "UpdateEvent:"
 	log.Debug(message + "started")
 	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
 	eventId := vars["id"]
 	userId := r.Context().Value(response.CtxString("userId")).(string)
-	err := r.ParseMultipartForm(5 << 20)
+	eventFromRequest, err := getEventFromForm(r)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	eventReader := strings.NewReader(r.FormValue("json"))
-	eventFromRequest, err := response.GetEventFromRequest(eventReader)
+	eventFromRequest.ID = eventId
+	err = h.useCase.UpdateEvent(eventFromRequest, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	imgUrl, err := utils.SaveImageFromRequest(r, "file")
-	if err == utils.ErrFileExt {
-		response.CheckIfNoError(&w, err, message)
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+// Deletes a single event, an occurrence of a series is excluded from it
+func (h *Delivery) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DeleteEvent:"
+	log.Debug(message + "started")
+	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	eventId := vars["id"]
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	err := h.useCase.DeleteEvent(eventId, userId)
+	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	if err == nil {
-		eventFromRequest.ImgUrl = imgUrl
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "UpdateSeries:"
+	log.Debug(message + "started")
+	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	eventId := vars["id"]
+	userId := r.Context().Value(response.CtxString("userId")).(string)
+	eventFromRequest, err := getEventFromForm(r)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
 	}
 	eventFromRequest.ID = eventId
-	err = h.useCase.UpdateEvent(eventFromRequest, userId)
+	err = h.useCase.UpdateSeries(eventFromRequest, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
@@ -91,13 +209,13 @@ func (h *Delivery) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "ended")
 }
 
-func (h *Delivery) DeleteEvent(w http.ResponseWriter, r *http.Request) {
-	message := logMessage + "DeleteEvent:"
+func (h *Delivery) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DeleteSeries:"
 	log.Debug(message + "started")
 	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
 	eventId := vars["id"]
 	userId := r.Context().Value(response.CtxString("userId")).(string)
-	err := h.useCase.DeleteEvent(eventId, userId)
+	err := h.useCase.DeleteSeries(eventId, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
@@ -127,7 +245,6 @@ func (h *Delivery) GetEvents(w http.ResponseWriter, r *http.Request) {
 	var category string
 	var city string
 	var tag string
-	var date string
 
 	if len(q["userId"]) > 0 {
 		userId = q["userId"][0]
@@ -144,16 +261,53 @@ func (h *Delivery) GetEvents(w http.ResponseWriter, r *http.Request) {
 	if len(q["city"]) > 0 {
 		city = q["city"][0]
 	}
-	if len(q["date"]) > 0 {
-		date = q["date"][0]
-	}
 	tags := strings.Split(tag, "|")
+	from, err := getTimeFromQuery(q, "from")
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	to, err := getTimeFromQuery(q, "to")
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	near, err := getNearFromQuery(q)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	page, err := getPageFromQuery(q)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+
+	eventsList, nextCursor, err := h.useCase.GetEvents(userId, title, category, city, from, to, tags, near, page)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.EventListResponse(eventsList, nextCursor))
+}
 
-	eventsList, err := h.useCase.GetEvents(userId, title, category, city, date, tags)
+func (h *Delivery) GetEventsMap(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetEventsMap:"
+	log.Debug(message + "started")
+	q := r.URL.Query()
+	bbox, err := getBBoxFromQuery(q)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	zoom := defaultMapZoom
+	if q.Get("zoom") != "" {
+		zoom, err = strconv.Atoi(q.Get("zoom"))
+		if err != nil {
+			response.CheckIfNoError(&w, error2.ErrAtoi, message)
+			return
+		}
+	}
+	eventMap, err := h.useCase.GetEventsMap(bbox, zoom)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventsList))
+	response.SendResponse(w, response.EventMapResponse(eventMap))
+	log.Debug(message + "ended")
 }
 
 func (h *Delivery) GetVisitedEvents(w http.ResponseWriter, r *http.Request) {
@@ -161,11 +315,15 @@ func (h *Delivery) GetVisitedEvents(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	eventList, err := h.useCase.GetVisitedEvents(userId)
+	page, err := getPageFromQuery(r.URL.Query())
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventList))
+	eventList, nextCursor, err := h.useCase.GetVisitedEvents(userId, page)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.EventListResponse(eventList, nextCursor))
 }
 
 func (h *Delivery) GetCreatedEvents(w http.ResponseWriter, r *http.Request) {
@@ -173,11 +331,15 @@ func (h *Delivery) GetCreatedEvents(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	eventList, err := h.useCase.GetCreatedEvents(userId)
+	page, err := getPageFromQuery(r.URL.Query())
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	eventList, nextCursor, err := h.useCase.GetCreatedEvents(userId, page)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventList))
+	response.SendResponse(w, response.EventListResponse(eventList, nextCursor))
 }
 
 func (h *Delivery) Visit(w http.ResponseWriter, r *http.Request) {
@@ -192,11 +354,11 @@ func (h *Delivery) Visit(w http.ResponseWriter, r *http.Request) {
 		response.CheckIfNoError(&w, errors.New("type casting error"), message)
 	}
 	eventId := vars["id"]
-	err := h.useCase.Visit(eventId, userId)
+	position, err := h.useCase.Visit(eventId, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.OkResponse())
+	response.SendResponse(w, response.FavouriteResponse(position == 0, position))
 	log.Debug(message + "ended")
 }
 
@@ -212,11 +374,14 @@ func (h *Delivery) Unvisit(w http.ResponseWriter, r *http.Request) {
 		response.CheckIfNoError(&w, errors.New("type casting error"), message)
 	}
 	eventId := vars["id"]
-	err := h.useCase.Unvisit(eventId, userId)
+	promotedUserId, err := h.useCase.Unvisit(eventId, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
 	response.SendResponse(w, response.OkResponse())
+	if promotedUserId != "" {
+		_ = h.notificator.WaitlistNotification(promotedUserId, eventId)
+	}
 	log.Debug(message + "ended")
 }
 
@@ -232,11 +397,152 @@ func (h *Delivery) IsVisited(w http.ResponseWriter, r *http.Request) {
 		response.CheckIfNoError(&w, errors.New("type casting error"), message)
 	}
 	eventId := vars["id"]
-	res, err := h.useCase.IsVisited(eventId, userId)
+	res, position, err := h.useCase.IsVisited(eventId, userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.FavouriteResponse(res, position))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) SetRSVP(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "SetRSVP:"
+	log.Debug(message + "started")
+	vars, ok := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	eventId := vars["id"]
+	status, err := response.GetRSVPStatusFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	rsvp, promotedUserId, err := h.useCase.SetRSVP(eventId, userId, status)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.RSVPResponse(rsvp))
+	if promotedUserId != "" {
+		_ = h.notificator.WaitlistNotification(promotedUserId, eventId)
+	}
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetRSVP(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetRSVP:"
+	log.Debug(message + "started")
+	vars, ok := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	eventId := vars["id"]
+	rsvp, err := h.useCase.GetRSVP(eventId, userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.RSVPResponse(rsvp))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) Invite(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "Invite:"
+	log.Debug(message + "started")
+	q := r.URL.Query()
+	var eventId string
+	if len(q["eventId"]) > 0 {
+		eventId = q["eventId"][0]
+	}
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	receiversId, err := response.GetUsersIdFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.useCase.Invite(eventId, userId, receiversId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	for _, receiverId := range receiversId {
+		err = h.notificator.InvitationNotification(receiverId, userId, eventId)
+		if !response.CheckIfNoError(&w, err, message) {
+			return
+		}
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) respondInvitation(w http.ResponseWriter, r *http.Request, message string, status string) {
+	log.Debug(message + "started")
+	vars, ok := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	invitationId := vars["id"]
+	invitation, position, err := h.useCase.RespondInvitation(invitationId, userId, status)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.InvitationResponse(invitation, position))
+	accepted := status == models.InvitationAccepted
+	_ = h.notificator.InvitationResponseNotification(invitation.InviterId, userId, invitation.EventId, accepted)
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondInvitation(w, r, logMessage+"AcceptInvitation:", models.InvitationAccepted)
+}
+
+func (h *Delivery) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondInvitation(w, r, logMessage+"DeclineInvitation:", models.InvitationDeclined)
+}
+
+func (h *Delivery) GetEventInvitations(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetEventInvitations:"
+	log.Debug(message + "started")
+	vars, ok := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	eventId := vars["id"]
+	invitations, err := h.useCase.GetEventInvitations(eventId, userId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.FavouriteResponse(res))
+	response.SendResponse(w, response.InvitationListResponse(invitations))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetUserInvitations(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetUserInvitations:"
+	log.Debug(message + "started")
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+	}
+	invitations, err := h.useCase.GetUserInvitations(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.InvitationListResponse(invitations))
 	log.Debug(message + "ended")
 }
 
@@ -250,3 +556,90 @@ func (h *Delivery) GetCities(w http.ResponseWriter, r *http.Request) {
 	response.SendResponse(w, response.CitiesResponse(res))
 	log.Debug(message + "ended")
 }
+
+func calendarBaseURL() string {
+	return strings.TrimSuffix(viper.GetString("calendar.base_url"), "/")
+}
+
+func calendarDomain() string {
+	u, err := url.Parse(calendarBaseURL())
+	if err != nil || u.Hostname() == "" {
+		return defaultCalendarDomain
+	}
+	return u.Hostname()
+}
+
+func calendarFeedURL(userId string, token string) string {
+	return calendarBaseURL() + "/api/user/" + userId + "/calendar.ics?token=" + token
+}
+
+func (h *Delivery) GetEventICS(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetEventICS:"
+	log.Debug(message + "started")
+	vars := mux.Vars(r)
+	eventId := vars["id"]
+	resultEvent, err := h.useCase.GetEventById(eventId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	calendar := &ical.Calendar{
+		Events: []ical.Event{response.MakeICalEvent(resultEvent, calendarDomain())},
+	}
+	response.SendCalendar(w, "event-"+eventId+".ics", calendar)
+	log.Debug(message + "ended")
+}
+
+// Feed is public, it is protected by the token from the url
+func (h *Delivery) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetCalendarFeed:"
+	log.Debug(message + "started")
+	vars := mux.Vars(r)
+	userId := vars["id"]
+	token := r.URL.Query().Get("token")
+	events, err := h.useCase.GetCalendarFeed(userId, token)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	domain := calendarDomain()
+	calendar := &ical.Calendar{
+		Name:   calendarName,
+		Events: make([]ical.Event, len(events)),
+	}
+	for i, e := range events {
+		calendar.Events[i] = response.MakeICalEvent(e, domain)
+	}
+	response.SendCalendar(w, "calendar.ics", calendar)
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetCalendarToken(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetCalendarToken:"
+	log.Debug(message + "started")
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+		return
+	}
+	token, err := h.useCase.GetCalendarToken(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.CalendarResponse(calendarFeedURL(userId, token)))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RegenerateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RegenerateCalendarToken:"
+	log.Debug(message + "started")
+	userId, ok := r.Context().Value(response.CtxString("userId")).(string)
+	if !ok {
+		response.CheckIfNoError(&w, errors.New("type casting error"), message)
+		return
+	}
+	token, err := h.useCase.RegenerateCalendarToken(userId)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.CalendarResponse(calendarFeedURL(userId, token)))
+	log.Debug(message + "ended")
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"backend/internal/models"
 	"backend/internal/response"
+	error2 "backend/internal/service/event/error"
 	"backend/internal/service/event/usecase"
 	"backend/pkg/notificator"
 	"bytes"
@@ -11,10 +12,12 @@ import (
 	"errors"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 const logTestMessage = "service:event:delivery"
@@ -202,6 +205,98 @@ func TestDeleteEvent(t *testing.T) {
 	}
 }
 
+var updateSeriesTests = []struct {
+	id         int
+	json       string
+	event      *models.Event
+	useCaseErr error
+	status     response.HttpStatus
+}{
+	{
+		1,
+		`{"title":"test","rrule":"FREQ=WEEKLY;COUNT=4","exdates":["2031-11-10"]}`,
+		&models.Event{
+			ID:      "100",
+			Title:   "test",
+			RRule:   "FREQ=WEEKLY;COUNT=4",
+			ExDates: []time.Time{time.Date(2031, 11, 10, 0, 0, 0, 0, time.UTC)},
+		},
+		nil,
+		http.StatusOK,
+	},
+	{
+		2,
+		`{"title":"test"}`,
+		&models.Event{
+			ID:    "100",
+			Title: "test",
+		},
+		error2.ErrNotSeries,
+		http.StatusBadRequest,
+	},
+	{
+		3,
+		`{"title":"test","exdates":["10.11.2031"]}`,
+		nil,
+		nil,
+		http.StatusBadRequest,
+	},
+}
+
+func TestUpdateSeries(t *testing.T) {
+	for _, test := range updateSeriesTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		useCaseMock.On("UpdateSeries", test.event, "1").Return(test.useCaseErr)
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("json", test.json), test.id)
+		require.NoError(t, writer.Close(), test.id)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/event", deliveryTest.UpdateSeries).Methods("POST")
+		req, err := http.NewRequest("POST", "/event", body)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		w := httptest.NewRecorder()
+		userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+		varsContext := context.WithValue(userIdContext, response.CtxString("vars"), map[string]string{"id": "100"})
+		r.ServeHTTP(w, req.WithContext(varsContext))
+
+		var resp response.Response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp), test.id)
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.event != nil {
+			useCaseMock.AssertCalled(t, "UpdateSeries", test.event, "1")
+		}
+	}
+}
+
+func TestDeleteSeries(t *testing.T) {
+	for _, test := range deleteEventTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		useCaseMock.On("DeleteSeries", test.eventId, test.userId).Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/event", deliveryTest.DeleteSeries).Methods("DELETE")
+		req, err := http.NewRequest("DELETE", "/event", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), test.userId)
+		varsContext := context.WithValue(userIdContext, response.CtxString("vars"), test.vars)
+		r.ServeHTTP(w, req.WithContext(varsContext))
+		useCaseMock.AssertCalled(t, "DeleteSeries", test.eventId, test.userId)
+	}
+}
+
 var getEventByIdTests = []struct {
 	id         int
 	eventId    string
@@ -264,10 +359,10 @@ var getEventsTests = []struct {
 			"query":    "testQuery",
 			"category": "testCategory",
 			"city":     "testCity",
-			"date":     "testDate",
+			"from":     "2021-12-01T00:00:00Z",
 			"tags":     "testTags|testTags|testTags",
 		},
-		"?query=testQuery&category=testCategory&city=testCity&date=testDate&tags=testTags|testTags|testTags",
+		"?query=testQuery&category=testCategory&city=testCity&from=2021-12-01T00:00:00Z&tags=testTags|testTags|testTags",
 		nil,
 		nil,
 	},
@@ -293,11 +388,11 @@ func TestGetEvents(t *testing.T) {
 		title := test.vars["query"]
 		category := test.vars["category"]
 		city := test.vars["city"]
-		date := test.vars["date"]
+		from, _ := time.Parse(time.RFC3339, test.vars["from"])
 		tag := test.vars["tags"]
 		tags := strings.Split(tag, "|")
 
-		useCaseMock.On("GetEvents", "", title, category, city, date, tags).Return(test.eventList, test.useCaseErr)
+		useCaseMock.On("GetEvents", "", title, category, city, from, time.Time{}, tags, (*models.GeoCircle)(nil), &models.Page{}).Return(test.eventList, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/events", deliveryTest.GetEvents).Methods("GET")
@@ -341,7 +436,7 @@ func TestGetEventsFromAuthor(t *testing.T) {
 
 		authorId := test.vars["authorid"]
 
-		useCaseMock.On("GetCreatedEvents", authorId).Return(test.eventList, test.useCaseErr)
+		useCaseMock.On("GetCreatedEvents", authorId, &models.Page{}).Return(test.eventList, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("{id:[0-9]+}", deliveryTest.GetCreatedEvents).
@@ -382,7 +477,7 @@ func TestGetVisitedEvents(t *testing.T) {
 		notificatorMock := new(notificator.NotificatorMock)
 		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
 
-		useCaseMock.On("GetVisitedEvents", test.userId).Return([]*models.Event{}, test.useCaseErr)
+		useCaseMock.On("GetVisitedEvents", test.userId, &models.Page{}).Return([]*models.Event{}, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetVisitedEvents).Methods("GET")
@@ -393,13 +488,63 @@ func TestGetVisitedEvents(t *testing.T) {
 	}
 }
 
+var getEventsMapTests = []struct {
+	id         int
+	query      string
+	bbox       *models.BBox
+	zoom       int
+	useCaseErr error
+}{
+	{
+		1,
+		"?bbox=30,50,40,60&zoom=10",
+		&models.BBox{MinLongitude: 30, MinLatitude: 50, MaxLongitude: 40, MaxLatitude: 60},
+		10,
+		nil,
+	},
+	{
+		2,
+		"?bbox=30,50,40,60",
+		&models.BBox{MinLongitude: 30, MinLatitude: 50, MaxLongitude: 40, MaxLatitude: 60},
+		defaultMapZoom,
+		errors.New("test_err"),
+	},
+	{
+		3,
+		"?bbox=30,50,40",
+		nil,
+		0,
+		nil,
+	},
+}
+
+func TestGetEventsMap(t *testing.T) {
+	for _, test := range getEventsMapTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		if test.bbox != nil {
+			useCaseMock.On("GetEventsMap", test.bbox, test.zoom).Return(&models.EventMap{}, test.useCaseErr)
+		}
+
+		r := mux.NewRouter()
+		r.HandleFunc("/map", deliveryTest.GetEventsMap).Methods("GET")
+		req, err := http.NewRequest("GET", "/map"+test.query, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		useCaseMock.AssertExpectations(t)
+	}
+}
+
 func TestGetCreatedEvents(t *testing.T) {
 	for _, test := range getVisitedEventsTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
 		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
 
-		useCaseMock.On("GetCreatedEvents", test.userId).Return([]*models.Event{}, test.useCaseErr)
+		useCaseMock.On("GetCreatedEvents", test.userId, &models.Page{}).Return([]*models.Event{}, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetCreatedEvents).Methods("GET")
@@ -457,7 +602,7 @@ func TestVisit(t *testing.T) {
 			uId = userId
 		}
 
-		useCaseMock.On("Visit", eId, uId).Return(test.useCaseErr)
+		useCaseMock.On("Visit", eId, uId).Return(0, test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/test", deliveryTest.Visit).Methods("GET")
@@ -474,10 +619,11 @@ func TestVisit(t *testing.T) {
 }
 
 var unvisitTests = []struct {
-	id         int
-	vars       interface{}
-	userId     interface{}
-	useCaseErr error
+	id             int
+	vars           interface{}
+	userId         interface{}
+	promotedUserId string
+	useCaseErr     error
 }{
 	{
 		1,
@@ -485,12 +631,14 @@ var unvisitTests = []struct {
 			"id": "123",
 		},
 		"1",
+		"",
 		nil,
 	},
 	{
 		2,
 		errors.New(""),
 		"2",
+		"",
 		errors.New("test_err"),
 	},
 	{
@@ -499,8 +647,18 @@ var unvisitTests = []struct {
 			"id": "123",
 		},
 		errors.New(""),
+		"",
 		errors.New("test_err"),
 	},
+	{
+		4,
+		map[string]string{
+			"id": "123",
+		},
+		"1",
+		"5",
+		nil,
+	},
 }
 
 func TestUnvisit(t *testing.T) {
@@ -520,7 +678,8 @@ func TestUnvisit(t *testing.T) {
 			uId = userId
 		}
 
-		useCaseMock.On("Unvisit", eId, uId).Return(test.useCaseErr)
+		useCaseMock.On("Unvisit", eId, uId).Return(test.promotedUserId, test.useCaseErr)
+		notificatorMock.On("WaitlistNotification", test.promotedUserId, eId).Return(nil)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/test", deliveryTest.Unvisit).Methods("GET")
@@ -552,7 +711,7 @@ func TestIsVisited(t *testing.T) {
 			uId = userId
 		}
 
-		useCaseMock.On("IsVisited", eId, uId).Return(true, test.useCaseErr)
+		useCaseMock.On("IsVisited", eId, uId).Return(true, 0, test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/test", deliveryTest.IsVisited).Methods("GET")
@@ -568,6 +727,343 @@ func TestIsVisited(t *testing.T) {
 	}
 }
 
+var setRSVPTests = []struct {
+	id             int
+	vars           interface{}
+	userId         interface{}
+	body           string
+	status         string
+	promotedUserId string
+	useCaseErr     error
+}{
+	{
+		1,
+		map[string]string{
+			"id": "123",
+		},
+		"1",
+		`{"status":"maybe"}`,
+		"maybe",
+		"5",
+		nil,
+	},
+	{
+		2,
+		map[string]string{
+			"id": "123",
+		},
+		"1",
+		`{"status":"going"}`,
+		"going",
+		"",
+		errors.New("test_err"),
+	},
+	{
+		3,
+		map[string]string{
+			"id": "123",
+		},
+		"1",
+		`{"status":`,
+		"",
+		"",
+		nil,
+	},
+}
+
+func TestSetRSVP(t *testing.T) {
+	for _, test := range setRSVPTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		var eId string
+		vars, ok := test.vars.(map[string]string)
+		if ok {
+			eId = vars["id"]
+		}
+		uId, _ := test.userId.(string)
+
+		useCaseMock.On("SetRSVP", eId, uId, test.status).Return(&models.RSVP{Status: test.status}, test.promotedUserId, test.useCaseErr)
+		notificatorMock.On("WaitlistNotification", test.promotedUserId, eId).Return(nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/test", deliveryTest.SetRSVP).Methods("POST")
+		req, err := http.NewRequest("POST", "/test", strings.NewReader(test.body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		ctxVars := context.WithValue(context.Background(), response.CtxString("vars"), test.vars)
+		ctxUserId := context.WithValue(ctxVars, response.CtxString("userId"), test.userId)
+		req = req.WithContext(ctxUserId)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	}
+}
+
+func TestGetRSVP(t *testing.T) {
+	for _, test := range unvisitTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		var eId string
+		var uId string
+		vars, ok := test.vars.(map[string]string)
+		if ok {
+			eId = vars["id"]
+		}
+		userId, ok := test.userId.(string)
+		if ok {
+			uId = userId
+		}
+
+		useCaseMock.On("GetRSVP", eId, uId).Return(&models.RSVP{Status: models.RSVPGoing}, test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/test", deliveryTest.GetRSVP).Methods("GET")
+		req, err := http.NewRequest("GET", "/test", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		ctxVars := context.WithValue(context.Background(), response.CtxString("vars"), test.vars)
+		ctxUserId := context.WithValue(ctxVars, response.CtxString("userId"), test.userId)
+		req = req.WithContext(ctxUserId)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	}
+}
+
+var inviteTests = []struct {
+	id         int
+	userId     interface{}
+	eventId    string
+	body       string
+	receivers  []string
+	useCaseErr error
+}{
+	{
+		1,
+		"1",
+		"10",
+		`{"usersId":["2","3"]}`,
+		[]string{"2", "3"},
+		nil,
+	},
+	{
+		2,
+		"1",
+		"10",
+		`{"usersId":["2"]}`,
+		[]string{"2"},
+		errors.New("test_err"),
+	},
+	{
+		3,
+		"1",
+		"10",
+		`{"usersId":`,
+		nil,
+		nil,
+	},
+}
+
+func TestInvite(t *testing.T) {
+	for _, test := range inviteTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		uId, _ := test.userId.(string)
+		useCaseMock.On("Invite", test.eventId, uId, test.receivers).Return(test.useCaseErr)
+		for _, receiverId := range test.receivers {
+			notificatorMock.On("InvitationNotification", receiverId, uId, test.eventId).Return(nil)
+		}
+
+		r := mux.NewRouter()
+		r.HandleFunc("/test", deliveryTest.Invite).Methods("POST")
+		req, err := http.NewRequest("POST", "/test?eventId="+test.eventId, strings.NewReader(test.body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		ctxUserId := context.WithValue(context.Background(), response.CtxString("userId"), test.userId)
+		req = req.WithContext(ctxUserId)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if test.useCaseErr == nil && test.receivers != nil {
+			notificatorMock.AssertNumberOfCalls(t, "InvitationNotification", len(test.receivers))
+		} else {
+			notificatorMock.AssertNotCalled(t, "InvitationNotification")
+		}
+	}
+}
+
+func TestRespondInvitation(t *testing.T) {
+	for _, test := range unvisitTests {
+		for _, accept := range []bool{true, false} {
+			useCaseMock := new(usecase.UseCaseMock)
+			notificatorMock := new(notificator.NotificatorMock)
+			deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+			var iId string
+			var uId string
+			vars, ok := test.vars.(map[string]string)
+			if ok {
+				iId = vars["id"]
+			}
+			userId, ok := test.userId.(string)
+			if ok {
+				uId = userId
+			}
+
+			status := models.InvitationDeclined
+			handler := deliveryTest.DeclineInvitation
+			if accept {
+				status = models.InvitationAccepted
+				handler = deliveryTest.AcceptInvitation
+			}
+			invitation := &models.Invitation{ID: iId, EventId: "5", InviterId: "7", ReceiverId: uId, Status: status}
+			useCaseMock.On("RespondInvitation", iId, uId, status).Return(invitation, 0, test.useCaseErr)
+			notificatorMock.On("InvitationResponseNotification", "7", uId, "5", accept).Return(nil)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/test", handler).Methods("POST")
+			req, err := http.NewRequest("POST", "/test", nil)
+			require.NoError(t, err, logTestMessage+"NewRequest error")
+
+			ctxVars := context.WithValue(context.Background(), response.CtxString("vars"), test.vars)
+			ctxUserId := context.WithValue(ctxVars, response.CtxString("userId"), test.userId)
+			req = req.WithContext(ctxUserId)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+		}
+	}
+}
+
+func TestGetEventInvitations(t *testing.T) {
+	for _, test := range unvisitTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		var eId string
+		var uId string
+		vars, ok := test.vars.(map[string]string)
+		if ok {
+			eId = vars["id"]
+		}
+		userId, ok := test.userId.(string)
+		if ok {
+			uId = userId
+		}
+
+		useCaseMock.On("GetEventInvitations", eId, uId).Return([]*models.Invitation{}, test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/test", deliveryTest.GetEventInvitations).Methods("GET")
+		req, err := http.NewRequest("GET", "/test", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		ctxVars := context.WithValue(context.Background(), response.CtxString("vars"), test.vars)
+		ctxUserId := context.WithValue(ctxVars, response.CtxString("userId"), test.userId)
+		req = req.WithContext(ctxUserId)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	}
+}
+
+func TestGetEventICS(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	notificatorMock := new(notificator.NotificatorMock)
+	deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+	useCaseMock.On("GetEventById", "1").Return(&models.Event{
+		ID:        "1",
+		Title:     "test",
+		StartDate: time.Date(2021, 12, 1, 16, 0, 0, 0, time.UTC),
+	}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/{id:[0-9]+}.ics", deliveryTest.GetEventICS).Methods("GET")
+	req, err := http.NewRequest("GET", "/1.ics", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Body.String(), "UID:event-1@"+defaultCalendarDomain+"\r\n")
+	require.Contains(t, w.Body.String(), "DTSTART:20211201T160000Z\r\n")
+	require.Contains(t, w.Body.String(), "SUMMARY:test\r\n")
+}
+
+var getCalendarFeedTests = []struct {
+	id          int
+	url         string
+	token       string
+	useCaseErr  error
+	contentType string
+}{
+	{1, "/user/1/calendar.ics?token=abc", "abc", nil, "text/calendar; charset=utf-8"},
+	{2, "/user/1/calendar.ics?token=old", "old", error2.ErrCalendarToken, ""},
+}
+
+func TestGetCalendarFeed(t *testing.T) {
+	for _, test := range getCalendarFeedTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+		useCaseMock.On("GetCalendarFeed", "1", test.token).Return([]*models.Event{{ID: "1"}, {ID: "2"}}, test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/user/{id:[0-9]+}/calendar.ics", deliveryTest.GetCalendarFeed).Methods("GET")
+		req, err := http.NewRequest("GET", test.url, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, test.contentType, w.Header().Get("Content-Type"), test.id)
+		if test.useCaseErr == nil {
+			require.Equal(t, 2, strings.Count(w.Body.String(), "BEGIN:VEVENT"), test.id)
+		} else {
+			var resp response.Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp), test.id)
+			require.Equal(t, response.HttpStatus(http.StatusForbidden), resp.Status, test.id)
+		}
+	}
+}
+
+func TestCalendarToken(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	notificatorMock := new(notificator.NotificatorMock)
+	deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+
+	useCaseMock.On("GetCalendarToken", "1").Return("abc", nil)
+	useCaseMock.On("RegenerateCalendarToken", "1").Return("def", nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/calendar", deliveryTest.GetCalendarToken).Methods("GET")
+	r.HandleFunc("/calendar/token", deliveryTest.RegenerateCalendarToken).Methods("POST")
+	for _, test := range []struct {
+		method string
+		url    string
+		token  string
+	}{
+		{"GET", "/calendar", "abc"},
+		{"POST", "/calendar/token", "def"},
+	} {
+		req, err := http.NewRequest(test.method, test.url, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		ctx := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req.WithContext(ctx))
+		require.Contains(t, w.Body.String(), "/api/user/1/calendar.ics?token="+test.token, test.url)
+	}
+}
+
 func TestGetCities(t *testing.T) {
 	for _, test := range unvisitTests {
 		useCaseMock := new(usecase.UseCaseMock)
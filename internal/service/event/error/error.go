@@ -3,9 +3,27 @@ package error
 import "errors"
 
 var (
-	ErrEmptyData  = errors.New("required data is empty")
-	ErrPostgres   = errors.New("internal DB server error")
-	ErrAtoi       = errors.New("cant cast string to int")
-	ErrNotAllowed = errors.New("user is not allowed to do this")
-	ErrNoRows     = errors.New("no rows in a query result")
+	ErrEmptyData          = errors.New("required data is empty")
+	ErrPostgres           = errors.New("internal DB server error")
+	ErrAtoi               = errors.New("cant cast string to int")
+	ErrNotAllowed         = errors.New("user is not allowed to do this")
+	ErrNoRows             = errors.New("no rows in a query result")
+	ErrCursor             = errors.New("invalid page cursor")
+	ErrDateFormat         = errors.New("wrong date format")
+	ErrTimeZone           = errors.New("unknown time zone")
+	ErrDateRange          = errors.New("event ends before it starts")
+	ErrStartDate          = errors.New("event start date is not set")
+	ErrGeo                = errors.New("wrong coordinates")
+	ErrBBox               = errors.New("wrong bounding box")
+	ErrGeocoder           = errors.New("can't get city and address from coordinates")
+	ErrCapacity           = errors.New("wrong event capacity")
+	ErrRSVPStatus         = errors.New("unknown rsvp status")
+	ErrInvitationExists   = errors.New("invitation already exists")
+	ErrInvitationExpired  = errors.New("invitation is expired")
+	ErrInvitationAnswered = errors.New("invitation is already answered")
+	ErrInvitationStatus   = errors.New("unknown invitation status")
+	ErrRRule              = errors.New("invalid recurrence rule")
+	ErrNotSeries          = errors.New("event is not recurring")
+	ErrSeriesTooLong      = errors.New("recurrence rule has too many occurrences")
+	ErrCalendarToken      = errors.New("invalid calendar token")
 )
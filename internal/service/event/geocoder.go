@@ -0,0 +1,5 @@
+package event
+
+type Geocoder interface {
+	Geocode(latitude float64, longitude float64) (city string, address string, err error)
+}
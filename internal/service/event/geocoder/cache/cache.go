@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"backend/internal/service/event"
+	log "backend/pkg/logger"
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	logMessage = "service:event:geocoder:cache:"
+	keyPrefix  = "geocoder:"
+)
+
+type Storage interface {
+	Get(key string) (string, bool)
+	Set(key string, value string)
+}
+
+// Geocoder caches the answers of another geocoder.
+// Coordinates are rounded, so the nearby points share one cache entry
+type Geocoder struct {
+	geocoder  event.Geocoder
+	storage   Storage
+	precision int
+}
+
+func NewGeocoder(geocoder event.Geocoder, storage Storage, precision int) *Geocoder {
+	return &Geocoder{
+		geocoder:  geocoder,
+		storage:   storage,
+		precision: precision,
+	}
+}
+
+type entry struct {
+	City    string `json:"city"`
+	Address string `json:"address"`
+}
+
+func (g *Geocoder) key(latitude float64, longitude float64) string {
+	return keyPrefix + strconv.FormatFloat(latitude, 'f', g.precision, 64) + ":" +
+		strconv.FormatFloat(longitude, 'f', g.precision, 64)
+}
+
+func (g *Geocoder) Geocode(latitude float64, longitude float64) (string, string, error) {
+	message := logMessage + "Geocode:"
+	key := g.key(latitude, longitude)
+	if value, ok := g.storage.Get(key); ok {
+		var e entry
+		if err := json.Unmarshal([]byte(value), &e); err == nil {
+			log.Debug(message + "cache hit " + key)
+			return e.City, e.Address, nil
+		}
+	}
+	city, address, err := g.geocoder.Geocode(latitude, longitude)
+	if err != nil {
+		return "", "", err
+	}
+	value, err := json.Marshal(entry{City: city, Address: address})
+	if err == nil {
+		g.storage.Set(key, string(value))
+	}
+	return city, address, nil
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type countingGeocoder struct {
+	calls int
+	err   error
+}
+
+func (g *countingGeocoder) Geocode(latitude float64, longitude float64) (string, string, error) {
+	g.calls++
+	if g.err != nil {
+		return "", "", g.err
+	}
+	return "test_city", "test_address", nil
+}
+
+func TestGeocodeCached(t *testing.T) {
+	inner := &countingGeocoder{}
+	geocoder := NewGeocoder(inner, NewMemoryStorage(time.Hour, 10), 3)
+
+	city, address, err := geocoder.Geocode(55.76581, 37.68503)
+	require.NoError(t, err)
+	require.Equal(t, "test_city", city)
+	require.Equal(t, "test_address", address)
+
+	//Rounds to the same key
+	_, _, err = geocoder.Geocode(55.76612, 37.68549)
+	require.NoError(t, err)
+	require.Equal(t, 1, inner.calls)
+
+	_, _, err = geocoder.Geocode(55.77, 37.68503)
+	require.NoError(t, err)
+	require.Equal(t, 2, inner.calls)
+}
+
+func TestGeocodeErrorNotCached(t *testing.T) {
+	inner := &countingGeocoder{err: errors.New("test_err")}
+	geocoder := NewGeocoder(inner, NewMemoryStorage(time.Hour, 10), 3)
+	for i := 0; i < 2; i++ {
+		_, _, err := geocoder.Geocode(55.76581, 37.68503)
+		require.Error(t, err)
+	}
+	require.Equal(t, 2, inner.calls)
+}
+
+func TestMemoryStorage(t *testing.T) {
+	storage := NewMemoryStorage(time.Hour, 2)
+	storage.Set("a", "1")
+	storage.Set("b", "2")
+	value, ok := storage.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "1", value)
+
+	//Storage is full and nothing is expired, so it is cleared
+	storage.Set("c", "3")
+	_, ok = storage.Get("a")
+	require.False(t, ok)
+	value, ok = storage.Get("c")
+	require.True(t, ok)
+	require.Equal(t, "3", value)
+
+	expired := NewMemoryStorage(-time.Second, 2)
+	expired.Set("a", "1")
+	_, ok = expired.Get("a")
+	require.False(t, ok)
+}
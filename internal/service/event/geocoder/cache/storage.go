@@ -0,0 +1,94 @@
+package cache
+
+import (
+	log "backend/pkg/logger"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+type RedisStorage struct {
+	db         redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRedisStorage(db redis.Cmdable, expiration time.Duration) *RedisStorage {
+	return &RedisStorage{
+		db:         db,
+		expiration: expiration,
+	}
+}
+
+func (s *RedisStorage) Get(key string) (string, bool) {
+	value, err := s.db.Get(key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			log.Error(logMessage+"RedisStorage:Get:err = ", err)
+		}
+		return "", false
+	}
+	return value, true
+}
+
+func (s *RedisStorage) Set(key string, value string) {
+	err := s.db.Set(key, value, s.expiration).Err()
+	if err != nil {
+		log.Error(logMessage+"RedisStorage:Set:err = ", err)
+	}
+}
+
+type memoryEntry struct {
+	value   string
+	expires time.Time
+}
+
+// MemoryStorage keeps at most maxEntries entries, expired entries are dropped when it is full
+type MemoryStorage struct {
+	mu         sync.Mutex
+	entries    map[string]memoryEntry
+	expiration time.Duration
+	maxEntries int
+}
+
+func NewMemoryStorage(expiration time.Duration, maxEntries int) *MemoryStorage {
+	return &MemoryStorage{
+		entries:    make(map[string]memoryEntry),
+		expiration: expiration,
+		maxEntries: maxEntries,
+	}
+}
+
+func (s *MemoryStorage) Get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return "", false
+	}
+	if time.Now().After(e.expires) {
+		delete(s.entries, key)
+		return "", false
+	}
+	return e.value, true
+}
+
+func (s *MemoryStorage) Set(key string, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) >= s.maxEntries {
+		now := time.Now()
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		if len(s.entries) >= s.maxEntries {
+			s.entries = make(map[string]memoryEntry)
+		}
+	}
+	s.entries[key] = memoryEntry{
+		value:   value,
+		expires: time.Now().Add(s.expiration),
+	}
+}
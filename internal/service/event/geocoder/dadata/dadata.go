@@ -0,0 +1,86 @@
+package dadata
+
+import (
+	error2 "backend/internal/service/event/error"
+	log "backend/pkg/logger"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	logMessage = "service:event:geocoder:dadata:"
+	apiUrl     = "https://suggestions.dadata.ru/suggestions/api/4_1/rs/geolocate/address"
+	//Used when the timeout is not configured
+	defaultTimeout = 5 * time.Second
+)
+
+type Geocoder struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func NewGeocoder(token string, timeout time.Duration) *Geocoder {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Geocoder{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		url:   apiUrl,
+		token: token,
+	}
+}
+
+type data struct {
+	City string `json:"city,omitempty"`
+}
+
+type addrInfo struct {
+	Value             string `json:"value,omitempty"`
+	UnrestrictedValue string `json:"unrestricted_value,omitempty"`
+	Data              data   `json:"data,omitempty"`
+}
+
+type suggest struct {
+	Suggestions []addrInfo `json:"suggestions,omitempty"`
+}
+
+func (g *Geocoder) Geocode(latitude float64, longitude float64) (string, string, error) {
+	message := logMessage + "Geocode:"
+	log.Debug(message + "started")
+	query := url.Values{}
+	query.Set("lat", strconv.FormatFloat(latitude, 'f', -1, 64))
+	query.Set("lon", strconv.FormatFloat(longitude, 'f', -1, 64))
+	req, err := http.NewRequest("GET", g.url+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Token "+g.token)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Error(message+"status = ", resp.StatusCode)
+		return "", "", error2.ErrGeocoder
+	}
+
+	suggestions := suggest{}
+	err = json.NewDecoder(resp.Body).Decode(&suggestions)
+	if err != nil {
+		return "", "", err
+	}
+	if len(suggestions.Suggestions) == 0 {
+		return "", "", error2.ErrGeocoder
+	}
+	log.Debug(message + "ended")
+	return suggestions.Suggestions[0].Data.City, suggestions.Suggestions[0].Value, nil
+}
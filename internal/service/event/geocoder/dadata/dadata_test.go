@@ -0,0 +1,75 @@
+package dadata
+
+import (
+	error2 "backend/internal/service/event/error"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var geocodeTests = []struct {
+	id         int
+	status     int
+	body       string
+	outputCity string
+	outputAddr string
+	outputErr  error
+}{
+	{
+		1,
+		http.StatusOK,
+		`{"suggestions":[{"value":"г Москва, ул 2-я Бауманская, д 5","data":{"city":"Москва"}}]}`,
+		"Москва",
+		"г Москва, ул 2-я Бауманская, д 5",
+		nil,
+	},
+	{
+		2,
+		http.StatusOK,
+		`{"suggestions":[]}`,
+		"",
+		"",
+		error2.ErrGeocoder,
+	},
+	{
+		3,
+		http.StatusForbidden,
+		``,
+		"",
+		"",
+		error2.ErrGeocoder,
+	},
+}
+
+func TestGeocode(t *testing.T) {
+	for _, test := range geocodeTests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Token test_token", r.Header.Get("Authorization"))
+			require.Equal(t, "55.75", r.URL.Query().Get("lat"))
+			require.Equal(t, "37.61", r.URL.Query().Get("lon"))
+			w.WriteHeader(test.status)
+			_, _ = w.Write([]byte(test.body))
+		}))
+		geocoder := NewGeocoder("test_token", time.Second)
+		geocoder.url = server.URL
+		city, address, err := geocoder.Geocode(55.75, 37.61)
+		require.Equal(t, test.outputErr, err, strconv.Itoa(test.id))
+		require.Equal(t, test.outputCity, city, strconv.Itoa(test.id))
+		require.Equal(t, test.outputAddr, address, strconv.Itoa(test.id))
+		server.Close()
+	}
+}
+
+func TestGeocodeTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+	geocoder := NewGeocoder("test_token", 10*time.Millisecond)
+	geocoder.url = server.URL
+	_, _, err := geocoder.Geocode(55.75, 37.61)
+	require.Error(t, err)
+}
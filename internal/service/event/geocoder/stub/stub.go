@@ -0,0 +1,57 @@
+package stub
+
+import (
+	error2 "backend/internal/service/event/error"
+	"encoding/json"
+	"math"
+	"os"
+)
+
+// Location is one entry of the stub file
+type Location struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+	City      string  `json:"city"`
+	Address   string  `json:"address"`
+}
+
+// Geocoder answers with the nearest known location, so it works without network access
+type Geocoder struct {
+	locations []Location
+}
+
+func NewGeocoder(locations []Location) *Geocoder {
+	return &Geocoder{
+		locations: locations,
+	}
+}
+
+func NewGeocoderFromFile(path string) (*Geocoder, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var locations []Location
+	err = json.NewDecoder(file).Decode(&locations)
+	if err != nil {
+		return nil, err
+	}
+	return NewGeocoder(locations), nil
+}
+
+func (g *Geocoder) Geocode(latitude float64, longitude float64) (string, string, error) {
+	var nearest *Location
+	minDistance := math.Inf(1)
+	for i, l := range g.locations {
+		distance := math.Hypot(l.Latitude-latitude, l.Longitude-longitude)
+		if distance < minDistance {
+			minDistance = distance
+			nearest = &g.locations[i]
+		}
+	}
+	if nearest == nil {
+		return "", "", error2.ErrGeocoder
+	}
+	return nearest.City, nearest.Address, nil
+}
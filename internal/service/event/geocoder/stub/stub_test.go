@@ -0,0 +1,52 @@
+package stub
+
+import (
+	error2 "backend/internal/service/event/error"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+var locations = []Location{
+	{Latitude: 55.765807, Longitude: 37.685031, City: "Москва", Address: "test_moscow"},
+	{Latitude: 59.939095, Longitude: 30.315868, City: "Санкт-Петербург", Address: "test_spb"},
+}
+
+var geocodeTests = []struct {
+	id         int
+	locations  []Location
+	latitude   float64
+	longitude  float64
+	outputCity string
+	outputAddr string
+	outputErr  error
+}{
+	{1, locations, 55.7, 37.6, "Москва", "test_moscow", nil},
+	{2, locations, 60, 30, "Санкт-Петербург", "test_spb", nil},
+	{3, nil, 60, 30, "", "", error2.ErrGeocoder},
+}
+
+func TestGeocode(t *testing.T) {
+	for _, test := range geocodeTests {
+		geocoder := NewGeocoder(test.locations)
+		city, address, err := geocoder.Geocode(test.latitude, test.longitude)
+		require.Equal(t, test.outputErr, err, strconv.Itoa(test.id))
+		require.Equal(t, test.outputCity, city, strconv.Itoa(test.id))
+		require.Equal(t, test.outputAddr, address, strconv.Itoa(test.id))
+	}
+}
+
+func TestNewGeocoderFromFile(t *testing.T) {
+	geocoder, err := NewGeocoderFromFile(filepath.Join("..", "..", "..", "..", "..", "config", "geocoder_stub.json"))
+	require.NoError(t, err)
+	city, _, err := geocoder.Geocode(55.76, 37.68)
+	require.NoError(t, err)
+	require.Equal(t, "Москва", city)
+
+	path := filepath.Join(t.TempDir(), "broken.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = NewGeocoderFromFile(path)
+	require.Error(t, err)
+}
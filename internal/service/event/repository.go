@@ -2,6 +2,7 @@ package event
 
 import (
 	models "backend/internal/models"
+	"time"
 )
 
 type Repository interface {
@@ -9,16 +10,42 @@ type Repository interface {
 	UpdateEvent(e *models.Event, userId string) error
 	DeleteEvent(eventId string, userId string) error
 	//
+	CreateSeries(e *models.Event, occurrences []time.Time) (string, error)
+	GetSeries(eventId string) (*models.Series, error)
+	UpdateSeries(e *models.Event, userId string, occurrences []time.Time) error
+	DeleteSeries(eventId string, userId string) error
+	//
 	GetEventById(eventId string) (*models.Event, error)
-	GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error)
-	GetCreatedEvents(authorId string) ([]*models.Event, error)
-	GetVisitedEvents(userId string) ([]*models.Event, error)
+	GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error)
+	GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error)
+	GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error)
+	GetEventsMap(bbox *models.BBox, cellSize float64) (*models.EventMap, error)
+	//
+	Visit(eventId string, userId string) (int, error)
+	Unvisit(eventId string, userId string) (string, error)
+	IsVisited(eventId string, userId string) (bool, int, error)
+	SetRSVP(eventId string, userId string, status string) (string, error)
+	GetRSVP(eventId string, userId string) (*models.RSVP, error)
+	//
+	CreateInvitations(eventId string, inviterId string, receiverIds []string) error
+	RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error)
+	GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error)
+	GetUserInvitations(receiverId string) ([]*models.Invitation, error)
 	//
-	Visit(eventId string, userId string) error
-	Unvisit(eventId string, userId string) error
-	IsVisited(eventId string, userId string) (bool, error)
+	GetCalendarEvents(userId string) ([]*models.Event, error)
+	GetCalendarToken(userId string) (string, error)
+	SetCalendarToken(userId string, token string) error
 	//
 	GetCities() ([]string, error)
 	//
 	EmailNotify(eventId string) ([]*models.Info, error)
 }
+
+// SeriesRepository is used by ExtendSeries in the event microservice, it is not available over gRPC
+type SeriesRepository interface {
+	// GetOpenSeries returns the series without COUNT and UNTIL
+	GetOpenSeries() ([]*models.Series, error)
+	// GetLastOccurrence returns the latest occurrence that was not edited separately
+	GetLastOccurrence(seriesId string) (*models.Event, error)
+	ExtendSeries(e *models.Event, occurrences []time.Time) error
+}
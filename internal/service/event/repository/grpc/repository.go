@@ -4,10 +4,13 @@ import (
 	eventGrpc "backend/internal/microservice/event/proto"
 	models "backend/internal/models"
 	"context"
+	"time"
 )
 
 //const logMessage = "service:event:repository:grpc:"
 
+const dateLayout = "2006-01-02"
+
 type Repository struct {
 	client eventGrpc.EventServiceClient
 }
@@ -18,8 +21,50 @@ func NewRepository(client eventGrpc.EventServiceClient) *Repository {
 	}
 }
 
-func (s *Repository) CreateEvent(e *models.Event) (string, error) {
-	in := &eventGrpc.Event{
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func parseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func formatDates(dates []time.Time) []string {
+	result := make([]string, len(dates))
+	for i, d := range dates {
+		result[i] = d.Format(dateLayout)
+	}
+	return result
+}
+
+func parseDates(dates []string) []time.Time {
+	var result []time.Time
+	for _, d := range dates {
+		t, err := time.Parse(dateLayout, d)
+		if err == nil {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func formatTimes(times []time.Time) []string {
+	result := make([]string, len(times))
+	for i, t := range times {
+		result[i] = formatTime(t)
+	}
+	return result
+}
+
+func makeProtoEvent(e *models.Event) *eventGrpc.Event {
+	return &eventGrpc.Event{
 		ID:          e.ID,
 		Title:       e.Title,
 		Description: e.Description,
@@ -29,34 +74,30 @@ func (s *Repository) CreateEvent(e *models.Event) (string, error) {
 		Viewed:      int32(e.Viewed),
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
+		StartDate:   formatTime(e.StartDate),
+		EndDate:     formatTime(e.EndDate),
+		TimeZone:    e.TimeZone,
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
 		Address:     e.Address,
 		AuthorId:    e.AuthorId,
+		Capacity:    int32(e.Capacity),
+		SeriesId:    e.SeriesId,
+		RRule:       e.RRule,
+		ExDates:     formatDates(e.ExDates),
 	}
+}
+
+func (s *Repository) CreateEvent(e *models.Event) (string, error) {
+	in := makeProtoEvent(e)
 	out, err := s.client.CreateEvent(context.Background(), in)
 	eventId := out.ID
 	return eventId, err
 }
 
 func (s *Repository) UpdateEvent(e *models.Event, userId string) error {
-	protoEvent := &eventGrpc.Event{
-		ID:          e.ID,
-		Title:       e.Title,
-		Description: e.Description,
-		Text:        e.Text,
-		City:        e.City,
-		Category:    e.Category,
-		Viewed:      int32(e.Viewed),
-		ImgUrl:      e.ImgUrl,
-		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
-		Address:     e.Address,
-		AuthorId:    e.AuthorId,
-	}
 	in := &eventGrpc.UpdateEventRequest{
-		Event:  protoEvent,
+		Event:  makeProtoEvent(e),
 		UserId: userId,
 	}
 	out, err := s.client.UpdateEvent(context.Background(), in)
@@ -64,6 +105,54 @@ func (s *Repository) UpdateEvent(e *models.Event, userId string) error {
 	return err
 }
 
+func (s *Repository) CreateSeries(e *models.Event, occurrences []time.Time) (string, error) {
+	in := &eventGrpc.CreateSeriesRequest{
+		Event:       makeProtoEvent(e),
+		Occurrences: formatTimes(occurrences),
+	}
+	out, err := s.client.CreateSeries(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
+
+func (s *Repository) GetSeries(eventId string) (*models.Series, error) {
+	in := &eventGrpc.EventId{
+		ID: eventId,
+	}
+	out, err := s.client.GetSeries(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Series{
+		ID:       out.ID,
+		AuthorId: out.AuthorId,
+		RRule:    out.RRule,
+		ExDates:  parseDates(out.ExDates),
+		Start:    parseTime(out.Start),
+	}, nil
+}
+
+func (s *Repository) UpdateSeries(e *models.Event, userId string, occurrences []time.Time) error {
+	in := &eventGrpc.UpdateSeriesRequest{
+		Event:       makeProtoEvent(e),
+		UserId:      userId,
+		Occurrences: formatTimes(occurrences),
+	}
+	_, err := s.client.UpdateSeries(context.Background(), in)
+	return err
+}
+
+func (s *Repository) DeleteSeries(eventId string, userId string) error {
+	in := &eventGrpc.DeleteEventRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	_, err := s.client.DeleteSeries(context.Background(), in)
+	return err
+}
+
 func (s *Repository) DeleteEvent(eventId string, userId string) error {
 	in := &eventGrpc.DeleteEventRequest{
 		EventId: eventId,
@@ -83,144 +172,316 @@ func (s *Repository) GetEventById(eventId string) (*models.Event, error) {
 		return nil, err
 	}
 	result := &models.Event{
-		ID:          out.ID,
-		Title:       out.Title,
-		Description: out.Description,
-		Text:        out.Text,
-		City:        out.City,
-		Category:    out.Category,
-		Viewed:      int(out.Viewed),
-		ImgUrl:      out.ImgUrl,
-		Tag:         out.Tag,
-		Date:        out.Date,
-		Geo:         out.Geo,
-		Address:     out.Address,
-		AuthorId:    out.AuthorId,
+		ID:               out.ID,
+		Title:            out.Title,
+		Description:      out.Description,
+		Text:             out.Text,
+		City:             out.City,
+		Category:         out.Category,
+		Viewed:           int(out.Viewed),
+		ImgUrl:           out.ImgUrl,
+		Tag:              out.Tag,
+		StartDate:        parseTime(out.StartDate),
+		EndDate:          parseTime(out.EndDate),
+		TimeZone:         out.TimeZone,
+		Latitude:         out.Latitude,
+		Longitude:        out.Longitude,
+		Address:          out.Address,
+		AuthorId:         out.AuthorId,
+		Capacity:         int(out.Capacity),
+		SeatsLeft:        int(out.SeatsLeft),
+		WaitlistPosition: int(out.WaitlistPosition),
+		SeriesId:         out.SeriesId,
+		RRule:            out.RRule,
+		ExDates:          parseDates(out.ExDates),
+		UpdatedAt:        parseTime(out.UpdatedAt),
 	}
 	return result, err
 }
 
-func (s *Repository) GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
+func makeModelEvents(out *eventGrpc.Events) []*models.Event {
+	result := make([]*models.Event, len(out.Events))
+	for i, protoEvent := range out.Events {
+		result[i] = &models.Event{
+			ID:               protoEvent.ID,
+			Title:            protoEvent.Title,
+			Description:      protoEvent.Description,
+			Text:             protoEvent.Text,
+			City:             protoEvent.City,
+			Category:         protoEvent.Category,
+			Viewed:           int(protoEvent.Viewed),
+			ImgUrl:           protoEvent.ImgUrl,
+			Tag:              protoEvent.Tag,
+			StartDate:        parseTime(protoEvent.StartDate),
+			EndDate:          parseTime(protoEvent.EndDate),
+			TimeZone:         protoEvent.TimeZone,
+			Latitude:         protoEvent.Latitude,
+			Longitude:        protoEvent.Longitude,
+			Address:          protoEvent.Address,
+			AuthorId:         protoEvent.AuthorId,
+			IsVisited:        protoEvent.IsVisited,
+			Snippet:          protoEvent.Snippet,
+			Capacity:         int(protoEvent.Capacity),
+			SeatsLeft:        int(protoEvent.SeatsLeft),
+			WaitlistPosition: int(protoEvent.WaitlistPosition),
+			SeriesId:         protoEvent.SeriesId,
+			UpdatedAt:        parseTime(protoEvent.UpdatedAt),
+		}
+	}
+	return result
+}
+
+func makeUserEventsRequest(userId string, page *models.Page) *eventGrpc.GetUserEventsRequest {
+	in := &eventGrpc.GetUserEventsRequest{
+		UserId: userId,
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
+	return in
+}
+
+func (s *Repository) GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error) {
 	in := &eventGrpc.GetEventsRequest{
 		UserId:   userId,
 		Title:    title,
 		Category: category,
 		City:     city,
-		Date:     date,
+		From:     formatTime(from),
+		To:       formatTime(to),
 		Tags:     tags,
 	}
+	if near != nil {
+		in.Near = &eventGrpc.GeoCircle{
+			Latitude:  near.Latitude,
+			Longitude: near.Longitude,
+			RadiusKm:  near.RadiusKm,
+		}
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
 	out, err := s.client.GetEvents(context.Background(), in)
 	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = &models.Event{
-			ID:          protoEvent.ID,
-			Title:       protoEvent.Title,
-			Description: protoEvent.Description,
-			Text:        protoEvent.Text,
-			City:        protoEvent.City,
-			Category:    protoEvent.Category,
-			Viewed:      int(protoEvent.Viewed),
-			ImgUrl:      protoEvent.ImgUrl,
-			Tag:         protoEvent.Tag,
-			Date:        protoEvent.Date,
-			Geo:         protoEvent.Geo,
-			Address:     protoEvent.Address,
-			AuthorId:    protoEvent.AuthorId,
-			IsVisited:   protoEvent.IsVisited,
-		}
+		return nil, "", err
 	}
-	return result, err
+	return makeModelEvents(out), out.NextCursor, err
 }
 
-func (s *Repository) GetVisitedEvents(userId string) ([]*models.Event, error) {
-	in := &eventGrpc.UserId{
-		ID: userId,
-	}
+func (s *Repository) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
+	in := makeUserEventsRequest(userId, page)
 	out, err := s.client.GetVisitedEvents(context.Background(), in)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = &models.Event{
-			ID:          protoEvent.ID,
-			Title:       protoEvent.Title,
-			Description: protoEvent.Description,
-			Text:        protoEvent.Text,
-			City:        protoEvent.City,
-			Category:    protoEvent.Category,
-			Viewed:      int(protoEvent.Viewed),
-			ImgUrl:      protoEvent.ImgUrl,
-			Tag:         protoEvent.Tag,
-			Date:        protoEvent.Date,
-			Geo:         protoEvent.Geo,
-			Address:     protoEvent.Address,
-			AuthorId:    protoEvent.AuthorId,
-		}
+	return makeModelEvents(out), out.NextCursor, err
+}
+
+func (s *Repository) GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error) {
+	in := makeUserEventsRequest(authorId, page)
+	out, err := s.client.GetCreatedEvents(context.Background(), in)
+	if err != nil {
+		return nil, "", err
 	}
-	return result, err
+	return makeModelEvents(out), out.NextCursor, err
 }
 
-func (s *Repository) GetCreatedEvents(authorId string) ([]*models.Event, error) {
-	in := &eventGrpc.UserId{
-		ID: authorId,
+func (s *Repository) GetEventsMap(bbox *models.BBox, cellSize float64) (*models.EventMap, error) {
+	in := &eventGrpc.GetEventsMapRequest{
+		MinLatitude:  bbox.MinLatitude,
+		MinLongitude: bbox.MinLongitude,
+		MaxLatitude:  bbox.MaxLatitude,
+		MaxLongitude: bbox.MaxLongitude,
+		CellSize:     cellSize,
 	}
-	out, err := s.client.GetCreatedEvents(context.Background(), in)
+	out, err := s.client.GetEventsMap(context.Background(), in)
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = &models.Event{
-			ID:          protoEvent.ID,
-			Title:       protoEvent.Title,
-			Description: protoEvent.Description,
-			Text:        protoEvent.Text,
-			City:        protoEvent.City,
-			Category:    protoEvent.Category,
-			Viewed:      int(protoEvent.Viewed),
-			ImgUrl:      protoEvent.ImgUrl,
-			Tag:         protoEvent.Tag,
-			Date:        protoEvent.Date,
-			Geo:         protoEvent.Geo,
-			Address:     protoEvent.Address,
-			AuthorId:    protoEvent.AuthorId,
+	result := &models.EventMap{
+		Pins:     make([]*models.MapPin, len(out.Pins)),
+		Clusters: make([]*models.MapCluster, len(out.Clusters)),
+	}
+	for i, protoPin := range out.Pins {
+		result.Pins[i] = &models.MapPin{
+			EventId:   protoPin.EventId,
+			Title:     protoPin.Title,
+			Category:  protoPin.Category,
+			Latitude:  protoPin.Latitude,
+			Longitude: protoPin.Longitude,
 		}
 	}
-	return result, err
+	for i, protoCluster := range out.Clusters {
+		result.Clusters[i] = &models.MapCluster{
+			Latitude:  protoCluster.Latitude,
+			Longitude: protoCluster.Longitude,
+			Count:     int(protoCluster.Count),
+		}
+	}
+	return result, nil
 }
 
-func (s *Repository) Visit(eventId string, userId string) error {
+func (s *Repository) Visit(eventId string, userId string) (int, error) {
 	in := &eventGrpc.VisitRequest{
 		EventId: eventId,
 		UserId:  userId,
 	}
 	out, err := s.client.Visit(context.Background(), in)
-	_ = out
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return int(out.WaitlistPosition), nil
 }
 
-func (s *Repository) Unvisit(eventId string, userId string) error {
+func (s *Repository) Unvisit(eventId string, userId string) (string, error) {
 	in := &eventGrpc.VisitRequest{
 		EventId: eventId,
 		UserId:  userId,
 	}
 	out, err := s.client.Unvisit(context.Background(), in)
-	_ = out
-	return err
+	if err != nil {
+		return "", err
+	}
+	return out.PromotedUserId, nil
 }
 
-func (s *Repository) IsVisited(eventId string, userId string) (bool, error) {
+func (s *Repository) IsVisited(eventId string, userId string) (bool, int, error) {
 	in := &eventGrpc.VisitRequest{
 		EventId: eventId,
 		UserId:  userId,
 	}
 	out, err := s.client.IsVisited(context.Background(), in)
-	result := out.Result
-	return result, err
+	if err != nil {
+		return false, 0, err
+	}
+	return out.Result, int(out.WaitlistPosition), nil
+}
+
+func (s *Repository) SetRSVP(eventId string, userId string, status string) (string, error) {
+	in := &eventGrpc.SetRSVPRequest{
+		EventId: eventId,
+		UserId:  userId,
+		Status:  status,
+	}
+	out, err := s.client.SetRSVP(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	return out.PromotedUserId, nil
+}
+
+func (s *Repository) GetRSVP(eventId string, userId string) (*models.RSVP, error) {
+	in := &eventGrpc.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := s.client.GetRSVP(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &models.RSVP{
+		Status:           out.Status,
+		WaitlistPosition: int(out.WaitlistPosition),
+	}, nil
+}
+
+func makeModelInvitation(in *eventGrpc.Invitation) *models.Invitation {
+	return &models.Invitation{
+		ID:         in.ID,
+		EventId:    in.EventId,
+		EventTitle: in.EventTitle,
+		InviterId:  in.InviterId,
+		ReceiverId: in.ReceiverId,
+		Status:     in.Status,
+		CreatedAt:  parseTime(in.CreatedAt),
+	}
+}
+
+func makeModelInvitations(out *eventGrpc.Invitations) []*models.Invitation {
+	result := make([]*models.Invitation, len(out.Invitations))
+	for i, protoInvitation := range out.Invitations {
+		result[i] = makeModelInvitation(protoInvitation)
+	}
+	return result
+}
+
+func (s *Repository) CreateInvitations(eventId string, inviterId string, receiverIds []string) error {
+	in := &eventGrpc.CreateInvitationsRequest{
+		EventId:     eventId,
+		InviterId:   inviterId,
+		ReceiverIds: receiverIds,
+	}
+	_, err := s.client.CreateInvitations(context.Background(), in)
+	return err
+}
+
+func (s *Repository) RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error) {
+	in := &eventGrpc.RespondInvitationRequest{
+		InvitationId: invitationId,
+		ReceiverId:   receiverId,
+		Status:       status,
+	}
+	out, err := s.client.RespondInvitation(context.Background(), in)
+	if err != nil {
+		return nil, 0, err
+	}
+	return makeModelInvitation(out), int(out.WaitlistPosition), nil
+}
+
+func (s *Repository) GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error) {
+	in := &eventGrpc.GetInvitationsRequest{
+		EventId: eventId,
+		UserId:  inviterId,
+	}
+	out, err := s.client.GetEventInvitations(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelInvitations(out), nil
+}
+
+func (s *Repository) GetUserInvitations(receiverId string) ([]*models.Invitation, error) {
+	in := &eventGrpc.GetInvitationsRequest{
+		UserId: receiverId,
+	}
+	out, err := s.client.GetUserInvitations(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelInvitations(out), nil
+}
+
+func (s *Repository) GetCalendarEvents(userId string) ([]*models.Event, error) {
+	in := &eventGrpc.UserId{
+		ID: userId,
+	}
+	out, err := s.client.GetCalendarEvents(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelEvents(out), nil
+}
+
+func (s *Repository) GetCalendarToken(userId string) (string, error) {
+	in := &eventGrpc.UserId{
+		ID: userId,
+	}
+	out, err := s.client.GetCalendarToken(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	return out.Token, nil
+}
+
+func (s *Repository) SetCalendarToken(userId string, token string) error {
+	in := &eventGrpc.CalendarToken{
+		UserId: userId,
+		Token:  token,
+	}
+	_, err := s.client.SetCalendarToken(context.Background(), in)
+	return err
 }
 
 func (s *Repository) GetCities() ([]string, error) {
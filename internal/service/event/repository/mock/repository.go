@@ -3,6 +3,7 @@ package mock
 import (
 	"backend/internal/models"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type RepositoryMock struct {
@@ -24,39 +25,109 @@ func (m *RepositoryMock) DeleteEvent(eventId string, userId string) error {
 	return args.Error(0)
 }
 
+func (m *RepositoryMock) CreateSeries(e *models.Event, occurrences []time.Time) (string, error) {
+	args := m.Called(e, occurrences)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *RepositoryMock) GetSeries(eventId string) (*models.Series, error) {
+	args := m.Called(eventId)
+	return args.Get(0).(*models.Series), args.Error(1)
+}
+
+func (m *RepositoryMock) UpdateSeries(e *models.Event, userId string, occurrences []time.Time) error {
+	args := m.Called(e, userId, occurrences)
+	return args.Error(0)
+}
+
+func (m *RepositoryMock) DeleteSeries(eventId string, userId string) error {
+	args := m.Called(eventId, userId)
+	return args.Error(0)
+}
+
 func (m *RepositoryMock) GetEventById(eventId string) (*models.Event, error) {
 	args := m.Called(eventId)
 	return args.Get(0).(*models.Event), args.Error(1)
 }
 
-func (m *RepositoryMock) GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
-	args := m.Called(userId, title, category, city, date, tags)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *RepositoryMock) GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(userId, title, category, city, from, to, tags, near, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
 }
 
-func (m *RepositoryMock) GetCreatedEvents(authorId string) ([]*models.Event, error) {
-	args := m.Called(authorId)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *RepositoryMock) GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(authorId, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
 }
 
-func (m *RepositoryMock) GetVisitedEvents(userId string) ([]*models.Event, error) {
-	args := m.Called(userId)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *RepositoryMock) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(userId, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
+}
+
+func (m *RepositoryMock) GetEventsMap(bbox *models.BBox, cellSize float64) (*models.EventMap, error) {
+	args := m.Called(bbox, cellSize)
+	return args.Get(0).(*models.EventMap), args.Error(1)
 }
 
-func (m *RepositoryMock) Visit(eventId string, userId string) error {
+func (m *RepositoryMock) Visit(eventId string, userId string) (int, error) {
 	args := m.Called(eventId, userId)
-	return args.Error(0)
+	return args.Get(0).(int), args.Error(1)
 }
 
-func (m *RepositoryMock) Unvisit(eventId string, userId string) error {
+func (m *RepositoryMock) Unvisit(eventId string, userId string) (string, error) {
 	args := m.Called(eventId, userId)
-	return args.Error(0)
+	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *RepositoryMock) IsVisited(eventId string, userId string) (bool, error) {
+func (m *RepositoryMock) IsVisited(eventId string, userId string) (bool, int, error) {
 	args := m.Called(eventId, userId)
-	return args.Get(0).(bool), args.Error(1)
+	return args.Get(0).(bool), args.Get(1).(int), args.Error(2)
+}
+
+func (m *RepositoryMock) SetRSVP(eventId string, userId string, status string) (string, error) {
+	args := m.Called(eventId, userId, status)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *RepositoryMock) GetRSVP(eventId string, userId string) (*models.RSVP, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.RSVP), args.Error(1)
+}
+
+func (m *RepositoryMock) CreateInvitations(eventId string, inviterId string, receiverIds []string) error {
+	args := m.Called(eventId, inviterId, receiverIds)
+	return args.Error(0)
+}
+
+func (m *RepositoryMock) RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error) {
+	args := m.Called(invitationId, receiverId, status)
+	return args.Get(0).(*models.Invitation), args.Get(1).(int), args.Error(2)
+}
+
+func (m *RepositoryMock) GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error) {
+	args := m.Called(eventId, inviterId)
+	return args.Get(0).([]*models.Invitation), args.Error(1)
+}
+
+func (m *RepositoryMock) GetUserInvitations(receiverId string) ([]*models.Invitation, error) {
+	args := m.Called(receiverId)
+	return args.Get(0).([]*models.Invitation), args.Error(1)
+}
+
+func (m *RepositoryMock) GetCalendarEvents(userId string) ([]*models.Event, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*models.Event), args.Error(1)
+}
+
+func (m *RepositoryMock) GetCalendarToken(userId string) (string, error) {
+	args := m.Called(userId)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *RepositoryMock) SetCalendarToken(userId string, token string) error {
+	args := m.Called(userId, token)
+	return args.Error(0)
 }
 
 func (m *RepositoryMock) GetCities() ([]string, error) {
@@ -68,3 +139,18 @@ func (m *RepositoryMock) EmailNotify(eventId string) ([]*models.Info, error) {
 	args := m.Called(eventId)
 	return args.Get(0).([]*models.Info), args.Error(1)
 }
+
+func (m *RepositoryMock) GetOpenSeries() ([]*models.Series, error) {
+	args := m.Called()
+	return args.Get(0).([]*models.Series), args.Error(1)
+}
+
+func (m *RepositoryMock) GetLastOccurrence(seriesId string) (*models.Event, error) {
+	args := m.Called(seriesId)
+	return args.Get(0).(*models.Event), args.Error(1)
+}
+
+func (m *RepositoryMock) ExtendSeries(e *models.Event, occurrences []time.Time) error {
+	args := m.Called(e, occurrences)
+	return args.Error(0)
+}
@@ -3,25 +3,45 @@ package postgres
 import (
 	"backend/internal/models"
 	error2 "backend/internal/service/event/error"
+	sql2 "database/sql"
+	"encoding/base64"
+	"fmt"
 	"github.com/lib/pq"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Event struct {
-	ID          int            `db:"id"`
-	Title       string         `db:"title"`
-	Description string         `db:"description"`
-	Text        string         `db:"text"`
-	City        string         `db:"city"`
-	Category    string         `db:"category"`
-	Viewed      int            `db:"viewed"`
-	ImgUrl      string         `db:"img_url"`
-	Tag         pq.StringArray `db:"tag"`
-	Date        string         `db:"date"`
-	Geo         string         `db:"geo"`
-	Address     string         `db:"address"`
-	AuthorID    int            `db:"author_id"`
-	IsVisited   int            `db:"count"`
+	ID             int            `db:"id"`
+	Title          string         `db:"title"`
+	Description    string         `db:"description"`
+	Text           string         `db:"text"`
+	City           string         `db:"city"`
+	Category       string         `db:"category"`
+	Viewed         int            `db:"viewed"`
+	ImgUrl         string         `db:"img_url"`
+	Tag            pq.StringArray `db:"tag"`
+	StartDate      time.Time      `db:"start_date"`
+	EndDate        time.Time      `db:"end_date"`
+	TimeZone       string         `db:"timezone"`
+	Latitude       float64        `db:"latitude"`
+	Longitude      float64        `db:"longitude"`
+	Address        string         `db:"address"`
+	AuthorID       int            `db:"author_id"`
+	IsVisited      int            `db:"count"`
+	Rank           float64        `db:"rank"`
+	Snippet        string         `db:"snippet"`
+	Capacity       int            `db:"capacity"`
+	Visitors       int            `db:"visitors"`
+	Waitlisted     int            `db:"waitlist_position"`
+	SeriesID       sql2.NullInt64 `db:"series_id"`
+	OccurrenceDate sql2.NullTime  `db:"occurrence_date"`
+	Detached       bool           `db:"detached"`
+	RRule          string         `db:"rrule"`
+	ExDates        pq.StringArray `db:"exdates"`
+	UpdatedAt      time.Time      `db:"updated_at"`
 }
 
 func toPostgresEvent(e *models.Event) (*Event, error) {
@@ -44,10 +64,14 @@ func toPostgresEvent(e *models.Event) (*Event, error) {
 		Viewed:      e.Viewed,
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
+		StartDate:   e.StartDate,
+		EndDate:     e.EndDate,
+		TimeZone:    e.TimeZone,
+		Latitude:    e.Latitude,
+		Longitude:   e.Longitude,
 		Address:     e.Address,
 		AuthorID:    authorIdInt,
+		Capacity:    e.Capacity,
 	}, nil
 }
 
@@ -56,20 +80,234 @@ func toModelEvent(e *Event) *models.Event {
 	if e.IsVisited > 0 {
 		isVisited = true
 	}
+	seatsLeft := 0
+	if e.Capacity > e.Visitors {
+		seatsLeft = e.Capacity - e.Visitors
+	}
+	seriesId := ""
+	if e.SeriesID.Valid {
+		seriesId = strconv.FormatInt(e.SeriesID.Int64, 10)
+	}
 	return &models.Event{
-		ID:          strconv.Itoa(e.ID),
-		Title:       e.Title,
-		Description: e.Description,
-		Text:        e.Text,
-		City:        e.City,
-		Category:    e.Category,
-		Viewed:      e.Viewed,
-		ImgUrl:      e.ImgUrl,
-		Tag:         e.Tag,
-		Date:        e.Date,
-		Geo:         e.Geo,
-		Address:     e.Address,
-		AuthorId:    strconv.Itoa(e.AuthorID),
-		IsVisited:   isVisited,
+		ID:               strconv.Itoa(e.ID),
+		Title:            e.Title,
+		Description:      e.Description,
+		Text:             e.Text,
+		City:             e.City,
+		Category:         e.Category,
+		Viewed:           e.Viewed,
+		ImgUrl:           e.ImgUrl,
+		Tag:              e.Tag,
+		StartDate:        e.StartDate,
+		EndDate:          e.EndDate,
+		TimeZone:         e.TimeZone,
+		Latitude:         e.Latitude,
+		Longitude:        e.Longitude,
+		Address:          e.Address,
+		AuthorId:         strconv.Itoa(e.AuthorID),
+		IsVisited:        isVisited,
+		Snippet:          e.Snippet,
+		Capacity:         e.Capacity,
+		SeatsLeft:        seatsLeft,
+		WaitlistPosition: e.Waitlisted,
+		SeriesId:         seriesId,
+		RRule:            e.RRule,
+		ExDates:          parseDates(e.ExDates),
+		UpdatedAt:        e.UpdatedAt,
+	}
+}
+
+const dateLayout = "2006-01-02"
+
+func parseDates(dates []string) []time.Time {
+	var result []time.Time
+	for _, d := range dates {
+		t, err := time.Parse(dateLayout, d)
+		if err == nil {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func formatDates(dates []time.Time) pq.StringArray {
+	result := make(pq.StringArray, len(dates))
+	for i, d := range dates {
+		result[i] = d.Format(dateLayout)
+	}
+	return result
+}
+
+type Series struct {
+	ID       int            `db:"id"`
+	AuthorID int            `db:"author_id"`
+	RRule    string         `db:"rrule"`
+	ExDates  pq.StringArray `db:"exdates"`
+	Start    sql2.NullTime  `db:"dtstart"`
+}
+
+func toModelSeries(s *Series) *models.Series {
+	return &models.Series{
+		ID:       strconv.Itoa(s.ID),
+		AuthorId: strconv.Itoa(s.AuthorID),
+		RRule:    s.RRule,
+		ExDates:  parseDates(s.ExDates),
+		Start:    s.Start.Time,
+	}
+}
+
+const (
+	visitorsColumn = `(select count(*) from "visitor" as vc where vc.event_id = e.id and vc.status = 'going') as visitors`
+	//Position of the user $1 in the waitlist of the event, zero if the user is not waiting
+	waitlistPositionColumn = `coalesce((select w.position from (select user_id, row_number() over (order by id) as position
+		from "waitlist" as wl where wl.event_id = e.id) as w where w.user_id = $1), 0) as waitlist_position`
+)
+
+const (
+	defaultPageLimit  = 20
+	maxPageLimit      = 100
+	maxMapPins        = 500
+	maxCalendarEvents = 500
+)
+
+// Events are ordered by (rank, viewed, id) descending, cursor points to the last event of the previous page.
+// Rank is non-zero only for full-text search results
+type cursor struct {
+	Rank   float64
+	Viewed int
+	ID     int
+}
+
+func encodeCursor(e *Event) string {
+	raw := fmt.Sprintf("%d:%d", e.Viewed, e.ID)
+	if e.Rank != 0 {
+		raw += ":" + strconv.FormatFloat(e.Rank, 'g', -1, 64)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(encoded string) (*cursor, error) {
+	if encoded == "" {
+		return &cursor{
+			Rank:   math.MaxFloat32,
+			Viewed: math.MaxInt64,
+			ID:     math.MaxInt32,
+		}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, error2.ErrCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, error2.ErrCursor
+	}
+	c := &cursor{}
+	c.Viewed, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, error2.ErrCursor
+	}
+	c.ID, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, error2.ErrCursor
+	}
+	if len(parts) == 3 {
+		c.Rank, err = strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return nil, error2.ErrCursor
+		}
+	}
+	return c, nil
+}
+
+func pageLimit(page *models.Page) int {
+	if page == nil || page.Limit <= 0 {
+		return defaultPageLimit
+	}
+	if page.Limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return page.Limit
+}
+
+func pageCursor(page *models.Page) (*cursor, error) {
+	if page == nil {
+		return decodeCursor("")
+	}
+	return decodeCursor(page.Cursor)
+}
+
+const (
+	searchVector   = `event_search_vector(e.title, e.description, e.text, e.tag)`
+	searchQuery    = `websearch_to_tsquery('russian', $2)`
+	headlineFormat = `StartSel=<b>, StopSel=</b>, MaxWords=25, MinWords=10, MaxFragments=2`
+	//Postgres regex metacharacters, such queries used to break the search
+	regexMetacharacters = `\.+*?()|[]{}^$`
+)
+
+// Full-text search loses punctuation, so queries with metacharacters are matched literally against the title
+func isLiteralSearch(title string) bool {
+	return strings.ContainsAny(title, regexMetacharacters)
+}
+
+// Returns the where condition, the rank and the snippet expressions for the title query
+func searchExpressions(title string) (string, string, string) {
+	if title == "" {
+		return `$2 = $2`, `0::real`, `''`
+	}
+	if isLiteralSearch(title) {
+		return `strpos(lower(e.title), lower($2)) > 0`, `0::real`, `''`
+	}
+	condition := searchVector + ` @@ ` + searchQuery
+	rank := `ts_rank(` + searchVector + `, ` + searchQuery + `)`
+	snippet := `ts_headline('russian', e.description || ' ' || e.text, ` + searchQuery + `, '` + headlineFormat + `')`
+	return condition, rank, snippet
+}
+
+// Haversine distance in km (Earth radius is 6371 km), one degree of latitude is about 111.2 km.
+// Latitude range is checked first so that the coordinates index can be used
+const radiusCondition = `e.latitude between $8 - $10 / 111.2 and $8 + $10 / 111.2 and
+		2 * 6371 * asin(sqrt(power(sin(radians(e.latitude - $8) / 2), 2) +
+		cos(radians($8)) * cos(radians(e.latitude)) * power(sin(radians(e.longitude - $9) / 2), 2))) <= $10`
+
+func nearCondition(near *models.GeoCircle) (string, []interface{}) {
+	if near == nil {
+		return `$8 = $8 and $9 = $9 and $10 = $10`, []interface{}{0.0, 0.0, 0.0}
+	}
+	return radiusCondition, []interface{}{near.Latitude, near.Longitude, near.RadiusKm}
+}
+
+// Map point is either a single event or a cluster of events, depending on count
+type mapPoint struct {
+	ID        int     `db:"id"`
+	Title     string  `db:"title"`
+	Category  string  `db:"category"`
+	Latitude  float64 `db:"latitude"`
+	Longitude float64 `db:"longitude"`
+	Count     int     `db:"count"`
+}
+
+func toModelEventMap(points []mapPoint) *models.EventMap {
+	result := &models.EventMap{
+		Pins:     []*models.MapPin{},
+		Clusters: []*models.MapCluster{},
+	}
+	for _, p := range points {
+		if p.Count > 1 {
+			result.Clusters = append(result.Clusters, &models.MapCluster{
+				Latitude:  p.Latitude,
+				Longitude: p.Longitude,
+				Count:     p.Count,
+			})
+			continue
+		}
+		result.Pins = append(result.Pins, &models.MapPin{
+			EventId:   strconv.Itoa(p.ID),
+			Title:     p.Title,
+			Category:  p.Category,
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+		})
 	}
+	return result
 }
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"backend/internal/models"
+	"strconv"
+	"time"
+)
+
+type Invitation struct {
+	ID         int       `db:"id"`
+	EventID    int       `db:"event_id"`
+	EventTitle string    `db:"title"`
+	InviterID  int       `db:"inviter_id"`
+	ReceiverID int       `db:"receiver_id"`
+	Status     string    `db:"status"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func toModelInvitation(i *Invitation) *models.Invitation {
+	return &models.Invitation{
+		ID:         strconv.Itoa(i.ID),
+		EventId:    strconv.Itoa(i.EventID),
+		EventTitle: i.EventTitle,
+		InviterId:  strconv.Itoa(i.InviterID),
+		ReceiverId: strconv.Itoa(i.ReceiverID),
+		Status:     i.Status,
+		CreatedAt:  i.CreatedAt,
+	}
+}
+
+// Pending invitations expire when the event ends
+const invitationColumns = `i.id, i.event_id, e.title, i.inviter_id, i.receiver_id,
+		case when i.status = 'pending' and e.end_date < now() then 'expired' else i.status end as status, i.created_at`
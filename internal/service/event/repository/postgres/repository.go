@@ -6,6 +6,7 @@ import (
 	log "backend/pkg/logger"
 	sql2 "database/sql"
 	"strconv"
+	"time"
 
 	sql "github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
@@ -23,42 +24,124 @@ func NewRepository(database *sql.DB) *Repository {
 
 const (
 	logMessage          = "service:event:repository:postgres:"
-	checkAuthorQuery    = `select author_id from "event" where id = $1`
+	checkAuthorQuery    = `select e.author_id, u.role from "event" as e, "user" as u where e.id = $1 and u.id = $2`
 	incrementEventViews = `update "event" set viewed = viewed + 1 where event.id = $1`
-	getEventQuery       = `select * from "event" where id = $1`
-	createEventQuery    = `insert into "event" 
-		(title, description, text, city, category, viewed, img_url, date, geo, address, tag, author_id) 
-		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11::varchar[], $12) 
+	getEventQuery       = `select e.*, coalesce(s.rrule, '') as rrule, coalesce(s.exdates, '{}') as exdates, ` + visitorsColumn + `
+		from "event" as e left join "series" as s on s.id = e.series_id where e.id = $1`
+	createEventQuery = `insert into "event" 
+		(title, description, text, city, category, viewed, img_url, start_date, end_date, timezone, latitude, longitude, address, tag, author_id, capacity) 
+		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14::varchar[], $15, $16) 
 		returning id`
 	updateEventQuery = `update "event" set
 		title = $1, description = $2, text = $3, city = $4, category = $5,
-		img_url = $6, date = $7, geo = $8, address = $9, tag = $10
-		where event.id = $11`
+		img_url = $6, start_date = $7, end_date = $8, timezone = $9, latitude = $10, longitude = $11, address = $12, tag = $13,
+		capacity = $14, detached = series_id is not null, updated_at = now()
+		where event.id = $15`
 	updateEventQueryWithoutImgUrl = `update "event" set
 		title = $1, description = $2, text = $3, city = $4, category = $5,
-		date = $6, geo = $7, address = $8, tag = $9 
-		where event.id = $10`
+		start_date = $6, end_date = $7, timezone = $8, latitude = $9, longitude = $10, address = $11, tag = $12,
+		capacity = $13, detached = series_id is not null, updated_at = now()
+		where event.id = $14`
 	deleteEventQuery = `delete from "event" where id = $1`
-	visitedQuery     = `select e.* from "event" as e join visitor as v on v.event_id = e.id where v.user_id = $1`
-	createdQuery     = `select * from "event" where author_id = $1`
-	visitQuery       = `insert into "visitor" (event_id, user_id) values ($1, $2)`
-	unvisitQuery     = `delete from "visitor" where event_id = $1 and user_id = $2`
-	isVisitedQuery   = `select count(*) from "visitor" where event_id = $1 and user_id = $2`
-	getCitiesQuery   = `select distinct city from event`
-	getSubsInfo      = `select u2.name, u2.mail, e.title, e.img_url from "user" as u1 join subscribe on u1.id = subscribe.subscribed_id
+	//Deleted occurrence must not come back when the series is updated
+	excludeOccurrenceQuery = `update "series" as s set exdates = array_append(s.exdates, e.occurrence_date)
+		from "event" as e where e.id = $1 and s.id = e.series_id`
+	createSeriesQuery = `insert into "series" (author_id, rrule, exdates, dtstart) values ($1, $2, $3::date[], $4) returning id`
+	//Occurrences edited separately are detached from the series and are not overwritten
+	createOccurrenceQuery = `insert into "event"
+		(title, description, text, city, category, viewed, img_url, start_date, end_date, timezone, latitude, longitude, address, tag, author_id, capacity,
+		series_id, occurrence_date)
+		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14::varchar[], $15, $16, $17, $18)
+		on conflict (series_id, occurrence_date) do update set
+		title = excluded.title, description = excluded.description, text = excluded.text, city = excluded.city,
+		category = excluded.category, img_url = coalesce(nullif(excluded.img_url, ''), event.img_url),
+		start_date = excluded.start_date, end_date = excluded.end_date, timezone = excluded.timezone,
+		latitude = excluded.latitude, longitude = excluded.longitude, address = excluded.address, tag = excluded.tag,
+		capacity = excluded.capacity, updated_at = now()
+		where not event.detached
+		returning id`
+	getSeriesQuery = `select s.id, s.author_id, s.rrule, s.exdates, s.dtstart from "series" as s
+		join "event" as e on e.series_id = s.id where e.id = $1`
+	updateSeriesQuery          = `update "series" set rrule = $1, exdates = $2::date[], dtstart = $4 where id = $3 returning author_id`
+	deleteStaleOccurrenceQuery = `delete from "event" where series_id = $1 and not detached and start_date >= now()
+		and occurrence_date <> all($2::date[])`
+	deleteSeriesQuery = `delete from "series" where id = $1`
+	//The rules are stored normalized, so COUNT and UNTIL are always in upper case
+	getOpenSeriesQuery = `select id, author_id, rrule, exdates from "series"
+		where rrule not like '%COUNT=%' and rrule not like '%UNTIL=%' order by id`
+	getLastOccurrenceQuery = `select e.* from "event" as e where e.series_id = $1 and not e.detached
+		order by e.start_date desc limit 1`
+	//Feed includes created and visited events that ended less than a month ago or have not ended yet
+	calendarEventsQuery = `select e.*, ` + visitorsColumn + ` from "event" as e
+		where (e.author_id = $1 or exists (select 1 from "visitor" as v where v.event_id = e.id and v.user_id = $1 and v.status = 'going'))
+		and e.end_date >= now() - interval '30 days' order by e.start_date limit $2`
+	getCalendarTokenQuery = `select token from "calendar_token" where user_id = $1`
+	setCalendarTokenQuery = `insert into "calendar_token" (user_id, token) values ($1, $2)
+		on conflict (user_id) do update set token = $2, created_at = now()`
+	visitedQuery = `select e.*, ` + visitorsColumn + ` from "event" as e join visitor as v on v.event_id = e.id and v.status = 'going' where v.user_id = $1
+		and (e.viewed, e.id) < ($2, $3) order by e.viewed desc, e.id desc limit $4`
+	createdQuery = `select e.*, ` + visitorsColumn + ` from "event" as e where e.author_id = $1
+		and (e.viewed, e.id) < ($2, $3) order by e.viewed desc, e.id desc limit $4`
+	visitQuery     = `insert into "visitor" (event_id, user_id) values ($1, $2) on conflict (event_id, user_id) do update set status = 'going'`
+	unvisitQuery   = `delete from "visitor" where event_id = $1 and user_id = $2`
+	isVisitedQuery = `select count(*) from "visitor" where event_id = $1 and user_id = $2 and status = 'going'`
+	getCitiesQuery = `select distinct city from event`
+	getSubsInfo    = `select u2.name, u2.mail, e.title, e.img_url from "user" as u1 join subscribe on u1.id = subscribe.subscribed_id
 							join "user" as u2 on u2.id = subscribe.subscriber_id 
 							join "event" as e on e.id = $1
 							where e.author_id = u1.id`
+	//Events without coordinates are stored at 0,0. The box crosses the antimeridian if $2 > $4
+	mapBBoxCondition = `not (latitude = 0 and longitude = 0) and latitude between $1 and $3
+		and (longitude between $2 and $4 or $2 > $4 and (longitude >= $2 or longitude <= $4))`
+	mapPinsQuery = `select id, title, category, latitude, longitude, 1 as count from "event"
+		where ` + mapBBoxCondition + `
+		order by viewed desc limit $5`
+	mapClustersQuery = `select min(id) as id, min(title) as title, min(category) as category,
+		avg(latitude) as latitude, avg(longitude) as longitude, count(*) as count from "event"
+		where ` + mapBBoxCondition + `
+		group by floor(latitude / $5), floor(longitude / $5)`
+	lockEventQuery        = `select capacity from "event" where id = $1 for update`
+	countVisitorsQuery    = `select count(*) from "visitor" where event_id = $1 and status = 'going'`
+	waitQuery             = `insert into "waitlist" (event_id, user_id) values ($1, $2) on conflict do nothing`
+	unwaitQuery           = `delete from "waitlist" where event_id = $1 and user_id = $2`
+	waitlistPositionQuery = `select position from (select user_id, row_number() over (order by id) as position
+		from "waitlist" where event_id = $1) as w where user_id = $2`
+	nextWaitlistedQuery = `delete from "waitlist" where id = (select id from "waitlist" where event_id = $1 order by id limit 1)
+		returning user_id`
+	//Null limit promotes the whole waitlist
+	promoteWaitlistedQuery = `with promoted as (delete from "waitlist" where id in
+		(select id from "waitlist" where event_id = $1 order by id limit $2) returning user_id)
+		insert into "visitor" (event_id, user_id) select $1, user_id from promoted
+		on conflict (event_id, user_id) do update set status = 'going'`
+	setRSVPQuery = `insert into "visitor" (event_id, user_id, status) values ($1, $2, $3)
+		on conflict (event_id, user_id) do update set status = $3`
+	getRSVPQuery = `select status from "visitor" where event_id = $1 and user_id = $2`
+	//Duplicate invitation doesn't return id
+	createInvitationQuery = `insert into "invitation" (event_id, inviter_id, receiver_id) values ($1, $2, $3)
+		on conflict (event_id, receiver_id) do nothing returning id`
+	respondInvitationQuery = `update "invitation" as i set status = $3, responded_at = now() from "event" as e
+		where i.id = $1 and i.receiver_id = $2 and e.id = i.event_id and i.status = 'pending' and e.end_date >= now()
+		returning ` + invitationColumns
+	getInvitationQuery = `select ` + invitationColumns + ` from "invitation" as i join "event" as e on e.id = i.event_id
+		where i.id = $1 and i.receiver_id = $2`
+	getEventInvitationsQuery = `select ` + invitationColumns + ` from "invitation" as i join "event" as e on e.id = i.event_id
+		where i.event_id = $1 and i.inviter_id = $2 order by i.id`
+	getUserInvitationsQuery = `select ` + invitationColumns + ` from "invitation" as i join "event" as e on e.id = i.event_id
+		where i.receiver_id = $1 order by i.id desc`
 )
 
+// checkAuthor also lets moderators through, they may edit and delete any event
 func (s *Repository) checkAuthor(eventId int, userId int) error {
-	var authorId int
+	var author struct {
+		AuthorId int    `db:"author_id"`
+		Role     string `db:"role"`
+	}
 	query := checkAuthorQuery
-	err := s.db.Get(&authorId, query, eventId)
+	err := s.db.Get(&author, query, eventId, userId)
 	if err != nil {
 		return error2.ErrPostgres
 	}
-	if authorId == userId {
+	if author.AuthorId == userId || models.Can(author.Role, models.PermissionModerateEvents) {
 		return nil
 	} else {
 		return error2.ErrNotAllowed
@@ -83,11 +166,15 @@ func (s *Repository) CreateEvent(e *models.Event) (string, error) {
 		newEvent.Category,
 		newEvent.Viewed,
 		newEvent.ImgUrl,
-		newEvent.Date,
-		newEvent.Geo,
+		newEvent.StartDate,
+		newEvent.EndDate,
+		newEvent.TimeZone,
+		newEvent.Latitude,
+		newEvent.Longitude,
 		newEvent.Address,
 		newEvent.Tag,
-		newEvent.AuthorID)
+		newEvent.AuthorID,
+		newEvent.Capacity)
 	if err != nil {
 		if err == sql2.ErrNoRows {
 			return "", error2.ErrNoRows
@@ -121,44 +208,60 @@ func (s *Repository) UpdateEvent(e *models.Event, userId string) error {
 		return err
 	}
 	postgresEvent.ID = eventIdInt
-	var query string
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
 	if postgresEvent.ImgUrl != "" {
-		query = updateEventQuery
-		rows, err := s.db.Query(query,
+		_, err = tx.Exec(updateEventQuery,
 			postgresEvent.Title,
 			postgresEvent.Description,
 			postgresEvent.Text,
 			postgresEvent.City,
 			postgresEvent.Category,
 			postgresEvent.ImgUrl,
-			postgresEvent.Date,
-			postgresEvent.Geo,
+			postgresEvent.StartDate,
+			postgresEvent.EndDate,
+			postgresEvent.TimeZone,
+			postgresEvent.Latitude,
+			postgresEvent.Longitude,
 			postgresEvent.Address,
 			postgresEvent.Tag,
+			postgresEvent.Capacity,
 			postgresEvent.ID)
-		if err != nil {
-			log.Error(message+"err = ", err)
-			return error2.ErrPostgres
-		}
-		defer rows.Close()
 	} else {
-		query = updateEventQueryWithoutImgUrl
-		rows, err := s.db.Query(query,
+		_, err = tx.Exec(updateEventQueryWithoutImgUrl,
 			postgresEvent.Title,
 			postgresEvent.Description,
 			postgresEvent.Text,
 			postgresEvent.City,
 			postgresEvent.Category,
-			postgresEvent.Date,
-			postgresEvent.Geo,
+			postgresEvent.StartDate,
+			postgresEvent.EndDate,
+			postgresEvent.TimeZone,
+			postgresEvent.Latitude,
+			postgresEvent.Longitude,
 			postgresEvent.Address,
 			postgresEvent.Tag,
+			postgresEvent.Capacity,
 			postgresEvent.ID)
-		if err != nil {
-			log.Error(message+"err = ", err)
-			return error2.ErrPostgres
-		}
-		defer rows.Close()
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	//The updated row stays locked until the commit, so Visit can't take the freed seats meanwhile
+	err = promoteToCapacity(tx, eventIdInt, postgresEvent.Capacity)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
 	}
 	log.Debug(message + "ended")
 	return nil
@@ -180,13 +283,284 @@ func (s *Repository) DeleteEvent(eventId string, userId string) error {
 		log.Error(message+"err = ", err)
 		return err
 	}
-	query := deleteEventQuery
-	rows, err := s.db.Query(query, eventIdInt)
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec(excludeOccurrenceQuery, eventIdInt)
+	if err == nil {
+		_, err = tx.Exec(deleteEventQuery, eventIdInt)
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+// Inserts the occurrences or updates the ones that already exist, returns the id of the first occurrence
+func upsertOccurrences(tx *sql.Tx, e *Event, seriesId int, occurrences []time.Time) (int, error) {
+	duration := e.EndDate.Sub(e.StartDate)
+	firstId := 0
+	for _, start := range occurrences {
+		var id int
+		err := tx.Get(
+			&id, createOccurrenceQuery,
+			e.Title,
+			e.Description,
+			e.Text,
+			e.City,
+			e.Category,
+			e.Viewed,
+			e.ImgUrl,
+			start,
+			start.Add(duration),
+			e.TimeZone,
+			e.Latitude,
+			e.Longitude,
+			e.Address,
+			e.Tag,
+			e.AuthorID,
+			e.Capacity,
+			seriesId,
+			start.Format(dateLayout))
+		//Detached occurrence is left as it is
+		if err == sql2.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if firstId == 0 {
+			firstId = id
+		}
+	}
+	return firstId, nil
+}
+
+// Occurrences are already expanded from the recurrence rule of the event
+func (s *Repository) CreateSeries(e *models.Event, occurrences []time.Time) (string, error) {
+	message := logMessage + "CreateSeries:"
+	log.Debug(message + "started")
+	newEvent, err := toPostgresEvent(e)
+	if err != nil {
+		return "", err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	var seriesId int
+	err = tx.Get(&seriesId, createSeriesQuery, newEvent.AuthorID, e.RRule, formatDates(e.ExDates), newEvent.StartDate)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	eventId, err := upsertOccurrences(tx, newEvent, seriesId, occurrences)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return strconv.Itoa(eventId), nil
+}
+
+// Returns the series of the occurrence
+func (s *Repository) GetSeries(eventId string) (*models.Series, error) {
+	message := logMessage + "GetSeries:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	var series Series
+	query := getSeriesQuery
+	err = s.db.Get(&series, query, eventIdInt)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrNotSeries
+		}
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toModelSeries(&series), nil
+}
+
+// Future occurrences are replaced with the given ones, past and detached occurrences are kept.
+// The occurrences keep the author of the series when a moderator updates it, e.StartDate is stored as its start
+func (s *Repository) UpdateSeries(e *models.Event, userId string, occurrences []time.Time) error {
+	message := logMessage + "UpdateSeries:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(e.ID)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	seriesIdInt, err := strconv.Atoi(e.SeriesId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	postgresEvent, err := toPostgresEvent(e)
+	if err != nil {
+		return err
+	}
+	err = s.checkAuthor(eventIdInt, userIdInt)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	err = tx.Get(&postgresEvent.AuthorID, updateSeriesQuery, e.RRule, formatDates(e.ExDates), seriesIdInt, postgresEvent.StartDate)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return error2.ErrNotSeries
+		}
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	_, err = upsertOccurrences(tx, postgresEvent, seriesIdInt, occurrences)
+	if err == nil {
+		_, err = tx.Exec(deleteStaleOccurrenceQuery, seriesIdInt, formatDates(occurrences))
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+// Deletes all occurrences of the series, including the past ones
+func (s *Repository) DeleteSeries(eventId string, userId string) error {
+	message := logMessage + "DeleteSeries:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	var series Series
+	query := getSeriesQuery
+	err = s.db.Get(&series, query, eventIdInt)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return error2.ErrNotSeries
+		}
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	err = s.checkAuthor(eventIdInt, userIdInt)
+	if err != nil {
+		return err
+	}
+	query = deleteSeriesQuery
+	_, err = s.db.Exec(query, series.ID)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+func (s *Repository) GetOpenSeries() ([]*models.Series, error) {
+	message := logMessage + "GetOpenSeries:"
+	log.Debug(message + "started")
+	var series []Series
+	query := getOpenSeriesQuery
+	err := s.db.Select(&series, query)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	result := make([]*models.Series, 0, len(series))
+	for i := range series {
+		result = append(result, toModelSeries(&series[i]))
+	}
+	log.Debug(message + "ended")
+	return result, nil
+}
+
+func (s *Repository) GetLastOccurrence(seriesId string) (*models.Event, error) {
+	message := logMessage + "GetLastOccurrence:"
+	log.Debug(message + "started")
+	seriesIdInt, err := strconv.Atoi(seriesId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	var e Event
+	query := getLastOccurrenceQuery
+	err = s.db.Get(&e, query, seriesIdInt)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrNoRows
+		}
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toModelEvent(&e), nil
+}
+
+// Adds the occurrences to the series of the event, the existing ones are updated unless they are detached
+func (s *Repository) ExtendSeries(e *models.Event, occurrences []time.Time) error {
+	message := logMessage + "ExtendSeries:"
+	log.Debug(message + "started")
+	seriesIdInt, err := strconv.Atoi(e.SeriesId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	postgresEvent, err := toPostgresEvent(e)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	_, err = upsertOccurrences(tx, postgresEvent, seriesIdInt, occurrences)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	err = tx.Commit()
 	if err != nil {
 		log.Error(message+"err = ", err)
 		return error2.ErrPostgres
 	}
-	defer rows.Close()
 	log.Debug(message + "ended")
 	return nil
 }
@@ -221,7 +595,36 @@ func (s *Repository) GetEventById(eventId string) (*models.Event, error) {
 	return modelEvent, nil
 }
 
-func (s *Repository) GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
+// Selects one more row than the limit to find out whether the next page exists
+func (s *Repository) getEventsPage(message string, limit int, query string, args ...interface{}) ([]*models.Event, string, error) {
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, "", error2.ErrPostgres
+	}
+	defer rows.Close()
+	var resultEvents []*models.Event
+	var lastEvent Event
+	nextCursor := ""
+	for rows.Next() {
+		var e Event
+		err := rows.StructScan(&e)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return nil, "", error2.ErrPostgres
+		}
+		if len(resultEvents) == limit {
+			nextCursor = encodeCursor(&lastEvent)
+			break
+		}
+		modelEvent := toModelEvent(&e)
+		resultEvents = append(resultEvents, modelEvent)
+		lastEvent = e
+	}
+	return resultEvents, nextCursor, nil
+}
+
+func (s *Repository) GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error) {
 	message := logMessage + "GetEvents:"
 	log.Debug(message + "started")
 	postgresTags := make(pq.StringArray, len(tags))
@@ -234,18 +637,21 @@ func (s *Repository) GetEvents(userId string, title string, category string, cit
 	} else {
 		userIdInt1, err := strconv.Atoi(userId)
 		if err != nil {
-			return nil, error2.ErrAtoi
+			return nil, "", error2.ErrAtoi
 		}
 		userIdInt = userIdInt1
 	}
-	query := `select e.*, count(v) from event as e
-				left join visitor as v on e.id = v.event_id and `
-	query += `v.user_id = $1 `
-	if title != "" {
-		query += `where lower(title) ~ lower($2) and `
-	} else {
-		query += `where $2 = $2 and `
+	limit := pageLimit(page)
+	after, err := pageCursor(page)
+	if err != nil {
+		return nil, "", err
 	}
+	condition, rank, snippet := searchExpressions(title)
+	query := `select e.*, count(v), ` + visitorsColumn + `, ` + waitlistPositionColumn + `,
+				` + rank + ` as rank, ` + snippet + ` as snippet from event as e
+				left join visitor as v on e.id = v.event_id and v.status = 'going' and `
+	query += `v.user_id = $1 `
+	query += `where ` + condition + ` and `
 	if category != "" {
 		query += `lower(category) = lower($3) and `
 	} else {
@@ -256,16 +662,24 @@ func (s *Repository) GetEvents(userId string, title string, category string, cit
 	} else {
 		query += `$4 = $4 and `
 	}
-	if date != "" {
-		query += `lower(e.date) = lower($5) and `
+	if !from.IsZero() {
+		query += `e.end_date >= $5 and `
 	} else {
 		query += `$5 = $5 and `
 	}
+	if !to.IsZero() {
+		query += `e.start_date <= $6 and `
+	} else {
+		query += `$6 = $6 and `
+	}
 	if len(postgresTags) != 0 {
-		query += `tag && $6::varchar[] `
+		query += `tag && $7::varchar[] and `
 	} else {
-		query += `$6 = $6 `
+		query += `$7 = $7 and `
 	}
+	radius, nearArgs := nearCondition(near)
+	query += radius + ` `
+	query += `and (` + rank + `, e.viewed, e.id) < ($11, $12, $13) `
 	query += `group by e.id,
          e.title,
          e.description,
@@ -274,161 +688,600 @@ func (s *Repository) GetEvents(userId string, title string, category string, cit
          e.category,
          e.viewed,
          e.img_url,
-         e.date,
-         e.geo,
+         e.start_date,
+         e.end_date,
+         e.timezone,
+         e.latitude,
+         e.longitude,
          e.address,
          e.tag,
-         e.author_id 
-         order by viewed DESC`
-	rows, err := s.db.Queryx(query, userIdInt, title, category, city, date, postgresTags)
+         e.author_id,
+         e.capacity,
+         e.series_id,
+         e.occurrence_date,
+         e.detached,
+         e.updated_at 
+         order by rank DESC, viewed DESC, e.id DESC limit $14`
+	resultEvents, nextCursor, err := s.getEventsPage(message, limit, query,
+		userIdInt, title, category, city, from, to, postgresTags, nearArgs[0], nearArgs[1], nearArgs[2],
+		after.Rank, after.Viewed, after.ID, limit+1)
 	if err != nil {
-		log.Error(message+"err = ", err)
-		return nil, error2.ErrPostgres
-	}
-	defer rows.Close()
-	var resultEvents []*models.Event
-	for rows.Next() {
-		var e Event
-		err := rows.StructScan(&e)
-		if err != nil {
-			log.Error(message+"err = ", err)
-			return nil, error2.ErrPostgres
-		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
+		return nil, "", err
 	}
 	log.Debug(message + "ended")
-	return resultEvents, nil
+	return resultEvents, nextCursor, nil
 }
 
-func (s *Repository) GetVisitedEvents(userId string) ([]*models.Event, error) {
+func (s *Repository) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
 	message := logMessage + "GetVisitedEvents:"
 	log.Debug(message + "started")
 	userIdInt, err := strconv.Atoi(userId)
 	if err != nil {
-		return nil, error2.ErrAtoi
+		return nil, "", error2.ErrAtoi
+	}
+	limit := pageLimit(page)
+	after, err := pageCursor(page)
+	if err != nil {
+		return nil, "", err
 	}
 	query := visitedQuery
-	rows, err := s.db.Queryx(query, userIdInt)
+	resultEvents, nextCursor, err := s.getEventsPage(message, limit, query, userIdInt, after.Viewed, after.ID, limit+1)
 	if err != nil {
-		log.Error(message+"err = ", err)
-		return nil, error2.ErrPostgres
-	}
-	defer rows.Close()
-	var resultEvents []*models.Event
-	for rows.Next() {
-		var e Event
-		err := rows.StructScan(&e)
-		if err != nil {
-			log.Error(message+"err = ", err)
-			return nil, error2.ErrPostgres
-		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
+		return nil, "", err
 	}
 	log.Debug(message + "ended")
-	return resultEvents, nil
+	return resultEvents, nextCursor, nil
 }
 
-func (s *Repository) GetCreatedEvents(authorId string) ([]*models.Event, error) {
+func (s *Repository) GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error) {
 	message := logMessage + "GetCreatedEvents:"
 	log.Debug(message + "started")
 	authorIdInt, err := strconv.Atoi(authorId)
 	if err != nil {
-		return nil, error2.ErrAtoi
+		return nil, "", error2.ErrAtoi
+	}
+	limit := pageLimit(page)
+	after, err := pageCursor(page)
+	if err != nil {
+		return nil, "", err
 	}
 	query := createdQuery
-	rows, err := s.db.Queryx(query, authorIdInt)
+	resultEvents, nextCursor, err := s.getEventsPage(message, limit, query, authorIdInt, after.Viewed, after.ID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	log.Debug(message + "ended")
+	return resultEvents, nextCursor, nil
+}
+
+func (s *Repository) GetEventsMap(bbox *models.BBox, cellSize float64) (*models.EventMap, error) {
+	message := logMessage + "GetEventsMap:"
+	log.Debug(message + "started")
+	var points []mapPoint
+	var err error
+	if cellSize > 0 {
+		query := mapClustersQuery
+		err = s.db.Select(&points, query, bbox.MinLatitude, bbox.MinLongitude, bbox.MaxLatitude, bbox.MaxLongitude, cellSize)
+	} else {
+		query := mapPinsQuery
+		err = s.db.Select(&points, query, bbox.MinLatitude, bbox.MinLongitude, bbox.MaxLatitude, bbox.MaxLongitude, maxMapPins)
+	}
 	if err != nil {
 		log.Error(message+"err = ", err)
 		return nil, error2.ErrPostgres
 	}
-	defer rows.Close()
-	var resultEvents []*models.Event
-	for rows.Next() {
-		var e Event
-		err := rows.StructScan(&e)
+	log.Debug(message + "ended")
+	return toModelEventMap(points), nil
+}
+
+// Locks the event row, so that visits of one event are serialized
+func lockEvent(tx *sql.Tx, eventId int) (int, error) {
+	var capacity int
+	err := tx.Get(&capacity, lockEventQuery, eventId)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return 0, error2.ErrNoRows
+		}
+		return 0, error2.ErrPostgres
+	}
+	return capacity, nil
+}
+
+// Moves the first waitlisted user to the visitors if a seat is free, returns the id of this user
+func promoteWaitlisted(tx *sql.Tx, eventId int, capacity int) (string, error) {
+	if capacity == 0 {
+		return "", nil
+	}
+	var visitors int
+	err := tx.Get(&visitors, countVisitorsQuery, eventId)
+	if err != nil {
+		return "", err
+	}
+	if visitors >= capacity {
+		return "", nil
+	}
+	var nextUserId int
+	err = tx.Get(&nextUserId, nextWaitlistedQuery, eventId)
+	if err == sql2.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.Exec(visitQuery, eventId, nextUserId)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(nextUserId), nil
+}
+
+// Moves the waitlisted users to the seats that are free after the capacity has changed
+func promoteToCapacity(tx *sql.Tx, eventId int, capacity int) error {
+	limit := sql2.NullInt64{}
+	if capacity > 0 {
+		var visitors int
+		err := tx.Get(&visitors, countVisitorsQuery, eventId)
 		if err != nil {
-			log.Error(message+"err = ", err)
-			return nil, error2.ErrPostgres
+			return err
 		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
+		if visitors >= capacity {
+			return nil
+		}
+		limit = sql2.NullInt64{Int64: int64(capacity - visitors), Valid: true}
 	}
-	log.Debug(message + "ended")
-	return resultEvents, nil
+	_, err := tx.Exec(promoteWaitlistedQuery, eventId, limit)
+	return err
+}
+
+// Returns the waitlist position of the user, zero means that the user visits the event
+func visit(tx *sql.Tx, eventId int, userId int) (int, error) {
+	capacity, err := lockEvent(tx, eventId)
+	if err != nil {
+		return 0, err
+	}
+	var visited, visitors int
+	err = tx.Get(&visited, isVisitedQuery, eventId, userId)
+	if err != nil {
+		return 0, error2.ErrPostgres
+	}
+	if visited > 0 {
+		return 0, nil
+	}
+	err = tx.Get(&visitors, countVisitorsQuery, eventId)
+	if err != nil {
+		return 0, error2.ErrPostgres
+	}
+	position := 0
+	if capacity == 0 || visitors < capacity {
+		_, err = tx.Exec(unwaitQuery, eventId, userId)
+		if err == nil {
+			_, err = tx.Exec(visitQuery, eventId, userId)
+		}
+	} else {
+		_, err = tx.Exec(waitQuery, eventId, userId)
+		if err == nil {
+			err = tx.Get(&position, waitlistPositionQuery, eventId, userId)
+		}
+	}
+	if err != nil {
+		return 0, error2.ErrPostgres
+	}
+	return position, nil
 }
 
-func (s *Repository) Visit(eventId string, userId string) error {
+// Returns the waitlist position of the user, zero means that the user visits the event
+func (s *Repository) Visit(eventId string, userId string) (int, error) {
 	message := logMessage + "Visit:"
 	log.Debug(message + "started")
 	eventIdInt, err := strconv.Atoi(eventId)
 	if err != nil {
-		return error2.ErrAtoi
+		return 0, error2.ErrAtoi
 	}
 	userIdInt, err := strconv.Atoi(userId)
 	if err != nil {
-		return error2.ErrAtoi
+		return 0, error2.ErrAtoi
 	}
-	query := visitQuery
-	rows, err := s.db.Query(query, eventIdInt, userIdInt)
+	tx, err := s.db.Beginx()
 	if err != nil {
 		log.Error(message+"err = ", err)
-		return error2.ErrPostgres
+		return 0, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	position, err := visit(tx, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return 0, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return 0, error2.ErrPostgres
 	}
-	defer rows.Close()
 	log.Debug(message + "ended")
-	return nil
+	return position, nil
 }
 
-func (s *Repository) Unvisit(eventId string, userId string) error {
+// Returns the id of the user promoted from the waitlist, if there is one
+func (s *Repository) Unvisit(eventId string, userId string) (string, error) {
 	message := logMessage + "Unvisit:"
 	log.Debug(message + "started")
 	eventIdInt, err := strconv.Atoi(eventId)
 	if err != nil {
-		return error2.ErrAtoi
+		return "", error2.ErrAtoi
 	}
 	userIdInt, err := strconv.Atoi(userId)
 	if err != nil {
-		return error2.ErrAtoi
+		return "", error2.ErrAtoi
 	}
-	query := unvisitQuery
-	rows, err := s.db.Query(query, eventIdInt, userIdInt)
+	tx, err := s.db.Beginx()
 	if err != nil {
 		log.Error(message+"err = ", err)
-		return error2.ErrPostgres
+		return "", error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	capacity, err := lockEvent(tx, eventIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	_, err = tx.Exec(unwaitQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	_, err = tx.Exec(unvisitQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	promotedUserId, err := promoteWaitlisted(tx, eventIdInt, capacity)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
 	}
-	defer rows.Close()
 	log.Debug(message + "ended")
-	return nil
+	return promotedUserId, nil
 }
 
-func (s *Repository) IsVisited(eventId string, userId string) (bool, error) {
+func (s *Repository) IsVisited(eventId string, userId string) (bool, int, error) {
 	message := logMessage + "IsVisited:"
 	log.Debug(message + "started")
 	eventIdInt, err := strconv.Atoi(eventId)
 	if err != nil {
-		return false, error2.ErrAtoi
+		return false, 0, error2.ErrAtoi
 	}
 	userIdInt, err := strconv.Atoi(userId)
 	if err != nil {
-		return false, error2.ErrAtoi
+		return false, 0, error2.ErrAtoi
 	}
 	query := isVisitedQuery
 	var count int
-	result := false
 	err = s.db.Get(&count, query, eventIdInt, userIdInt)
 	if err != nil {
 		log.Error(message+"err = ", err)
-		return false, error2.ErrPostgres
+		return false, 0, error2.ErrPostgres
 	}
 	if count > 0 {
-		result = true
+		log.Debug(message + "ended")
+		return true, 0, nil
+	}
+	var position int
+	query = waitlistPositionQuery
+	err = s.db.Get(&position, query, eventIdInt, userIdInt)
+	if err != nil && err != sql2.ErrNoRows {
+		log.Error(message+"err = ", err)
+		return false, 0, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return false, position, nil
+}
+
+// Sets "maybe" or "declined" status, "going" is set by Visit because of the capacity check.
+// Returns the id of the user promoted from the waitlist, if the user gave up the seat
+func (s *Repository) SetRSVP(eventId string, userId string, status string) (string, error) {
+	message := logMessage + "SetRSVP:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return "", error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return "", error2.ErrAtoi
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	capacity, err := lockEvent(tx, eventIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	_, err = tx.Exec(unwaitQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	_, err = tx.Exec(setRSVPQuery, eventIdInt, userIdInt, status)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	promotedUserId, err := promoteWaitlisted(tx, eventIdInt, capacity)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return promotedUserId, nil
+}
+
+// Empty status means that the user has not answered yet
+func (s *Repository) GetRSVP(eventId string, userId string) (*models.RSVP, error) {
+	message := logMessage + "GetRSVP:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	result := &models.RSVP{}
+	query := getRSVPQuery
+	err = s.db.Get(&result.Status, query, eventIdInt, userIdInt)
+	if err != nil && err != sql2.ErrNoRows {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	if result.Status == models.RSVPGoing {
+		log.Debug(message + "ended")
+		return result, nil
+	}
+	query = waitlistPositionQuery
+	err = s.db.Get(&result.WaitlistPosition, query, eventIdInt, userIdInt)
+	if err != nil && err != sql2.ErrNoRows {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	if result.WaitlistPosition > 0 {
+		result.Status = models.RSVPGoing
 	}
 	log.Debug(message + "ended")
 	return result, nil
 }
 
+// Invitations are created all at once, so a duplicate receiver rejects the whole request
+func (s *Repository) CreateInvitations(eventId string, inviterId string, receiverIds []string) error {
+	message := logMessage + "CreateInvitations:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	inviterIdInt, err := strconv.Atoi(inviterId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	receiverIdsInt := make([]int, len(receiverIds))
+	for i, receiverId := range receiverIds {
+		receiverIdsInt[i], err = strconv.Atoi(receiverId)
+		if err != nil {
+			return error2.ErrAtoi
+		}
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	for _, receiverIdInt := range receiverIdsInt {
+		var invitationId int
+		err = tx.Get(&invitationId, createInvitationQuery, eventIdInt, inviterIdInt, receiverIdInt)
+		if err == sql2.ErrNoRows {
+			return error2.ErrInvitationExists
+		}
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return error2.ErrPostgres
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
+// Accepted invitation makes the receiver a visitor in the same transaction, so the waitlist position is returned too
+func (s *Repository) RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error) {
+	message := logMessage + "RespondInvitation:"
+	log.Debug(message + "started")
+	invitationIdInt, err := strconv.Atoi(invitationId)
+	if err != nil {
+		return nil, 0, error2.ErrAtoi
+	}
+	receiverIdInt, err := strconv.Atoi(receiverId)
+	if err != nil {
+		return nil, 0, error2.ErrAtoi
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, 0, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	var i Invitation
+	query := respondInvitationQuery
+	err = tx.Get(&i, query, invitationIdInt, receiverIdInt, status)
+	if err == sql2.ErrNoRows {
+		//Invitation was not updated, finding out why
+		query = getInvitationQuery
+		err = tx.Get(&i, query, invitationIdInt, receiverIdInt)
+		if err == sql2.ErrNoRows {
+			return nil, 0, error2.ErrNoRows
+		}
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return nil, 0, error2.ErrPostgres
+		}
+		if i.Status == models.InvitationExpired {
+			return nil, 0, error2.ErrInvitationExpired
+		}
+		return nil, 0, error2.ErrInvitationAnswered
+	}
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, 0, error2.ErrPostgres
+	}
+	position := 0
+	if status == models.InvitationAccepted {
+		position, err = visit(tx, i.EventID, receiverIdInt)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return nil, 0, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, 0, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toModelInvitation(&i), position, nil
+}
+
+func (s *Repository) getInvitations(message string, query string, args ...interface{}) ([]*models.Invitation, error) {
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	defer rows.Close()
+	resultInvitations := []*models.Invitation{}
+	for rows.Next() {
+		var i Invitation
+		err := rows.StructScan(&i)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return nil, error2.ErrPostgres
+		}
+		resultInvitations = append(resultInvitations, toModelInvitation(&i))
+	}
+	return resultInvitations, nil
+}
+
+// Only invitations sent by the inviter are returned
+func (s *Repository) GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error) {
+	message := logMessage + "GetEventInvitations:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(eventId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	inviterIdInt, err := strconv.Atoi(inviterId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	resultInvitations, err := s.getInvitations(message, getEventInvitationsQuery, eventIdInt, inviterIdInt)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(message + "ended")
+	return resultInvitations, nil
+}
+
+func (s *Repository) GetUserInvitations(receiverId string) ([]*models.Invitation, error) {
+	message := logMessage + "GetUserInvitations:"
+	log.Debug(message + "started")
+	receiverIdInt, err := strconv.Atoi(receiverId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	resultInvitations, err := s.getInvitations(message, getUserInvitationsQuery, receiverIdInt)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(message + "ended")
+	return resultInvitations, nil
+}
+
+func (s *Repository) GetCalendarEvents(userId string) ([]*models.Event, error) {
+	message := logMessage + "GetCalendarEvents:"
+	log.Debug(message + "started")
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrAtoi
+	}
+	var events []Event
+	query := calendarEventsQuery
+	err = s.db.Select(&events, query, userIdInt, maxCalendarEvents)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, error2.ErrPostgres
+	}
+	resultEvents := make([]*models.Event, len(events))
+	for i := range events {
+		resultEvents[i] = toModelEvent(&events[i])
+	}
+	log.Debug(message + "ended")
+	return resultEvents, nil
+}
+
+// Empty token means that the user has not got the feed yet
+func (s *Repository) GetCalendarToken(userId string) (string, error) {
+	message := logMessage + "GetCalendarToken:"
+	log.Debug(message + "started")
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return "", error2.ErrAtoi
+	}
+	var token string
+	query := getCalendarTokenQuery
+	err = s.db.Get(&token, query, userIdInt)
+	if err != nil && err != sql2.ErrNoRows {
+		log.Error(message+"err = ", err)
+		return "", error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return token, nil
+}
+
+// Replaces the previous token of the user
+func (s *Repository) SetCalendarToken(userId string, token string) error {
+	message := logMessage + "SetCalendarToken:"
+	log.Debug(message + "started")
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	query := setCalendarTokenQuery
+	_, err = s.db.Exec(query, userIdInt, token)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
 func (s *Repository) GetCities() ([]string, error) {
 	message := logMessage + "GetCities:"
 	log.Debug(message + "started")
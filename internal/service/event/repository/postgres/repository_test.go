@@ -4,12 +4,15 @@ import (
 	"backend/internal/models"
 	error2 "backend/internal/service/event/error"
 	sql2 "database/sql"
+	"database/sql/driver"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
+	"math"
 	"strconv"
 	"testing"
+	"time"
 )
 
 var createEventTests = []struct {
@@ -93,11 +96,15 @@ func TestCreateEvent(t *testing.T) {
 				newEvent.Category,
 				newEvent.Viewed,
 				newEvent.ImgUrl,
-				newEvent.Date,
-				newEvent.Geo,
+				newEvent.StartDate,
+				newEvent.EndDate,
+				newEvent.TimeZone,
+				newEvent.Latitude,
+				newEvent.Longitude,
 				newEvent.Address,
 				newEvent.Tag,
 				newEvent.AuthorID,
+				newEvent.Capacity,
 			).WillReturnRows(sqlmock.NewRows([]string{"id"}).
 			AddRow(test.eventId)).WillReturnError(test.postgresErr)
 		out, actualErr := repositoryTest.CreateEvent(test.event)
@@ -110,6 +117,8 @@ var updateEventTests = []struct {
 	id          int
 	event       *models.Event
 	userId      string
+	visitors    int
+	promoted    sql2.NullInt64
 	postgresErr error
 	outputErr   error
 }{
@@ -120,6 +129,8 @@ var updateEventTests = []struct {
 			AuthorId: "10",
 		},
 		"10",
+		0,
+		sql2.NullInt64{},
 		nil,
 		nil,
 	},
@@ -131,6 +142,8 @@ var updateEventTests = []struct {
 			ImgUrl:   "test",
 		},
 		"10",
+		0,
+		sql2.NullInt64{},
 		nil,
 		nil,
 	},
@@ -142,6 +155,8 @@ var updateEventTests = []struct {
 			ImgUrl:   "test",
 		},
 		"10",
+		0,
+		sql2.NullInt64{},
 		error2.ErrPostgres,
 		error2.ErrPostgres,
 	},
@@ -152,6 +167,8 @@ var updateEventTests = []struct {
 			AuthorId: "10",
 		},
 		"10",
+		0,
+		sql2.NullInt64{},
 		error2.ErrPostgres,
 		error2.ErrPostgres,
 	},
@@ -162,6 +179,8 @@ var updateEventTests = []struct {
 			AuthorId: "10",
 		},
 		"2",
+		0,
+		sql2.NullInt64{},
 		nil,
 		error2.ErrNotAllowed,
 	},
@@ -172,6 +191,8 @@ var updateEventTests = []struct {
 			AuthorId: "10",
 		},
 		"2",
+		0,
+		sql2.NullInt64{},
 		nil,
 		error2.ErrAtoi,
 	},
@@ -182,74 +203,121 @@ var updateEventTests = []struct {
 			AuthorId: "10",
 		},
 		"test",
+		0,
+		sql2.NullInt64{},
 		nil,
 		error2.ErrAtoi,
 	},
+	{
+		8,
+		&models.Event{
+			ID:       "1",
+			AuthorId: "10",
+			Capacity: 5,
+		},
+		"10",
+		2,
+		sql2.NullInt64{Int64: 3, Valid: true},
+		nil,
+		nil,
+	},
+	{
+		9,
+		&models.Event{
+			ID:       "1",
+			AuthorId: "10",
+			Capacity: 5,
+		},
+		"10",
+		5,
+		sql2.NullInt64{},
+		nil,
+		nil,
+	},
 }
 
 func TestUpdateEvent(t *testing.T) {
 	for _, test := range updateEventTests {
-
 		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 		require.NoError(t, err, logMessage, err)
-		defer db.Close()
 		sqlxDB := sqlx.NewDb(db, "sqlmock")
 		repositoryTest := NewRepository(sqlxDB)
 
-		newEvent, err := toPostgresEvent(test.event)
-		if err != nil {
-			newEvent = &Event{}
-		}
-
-		eventIdInt, err := strconv.Atoi(test.event.ID)
-		if err != nil {
-			eventIdInt = 0
-		}
-		newEvent.ID = eventIdInt
+		if test.outputErr != error2.ErrAtoi {
+			newEvent, _ := toPostgresEvent(test.event)
+			newEvent.ID, _ = strconv.Atoi(test.event.ID)
+			authorIdInt, _ := strconv.Atoi(test.event.AuthorId)
+			userIdInt, _ := strconv.Atoi(test.userId)
 
-		authorIdInt, err := strconv.Atoi(test.event.AuthorId)
-		if err != nil {
-			eventIdInt = 0
+			mock.ExpectQuery(checkAuthorQuery).
+				WithArgs(newEvent.ID, userIdInt).
+				WillReturnRows(sqlmock.NewRows([]string{"author_id", "role"}).
+					AddRow(authorIdInt, models.RoleUser))
 		}
+		if test.outputErr == nil || test.postgresErr != nil {
+			newEvent, _ := toPostgresEvent(test.event)
+			newEvent.ID, _ = strconv.Atoi(test.event.ID)
 
-		mock.ExpectQuery(checkAuthorQuery).
-			WithArgs(eventIdInt).
-			WillReturnRows(sqlmock.NewRows([]string{"author_id"}).
-				AddRow(authorIdInt)).
-			WillReturnError(nil)
-
-		if test.event.ImgUrl != "" {
-			mock.ExpectQuery(updateEventQuery).
-				WithArgs(
-					newEvent.Title,
-					newEvent.Description,
-					newEvent.Text,
-					newEvent.City,
-					newEvent.Category,
-					newEvent.ImgUrl,
-					newEvent.Date,
-					newEvent.Geo,
-					newEvent.Address,
-					newEvent.Tag,
-					newEvent.ID,
-				).WillReturnRows(sqlmock.NewRows([]string{""})).WillReturnError(test.postgresErr)
-		} else {
-			mock.ExpectQuery(updateEventQueryWithoutImgUrl).
-				WithArgs(
-					newEvent.Title,
-					newEvent.Description,
-					newEvent.Text,
-					newEvent.City,
-					newEvent.Category,
-					newEvent.Date,
-					newEvent.Geo,
-					newEvent.Address,
-					newEvent.Tag,
-					newEvent.ID,
-				).WillReturnRows(sqlmock.NewRows([]string{""})).WillReturnError(test.postgresErr)
+			mock.ExpectBegin()
+			var update *sqlmock.ExpectedExec
+			if test.event.ImgUrl != "" {
+				update = mock.ExpectExec(updateEventQuery).
+					WithArgs(
+						newEvent.Title,
+						newEvent.Description,
+						newEvent.Text,
+						newEvent.City,
+						newEvent.Category,
+						newEvent.ImgUrl,
+						newEvent.StartDate,
+						newEvent.EndDate,
+						newEvent.TimeZone,
+						newEvent.Latitude,
+						newEvent.Longitude,
+						newEvent.Address,
+						newEvent.Tag,
+						newEvent.Capacity,
+						newEvent.ID,
+					)
+			} else {
+				update = mock.ExpectExec(updateEventQueryWithoutImgUrl).
+					WithArgs(
+						newEvent.Title,
+						newEvent.Description,
+						newEvent.Text,
+						newEvent.City,
+						newEvent.Category,
+						newEvent.StartDate,
+						newEvent.EndDate,
+						newEvent.TimeZone,
+						newEvent.Latitude,
+						newEvent.Longitude,
+						newEvent.Address,
+						newEvent.Tag,
+						newEvent.Capacity,
+						newEvent.ID,
+					)
+			}
+			if test.postgresErr != nil {
+				update.WillReturnError(test.postgresErr)
+				mock.ExpectRollback()
+			} else {
+				update.WillReturnResult(sqlmock.NewResult(0, 1))
+				if newEvent.Capacity > 0 {
+					mock.ExpectQuery(countVisitorsQuery).WithArgs(newEvent.ID).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(test.visitors))
+				}
+				if newEvent.Capacity == 0 || test.promoted.Valid {
+					mock.ExpectExec(promoteWaitlistedQuery).WithArgs(newEvent.ID, test.promoted).
+						WillReturnResult(sqlmock.NewResult(0, test.promoted.Int64))
+				}
+				mock.ExpectCommit()
+			}
 		}
 		actualErr := repositoryTest.UpdateEvent(test.event, test.userId)
-		require.Equal(t, test.outputErr, actualErr)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
 	}
 }
 
@@ -258,6 +326,7 @@ var deleteEventTests = []struct {
 	eventId     string
 	userId      string
 	authorId    int
+	role        string
 	postgresErr error
 	outputErr   error
 }{
@@ -266,6 +335,7 @@ var deleteEventTests = []struct {
 		"1",
 		"1",
 		1,
+		models.RoleUser,
 		nil,
 		nil,
 	},
@@ -274,6 +344,7 @@ var deleteEventTests = []struct {
 		"test",
 		"1",
 		1,
+		models.RoleUser,
 		nil,
 		error2.ErrAtoi,
 	},
@@ -282,6 +353,7 @@ var deleteEventTests = []struct {
 		"1",
 		"test",
 		1,
+		models.RoleUser,
 		nil,
 		error2.ErrAtoi,
 	},
@@ -290,6 +362,7 @@ var deleteEventTests = []struct {
 		"1",
 		"1",
 		10,
+		models.RoleUser,
 		nil,
 		error2.ErrNotAllowed,
 	},
@@ -298,9 +371,19 @@ var deleteEventTests = []struct {
 		"1",
 		"1",
 		1,
+		models.RoleUser,
 		error2.ErrPostgres,
 		error2.ErrPostgres,
 	},
+	{
+		6,
+		"1",
+		"1",
+		10,
+		models.RoleModerator,
+		nil,
+		nil,
+	},
 }
 
 func TestDeleteEvent(t *testing.T) {
@@ -318,20 +401,476 @@ func TestDeleteEvent(t *testing.T) {
 			eventIdInt = 0
 		}
 
+		userIdInt, _ := strconv.Atoi(test.userId)
+
 		mock.ExpectQuery(checkAuthorQuery).
-			WithArgs(eventIdInt).
-			WillReturnRows(sqlmock.NewRows([]string{"author_id"}).
-				AddRow(test.authorId)).
+			WithArgs(eventIdInt, userIdInt).
+			WillReturnRows(sqlmock.NewRows([]string{"author_id", "role"}).
+				AddRow(test.authorId, test.role)).
 			WillReturnError(nil)
 
-		mock.ExpectQuery(deleteEventQuery).
-			WithArgs(eventIdInt).WillReturnRows(sqlmock.NewRows([]string{})).WillReturnError(test.postgresErr)
+		mock.ExpectBegin()
+		mock.ExpectExec(excludeOccurrenceQuery).
+			WithArgs(eventIdInt).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(deleteEventQuery).
+			WithArgs(eventIdInt).WillReturnResult(sqlmock.NewResult(0, 1)).WillReturnError(test.postgresErr)
+		if test.postgresErr == nil {
+			mock.ExpectCommit()
+		} else {
+			mock.ExpectRollback()
+		}
 
 		actualErr := repositoryTest.DeleteEvent(test.eventId, test.userId)
 		require.Equal(t, test.outputErr, actualErr)
 	}
 }
 
+func occurrenceArgs(e *models.Event, seriesId int, start time.Time) []driver.Value {
+	authorId, _ := strconv.Atoi(e.AuthorId)
+	return []driver.Value{e.Title, e.Description, e.Text, e.City, e.Category, e.Viewed, e.ImgUrl,
+		start, start.Add(e.EndDate.Sub(e.StartDate)), e.TimeZone, e.Latitude, e.Longitude, e.Address,
+		pq.StringArray(e.Tag), authorId, e.Capacity, seriesId, start.Format(dateLayout)}
+}
+
+var seriesStart = time.Date(2031, 11, 3, 18, 0, 0, 0, time.UTC)
+
+var createSeriesTests = []struct {
+	id          int
+	event       *models.Event
+	occurrences []time.Time
+	postgresErr error
+	output      string
+	outputErr   error
+}{
+	{
+		1,
+		&models.Event{
+			AuthorId:  "1",
+			StartDate: seriesStart,
+			EndDate:   seriesStart.Add(time.Hour),
+			RRule:     "FREQ=DAILY;COUNT=2",
+			ExDates:   []time.Time{seriesStart.AddDate(0, 0, 1)},
+		},
+		[]time.Time{seriesStart, seriesStart.AddDate(0, 0, 2)},
+		nil,
+		"10",
+		nil,
+	},
+	{
+		2,
+		&models.Event{
+			AuthorId: "1",
+			RRule:    "FREQ=DAILY",
+		},
+		[]time.Time{seriesStart},
+		sql2.ErrConnDone,
+		"",
+		error2.ErrPostgres,
+	},
+	{
+		3,
+		&models.Event{
+			AuthorId: "test",
+		},
+		nil,
+		nil,
+		"",
+		error2.ErrAtoi,
+	},
+}
+
+func TestCreateSeries(t *testing.T) {
+	for _, test := range createSeriesTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(createSeriesQuery).WithArgs(1, test.event.RRule, formatDates(test.event.ExDates), test.event.StartDate)
+			if test.postgresErr != nil {
+				query.WillReturnError(test.postgresErr)
+				mock.ExpectRollback()
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+				for j, start := range test.occurrences {
+					mock.ExpectQuery(createOccurrenceQuery).
+						WithArgs(occurrenceArgs(test.event, 5, start)...).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10 + j))
+				}
+				mock.ExpectCommit()
+			}
+		}
+		actual, actualErr := repositoryTest.CreateSeries(test.event, test.occurrences)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+func TestGetSeries(t *testing.T) {
+	columns := []string{"id", "author_id", "rrule", "exdates", "dtstart"}
+	for i, found := range []bool{true, false} {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		query := mock.ExpectQuery(getSeriesQuery).WithArgs(2)
+		if found {
+			query.WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, "FREQ=DAILY", "{2031-11-04}", seriesStart))
+		} else {
+			query.WillReturnError(sql2.ErrNoRows)
+		}
+		actual, actualErr := repositoryTest.GetSeries("2")
+		if found {
+			require.NoError(t, actualErr, i)
+			require.Equal(t, &models.Series{
+				ID:       "5",
+				AuthorId: "1",
+				RRule:    "FREQ=DAILY",
+				ExDates:  []time.Time{time.Date(2031, 11, 4, 0, 0, 0, 0, time.UTC)},
+				Start:    seriesStart,
+			}, actual, i)
+		} else {
+			require.Equal(t, error2.ErrNotSeries, actualErr, i)
+		}
+		require.NoError(t, mock.ExpectationsWereMet(), i)
+		db.Close()
+	}
+}
+
+var updateSeriesTests = []struct {
+	id          int
+	event       *models.Event
+	userId      string
+	role        string
+	occurrences []time.Time
+	detached    int
+	found       bool
+	outputErr   error
+}{
+	{
+		1,
+		&models.Event{
+			ID:        "2",
+			SeriesId:  "5",
+			StartDate: seriesStart,
+			EndDate:   seriesStart.Add(time.Hour),
+			RRule:     "FREQ=WEEKLY",
+		},
+		"1",
+		models.RoleUser,
+		[]time.Time{seriesStart, seriesStart.AddDate(0, 0, 7)},
+		1,
+		true,
+		nil,
+	},
+	{
+		2,
+		&models.Event{
+			ID:       "2",
+			SeriesId: "5",
+			RRule:    "FREQ=WEEKLY",
+		},
+		"2",
+		models.RoleUser,
+		[]time.Time{seriesStart},
+		-1,
+		true,
+		error2.ErrNotAllowed,
+	},
+	{
+		3,
+		&models.Event{
+			ID:        "2",
+			SeriesId:  "5",
+			StartDate: seriesStart,
+			EndDate:   seriesStart.Add(time.Hour),
+			RRule:     "FREQ=WEEKLY",
+		},
+		"2",
+		models.RoleModerator,
+		[]time.Time{seriesStart},
+		-1,
+		true,
+		nil,
+	},
+	{
+		4,
+		&models.Event{
+			ID:       "2",
+			SeriesId: "5",
+			RRule:    "FREQ=WEEKLY",
+		},
+		"1",
+		models.RoleUser,
+		[]time.Time{seriesStart},
+		-1,
+		false,
+		error2.ErrNotSeries,
+	},
+	{
+		5,
+		&models.Event{},
+		"1",
+		models.RoleUser,
+		nil,
+		-1,
+		false,
+		error2.ErrAtoi,
+	},
+}
+
+func TestUpdateSeries(t *testing.T) {
+	for _, test := range updateSeriesTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			userIdInt, _ := strconv.Atoi(test.userId)
+			mock.ExpectQuery(checkAuthorQuery).
+				WithArgs(2, userIdInt).
+				WillReturnRows(sqlmock.NewRows([]string{"author_id", "role"}).AddRow(1, test.role))
+		}
+		if test.outputErr != error2.ErrAtoi && test.outputErr != error2.ErrNotAllowed {
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(updateSeriesQuery).
+				WithArgs(test.event.RRule, formatDates(test.event.ExDates), 5, test.event.StartDate)
+			if test.found {
+				query.WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(1))
+			} else {
+				query.WillReturnError(sql2.ErrNoRows)
+			}
+			if test.outputErr == nil {
+				// The occurrences keep the author of the series
+				event := *test.event
+				event.AuthorId = "1"
+				for j, start := range test.occurrences {
+					query := mock.ExpectQuery(createOccurrenceQuery).WithArgs(occurrenceArgs(&event, 5, start)...)
+					if j == test.detached {
+						query.WillReturnError(sql2.ErrNoRows)
+					} else {
+						query.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10 + j))
+					}
+				}
+				mock.ExpectExec(deleteStaleOccurrenceQuery).
+					WithArgs(5, formatDates(test.occurrences)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+		}
+		actualErr := repositoryTest.UpdateSeries(test.event, test.userId, test.occurrences)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var deleteSeriesTests = []struct {
+	id        int
+	eventId   string
+	userId    string
+	role      string
+	found     bool
+	outputErr error
+}{
+	{1, "2", "1", models.RoleUser, true, nil},
+	{2, "2", "3", models.RoleUser, true, error2.ErrNotAllowed},
+	{3, "2", "3", models.RoleModerator, true, nil},
+	{4, "2", "1", models.RoleUser, false, error2.ErrNotSeries},
+	{5, "a", "1", models.RoleUser, false, error2.ErrAtoi},
+}
+
+func TestDeleteSeries(t *testing.T) {
+	columns := []string{"id", "author_id", "rrule", "exdates"}
+	for _, test := range deleteSeriesTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			query := mock.ExpectQuery(getSeriesQuery).WithArgs(2)
+			if test.found {
+				query.WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, "FREQ=DAILY", "{}"))
+				userIdInt, _ := strconv.Atoi(test.userId)
+				mock.ExpectQuery(checkAuthorQuery).
+					WithArgs(2, userIdInt).
+					WillReturnRows(sqlmock.NewRows([]string{"author_id", "role"}).AddRow(1, test.role))
+			} else {
+				query.WillReturnError(sql2.ErrNoRows)
+			}
+			if test.outputErr == nil {
+				mock.ExpectExec(deleteSeriesQuery).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+		}
+		actualErr := repositoryTest.DeleteSeries(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+func TestGetOpenSeries(t *testing.T) {
+	columns := []string{"id", "author_id", "rrule", "exdates"}
+	for i, postgresErr := range []error{nil, sql2.ErrConnDone} {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		query := mock.ExpectQuery(getOpenSeriesQuery)
+		if postgresErr == nil {
+			query.WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(5, 1, "FREQ=DAILY", "{2031-11-04}").
+				AddRow(6, 2, "FREQ=WEEKLY", "{}"))
+		} else {
+			query.WillReturnError(postgresErr)
+		}
+		actual, actualErr := repositoryTest.GetOpenSeries()
+		if postgresErr == nil {
+			require.NoError(t, actualErr, i)
+			require.Equal(t, []*models.Series{
+				{
+					ID:       "5",
+					AuthorId: "1",
+					RRule:    "FREQ=DAILY",
+					ExDates:  []time.Time{time.Date(2031, 11, 4, 0, 0, 0, 0, time.UTC)},
+				},
+				{
+					ID:       "6",
+					AuthorId: "2",
+					RRule:    "FREQ=WEEKLY",
+				},
+			}, actual, i)
+		} else {
+			require.Equal(t, error2.ErrPostgres, actualErr, i)
+		}
+		require.NoError(t, mock.ExpectationsWereMet(), i)
+		db.Close()
+	}
+}
+
+var getLastOccurrenceTests = []struct {
+	id          int
+	seriesId    string
+	postgresErr error
+	outputErr   error
+}{
+	{1, "5", nil, nil},
+	{2, "5", sql2.ErrNoRows, error2.ErrNoRows},
+	{3, "5", sql2.ErrConnDone, error2.ErrPostgres},
+	{4, "a", nil, error2.ErrAtoi},
+}
+
+func TestGetLastOccurrence(t *testing.T) {
+	columns := []string{"id", "title", "start_date", "series_id"}
+	for _, test := range getLastOccurrenceTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			query := mock.ExpectQuery(getLastOccurrenceQuery).WithArgs(5)
+			if test.postgresErr != nil {
+				query.WillReturnError(test.postgresErr)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows(columns).AddRow(12, "test", seriesStart, 5))
+			}
+		}
+		actual, actualErr := repositoryTest.GetLastOccurrence(test.seriesId)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		if test.outputErr == nil {
+			require.Equal(t, "12", actual.ID, test.id)
+			require.Equal(t, "5", actual.SeriesId, test.id)
+			require.Equal(t, seriesStart, actual.StartDate, test.id)
+		} else {
+			require.Nil(t, actual, test.id)
+		}
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var extendSeriesTests = []struct {
+	id          int
+	event       *models.Event
+	occurrences []time.Time
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		&models.Event{
+			AuthorId:  "1",
+			SeriesId:  "5",
+			StartDate: seriesStart,
+			EndDate:   seriesStart.Add(time.Hour),
+			RRule:     "FREQ=WEEKLY",
+		},
+		[]time.Time{seriesStart.AddDate(0, 0, 7), seriesStart.AddDate(0, 0, 14)},
+		nil,
+		nil,
+	},
+	{
+		2,
+		&models.Event{
+			AuthorId: "1",
+			SeriesId: "5",
+		},
+		[]time.Time{seriesStart},
+		sql2.ErrConnDone,
+		error2.ErrPostgres,
+	},
+	{
+		3,
+		&models.Event{
+			AuthorId: "1",
+		},
+		nil,
+		nil,
+		error2.ErrAtoi,
+	},
+}
+
+func TestExtendSeries(t *testing.T) {
+	for _, test := range extendSeriesTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			mock.ExpectBegin()
+			for j, start := range test.occurrences {
+				query := mock.ExpectQuery(createOccurrenceQuery).WithArgs(occurrenceArgs(test.event, 5, start)...)
+				if test.postgresErr != nil {
+					query.WillReturnError(test.postgresErr)
+				} else {
+					query.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10 + j))
+				}
+			}
+			if test.postgresErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+		}
+		actualErr := repositoryTest.ExtendSeries(test.event, test.occurrences)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
 var getEventByIdTests = []struct {
 	id          int
 	eventId     string
@@ -400,8 +939,11 @@ var getEventsTests = []struct {
 	title       string
 	category    string
 	city        string
-	date        string
+	from        time.Time
+	to          time.Time
 	tags        []string
+	near        *models.GeoCircle
+	snippet     string
 	postgresErr error
 	outputRes   []*models.Event
 	outputErr   error
@@ -412,24 +954,47 @@ var getEventsTests = []struct {
 		title:       "test",
 		category:    "test",
 		city:        "test",
-		date:        "test",
+		from:        time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+		to:          time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
 		tags:        []string{"test"},
+		snippet:     "<b>test</b>",
 		postgresErr: nil,
 		outputRes: []*models.Event{
 			&models.Event{
 				ID:       "1",
 				AuthorId: "0",
+				Snippet:  "<b>test</b>",
 			},
 		},
 		outputErr: nil,
 	},
 	{
-		id:          2,
-		userId:      "",
-		title:       "",
+		id:       4,
+		userId:   "",
+		title:    "c++ (base)",
+		category: "",
+		city:     "",
+		tags:     nil,
+		near: &models.GeoCircle{
+			Latitude:  55.75,
+			Longitude: 37.61,
+			RadiusKm:  10,
+		},
+		postgresErr: nil,
+		outputRes: []*models.Event{
+			&models.Event{
+				ID:       "1",
+				AuthorId: "0",
+			},
+		},
+		outputErr: nil,
+	},
+	{
+		id:          2,
+		userId:      "",
+		title:       "",
 		category:    "",
 		city:        "",
-		date:        "",
 		tags:        nil,
 		postgresErr: nil,
 		outputRes: []*models.Event{
@@ -446,7 +1011,6 @@ var getEventsTests = []struct {
 		title:       "",
 		category:    "",
 		city:        "",
-		date:        "",
 		tags:        nil,
 		postgresErr: sql2.ErrNoRows,
 		outputRes:   []*models.Event{},
@@ -474,14 +1038,12 @@ func TestGetEvents(t *testing.T) {
 		for i := range test.tags {
 			postgresTags[i] = test.tags[i]
 		}
-		query := `select e.*, count(v) from event as e
-				left join visitor as v on e.id = v.event_id and `
+		condition, rank, snippet := searchExpressions(test.title)
+		query := `select e.*, count(v), ` + visitorsColumn + `, ` + waitlistPositionColumn + `,
+				` + rank + ` as rank, ` + snippet + ` as snippet from event as e
+				left join visitor as v on e.id = v.event_id and v.status = 'going' and `
 		query += `v.user_id = $1 `
-		if test.title != "" {
-			query += `where lower(title) ~ lower($2) and `
-		} else {
-			query += `where $2 = $2 and `
-		}
+		query += `where ` + condition + ` and `
 		if test.category != "" {
 			query += `lower(category) = lower($3) and `
 		} else {
@@ -492,16 +1054,29 @@ func TestGetEvents(t *testing.T) {
 		} else {
 			query += `$4 = $4 and `
 		}
-		if test.date != "" {
-			query += `lower(e.date) = lower($5) and `
+		if !test.from.IsZero() {
+			query += `e.end_date >= $5 and `
 		} else {
 			query += `$5 = $5 and `
 		}
+		if !test.to.IsZero() {
+			query += `e.start_date <= $6 and `
+		} else {
+			query += `$6 = $6 and `
+		}
 		if len(postgresTags) != 0 {
-			query += `tag && $6::varchar[] `
+			query += `tag && $7::varchar[] and `
+		} else {
+			query += `$7 = $7 and `
+		}
+		nearArgs := []driver.Value{0.0, 0.0, 0.0}
+		if test.near != nil {
+			query += radiusCondition + ` `
+			nearArgs = []driver.Value{test.near.Latitude, test.near.Longitude, test.near.RadiusKm}
 		} else {
-			query += `$6 = $6 `
+			query += `$8 = $8 and $9 = $9 and $10 = $10 `
 		}
+		query += `and (` + rank + `, e.viewed, e.id) < ($11, $12, $13) `
 		query += `group by e.id,
          e.title,
          e.description,
@@ -510,20 +1085,29 @@ func TestGetEvents(t *testing.T) {
          e.category,
          e.viewed,
          e.img_url,
-         e.date,
-         e.geo,
+         e.start_date,
+         e.end_date,
+         e.timezone,
+         e.latitude,
+         e.longitude,
          e.address,
          e.tag,
-         e.author_id 
-         order by viewed DESC`
+         e.author_id,
+         e.capacity,
+         e.series_id,
+         e.occurrence_date,
+         e.detached,
+         e.updated_at 
+         order by rank DESC, viewed DESC, e.id DESC limit $14`
 
-		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+		rows := sqlmock.NewRows([]string{"id", "snippet"}).AddRow(1, test.snippet)
 
 		mock.ExpectQuery(query).
-			WithArgs(userIdInt, test.title, test.category, test.city, test.date, postgresTags).
+			WithArgs(userIdInt, test.title, test.category, test.city, test.from, test.to, postgresTags,
+				nearArgs[0], nearArgs[1], nearArgs[2], math.MaxFloat32, math.MaxInt64, math.MaxInt32, defaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
-		out, actualErr := repositoryTest.GetEvents(test.userId, test.title, test.category, test.city, test.date, test.tags)
+		out, _, actualErr := repositoryTest.GetEvents(test.userId, test.title, test.category, test.city, test.from, test.to, test.tags, test.near, nil)
 		require.Equal(t, test.outputErr, actualErr)
 		if test.outputErr != nil {
 			require.Equal(t, []*models.Event(nil), out)
@@ -533,6 +1117,46 @@ func TestGetEvents(t *testing.T) {
 	}
 }
 
+var searchExpressionsTests = []struct {
+	id        int
+	title     string
+	condition string
+	rank      string
+	snippet   string
+}{
+	{
+		id:        1,
+		title:     "",
+		condition: `$2 = $2`,
+		rank:      `0::real`,
+		snippet:   `''`,
+	},
+	{
+		id:        2,
+		title:     "концерты",
+		condition: `event_search_vector(e.title, e.description, e.text, e.tag) @@ websearch_to_tsquery('russian', $2)`,
+		rank:      `ts_rank(event_search_vector(e.title, e.description, e.text, e.tag), websearch_to_tsquery('russian', $2))`,
+		snippet: `ts_headline('russian', e.description || ' ' || e.text, websearch_to_tsquery('russian', $2), ` +
+			`'StartSel=<b>, StopSel=</b>, MaxWords=25, MinWords=10, MaxFragments=2')`,
+	},
+	{
+		id:        3,
+		title:     "[c++",
+		condition: `strpos(lower(e.title), lower($2)) > 0`,
+		rank:      `0::real`,
+		snippet:   `''`,
+	},
+}
+
+func TestSearchExpressions(t *testing.T) {
+	for _, test := range searchExpressionsTests {
+		condition, rank, snippet := searchExpressions(test.title)
+		require.Equal(t, test.condition, condition, strconv.Itoa(test.id))
+		require.Equal(t, test.rank, rank, strconv.Itoa(test.id))
+		require.Equal(t, test.snippet, snippet, strconv.Itoa(test.id))
+	}
+}
+
 var getVisitedEventsTests = []struct {
 	id          int
 	userId      string
@@ -584,10 +1208,10 @@ func TestGetVisitedEvents(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
 		mock.ExpectQuery(visitedQuery).
-			WithArgs(userIdInt).
+			WithArgs(userIdInt, math.MaxInt64, math.MaxInt32, defaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
-		out, actualErr := repositoryTest.GetVisitedEvents(test.userId)
+		out, _, actualErr := repositoryTest.GetVisitedEvents(test.userId, &models.Page{})
 		require.Equal(t, test.outputErr, actualErr)
 		if test.outputErr != nil {
 			require.Equal(t, []*models.Event(nil), out)
@@ -648,10 +1272,10 @@ func TestGetCreatedEvents(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
 		mock.ExpectQuery(createdQuery).
-			WithArgs(userIdInt).
+			WithArgs(userIdInt, math.MaxInt64, math.MaxInt32, defaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
-		out, actualErr := repositoryTest.GetCreatedEvents(test.userId)
+		out, _, actualErr := repositoryTest.GetCreatedEvents(test.userId, &models.Page{})
 		require.Equal(t, test.outputErr, actualErr)
 		if test.outputErr != nil {
 			require.Equal(t, []*models.Event(nil), out)
@@ -661,159 +1285,227 @@ func TestGetCreatedEvents(t *testing.T) {
 	}
 }
 
-var visitTests = []struct {
-	id          int
-	eventId     string
-	userId      string
-	postgresErr error
-	outputErr   error
+var getEventsPageTests = []struct {
+	id           int
+	page         *models.Page
+	rowsCount    int
+	queryViewed  int
+	queryId      int
+	queryLimit   int
+	outputCount  int
+	outputCursor string
+	outputErr    error
 }{
 	{
 		1,
-		"1",
-		"2",
-		nil,
+		&models.Page{
+			Limit: 2,
+		},
+		3,
+		math.MaxInt64,
+		math.MaxInt32,
+		3,
+		2,
+		encodeCursor(&Event{ID: 2, Viewed: 100}),
 		nil,
 	},
 	{
 		2,
-		"a",
-		"b",
+		&models.Page{
+			Limit:  2,
+			Cursor: encodeCursor(&Event{ID: 2, Viewed: 100}),
+		},
+		1,
+		100,
+		2,
+		3,
+		1,
+		"",
 		nil,
-		error2.ErrAtoi,
 	},
 	{
 		3,
-		"1",
-		"b",
+		&models.Page{
+			Limit: 1000,
+		},
+		1,
+		math.MaxInt64,
+		math.MaxInt32,
+		maxPageLimit + 1,
+		1,
+		"",
 		nil,
-		error2.ErrAtoi,
 	},
 	{
 		4,
-		"1",
-		"2",
-		sql2.ErrConnDone,
-		error2.ErrPostgres,
+		&models.Page{
+			Cursor: "not a cursor",
+		},
+		0,
+		0,
+		0,
+		0,
+		0,
+		"",
+		error2.ErrCursor,
 	},
 }
 
-func TestVisit(t *testing.T) {
+func TestGetEventsPage(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err, logMessage, err)
 	defer db.Close()
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	repositoryTest := NewRepository(sqlxDB)
 
-	for _, test := range visitTests {
-		eventIdInt, err := strconv.Atoi(test.eventId)
-		if err != nil {
-			eventIdInt = 0
+	for _, test := range getEventsPageTests {
+		rows := sqlmock.NewRows([]string{"id", "viewed"})
+		for i := 1; i <= test.rowsCount; i++ {
+			rows.AddRow(i, 100)
 		}
-		userIdInt, err := strconv.Atoi(test.userId)
-		if err != nil {
-			userIdInt = 0
+		if test.outputErr == nil {
+			mock.ExpectQuery(createdQuery).
+				WithArgs(1, test.queryViewed, test.queryId, test.queryLimit).
+				WillReturnRows(rows)
 		}
+		out, nextCursor, actualErr := repositoryTest.GetCreatedEvents("1", test.page)
+		require.Equal(t, test.outputErr, actualErr, strconv.Itoa(test.id))
+		require.Equal(t, test.outputCount, len(out), strconv.Itoa(test.id))
+		require.Equal(t, test.outputCursor, nextCursor, strconv.Itoa(test.id))
+	}
+}
 
-		rows := sqlmock.NewRows([]string{})
-		mock.ExpectQuery(visitQuery).
-			WithArgs(eventIdInt, userIdInt).
-			WillReturnRows(rows).
-			WillReturnError(test.postgresErr)
-		actualErr := repositoryTest.Visit(test.eventId, test.userId)
-		require.Equal(t, test.outputErr, actualErr)
+var decodeCursorTests = []struct {
+	id        int
+	encoded   string
+	outputRes *cursor
+	outputErr error
+}{
+	{
+		1,
+		encodeCursor(&Event{ID: 2, Viewed: 100}),
+		&cursor{Viewed: 100, ID: 2},
+		nil,
+	},
+	{
+		2,
+		encodeCursor(&Event{ID: 2, Viewed: 100, Rank: 0.0607927}),
+		&cursor{Rank: 0.0607927, Viewed: 100, ID: 2},
+		nil,
+	},
+	{
+		3,
+		"MTAwOjI6eA",
+		nil,
+		error2.ErrCursor,
+	},
+}
+
+func TestDecodeCursor(t *testing.T) {
+	for _, test := range decodeCursorTests {
+		out, actualErr := decodeCursor(test.encoded)
+		require.Equal(t, test.outputErr, actualErr, strconv.Itoa(test.id))
+		require.Equal(t, test.outputRes, out, strconv.Itoa(test.id))
 	}
 }
 
-var unvisitTests = []struct {
+var getEventsMapTests = []struct {
 	id          int
-	eventId     string
-	userId      string
+	cellSize    float64
+	rows        *sqlmock.Rows
 	postgresErr error
+	outputRes   *models.EventMap
 	outputErr   error
 }{
 	{
 		1,
-		"1",
-		"2",
+		0,
+		sqlmock.NewRows([]string{"id", "title", "latitude", "longitude", "count"}).
+			AddRow(1, "test", 55.75, 37.61, 1),
 		nil,
+		&models.EventMap{
+			Pins: []*models.MapPin{
+				{EventId: "1", Title: "test", Latitude: 55.75, Longitude: 37.61},
+			},
+			Clusters: []*models.MapCluster{},
+		},
 		nil,
 	},
 	{
 		2,
-		"a",
-		"b",
+		0.5,
+		sqlmock.NewRows([]string{"id", "title", "latitude", "longitude", "count"}).
+			AddRow(1, "test", 55.75, 37.61, 1).
+			AddRow(2, "test", 59.93, 30.33, 3),
 		nil,
-		error2.ErrAtoi,
-	},
-	{
-		3,
-		"1",
-		"b",
+		&models.EventMap{
+			Pins: []*models.MapPin{
+				{EventId: "1", Title: "test", Latitude: 55.75, Longitude: 37.61},
+			},
+			Clusters: []*models.MapCluster{
+				{Latitude: 59.93, Longitude: 30.33, Count: 3},
+			},
+		},
 		nil,
-		error2.ErrAtoi,
 	},
 	{
-		4,
-		"1",
-		"2",
+		3,
+		0.5,
+		sqlmock.NewRows([]string{"id"}),
 		sql2.ErrConnDone,
+		nil,
 		error2.ErrPostgres,
 	},
 }
 
-func TestUnvisit(t *testing.T) {
-	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-	require.NoError(t, err, logMessage, err)
-	defer db.Close()
-	sqlxDB := sqlx.NewDb(db, "sqlmock")
-	repositoryTest := NewRepository(sqlxDB)
+func TestGetEventsMap(t *testing.T) {
+	for _, test := range getEventsMapTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
 
-	for _, test := range unvisitTests {
-		eventIdInt, err := strconv.Atoi(test.eventId)
-		if err != nil {
-			eventIdInt = 0
-		}
-		userIdInt, err := strconv.Atoi(test.userId)
-		if err != nil {
-			userIdInt = 0
+		bbox := &models.BBox{MinLatitude: 50, MinLongitude: 30, MaxLatitude: 60, MaxLongitude: 40}
+		if test.cellSize > 0 {
+			mock.ExpectQuery(mapClustersQuery).
+				WithArgs(50.0, 30.0, 60.0, 40.0, test.cellSize).
+				WillReturnRows(test.rows).
+				WillReturnError(test.postgresErr)
+		} else {
+			mock.ExpectQuery(mapPinsQuery).
+				WithArgs(50.0, 30.0, 60.0, 40.0, maxMapPins).
+				WillReturnRows(test.rows).
+				WillReturnError(test.postgresErr)
 		}
-
-		rows := sqlmock.NewRows([]string{})
-		mock.ExpectQuery(unvisitQuery).
-			WithArgs(eventIdInt, userIdInt).
-			WillReturnRows(rows).
-			WillReturnError(test.postgresErr)
-		actualErr := repositoryTest.Unvisit(test.eventId, test.userId)
-		require.Equal(t, test.outputErr, actualErr)
+		out, actualErr := repositoryTest.GetEventsMap(bbox, test.cellSize)
+		require.Equal(t, test.outputErr, actualErr, strconv.Itoa(test.id))
+		require.Equal(t, test.outputRes, out, strconv.Itoa(test.id))
+		db.Close()
 	}
 }
 
-var isVisitedTests = []struct {
+var visitTests = []struct {
 	id          int
 	eventId     string
 	userId      string
-	count       int
-	result      bool
+	capacity    int
+	visited     int
+	visitors    int
+	position    int
 	postgresErr error
+	output      int
 	outputErr   error
 }{
 	{
 		1,
 		"1",
 		"2",
+		0,
+		0,
 		10,
-		true,
-		nil,
-		nil,
-	},
-	{
-		1,
-		"1",
-		"2",
 		0,
-		false,
 		nil,
+		0,
 		nil,
 	},
 	{
@@ -821,8 +1513,11 @@ var isVisitedTests = []struct {
 		"a",
 		"b",
 		0,
-		false,
+		0,
+		0,
+		0,
 		nil,
+		0,
 		error2.ErrAtoi,
 	},
 	{
@@ -830,8 +1525,11 @@ var isVisitedTests = []struct {
 		"1",
 		"b",
 		0,
-		false,
+		0,
+		0,
+		0,
 		nil,
+		0,
 		error2.ErrAtoi,
 	},
 	{
@@ -839,40 +1537,910 @@ var isVisitedTests = []struct {
 		"1",
 		"2",
 		0,
-		false,
+		0,
+		0,
+		0,
 		sql2.ErrConnDone,
+		0,
 		error2.ErrPostgres,
 	},
+	{
+		5,
+		"1",
+		"2",
+		0,
+		0,
+		0,
+		0,
+		sql2.ErrNoRows,
+		0,
+		error2.ErrNoRows,
+	},
+	{
+		6,
+		"1",
+		"2",
+		5,
+		0,
+		4,
+		0,
+		nil,
+		0,
+		nil,
+	},
+	{
+		7,
+		"1",
+		"2",
+		5,
+		0,
+		5,
+		3,
+		nil,
+		3,
+		nil,
+	},
+	{
+		8,
+		"1",
+		"2",
+		5,
+		1,
+		5,
+		0,
+		nil,
+		0,
+		nil,
+	},
 }
 
-func TestIsVisited(t *testing.T) {
-	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-	require.NoError(t, err, logMessage, err)
-	defer db.Close()
-	sqlxDB := sqlx.NewDb(db, "sqlmock")
-	repositoryTest := NewRepository(sqlxDB)
+func TestVisit(t *testing.T) {
+	for _, test := range visitTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
 
-	for _, test := range isVisitedTests {
-		eventIdInt, err := strconv.Atoi(test.eventId)
-		if err != nil {
-			eventIdInt = 0
-		}
-		userIdInt, err := strconv.Atoi(test.userId)
-		if err != nil {
-			userIdInt = 0
+		eventIdInt, errEvent := strconv.Atoi(test.eventId)
+		_, errUser := strconv.Atoi(test.userId)
+		if errEvent == nil && errUser == nil {
+			userIdInt, _ := strconv.Atoi(test.userId)
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockEventQuery).
+				WithArgs(eventIdInt).
+				WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(test.capacity)).
+				WillReturnError(test.postgresErr)
+			if test.postgresErr == nil {
+				mock.ExpectQuery(isVisitedQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(test.visited))
+			}
+			if test.postgresErr == nil && test.visited == 0 {
+				mock.ExpectQuery(countVisitorsQuery).
+					WithArgs(eventIdInt).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(test.visitors))
+				if test.capacity == 0 || test.visitors < test.capacity {
+					mock.ExpectExec(unwaitQuery).
+						WithArgs(eventIdInt, userIdInt).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectExec(visitQuery).
+						WithArgs(eventIdInt, userIdInt).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				} else {
+					mock.ExpectExec(waitQuery).
+						WithArgs(eventIdInt, userIdInt).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectQuery(waitlistPositionQuery).
+						WithArgs(eventIdInt, userIdInt).
+						WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(test.position))
+				}
+				mock.ExpectCommit()
+			} else if test.postgresErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
 		}
+		actual, actualErr := repositoryTest.Visit(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
 
-		rows := sqlmock.NewRows([]string{"count(*)"}).AddRow(test.count)
-		mock.ExpectQuery(isVisitedQuery).
-			WithArgs(eventIdInt, userIdInt).
-			WillReturnRows(rows).
-			WillReturnError(test.postgresErr)
-		out, actualErr := repositoryTest.IsVisited(test.eventId, test.userId)
+var unvisitTests = []struct {
+	id          int
+	eventId     string
+	userId      string
+	capacity    int
+	visitors    int
+	nextUserId  int
+	postgresErr error
+	output      string
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"2",
+		0,
+		0,
+		0,
+		nil,
+		"",
+		nil,
+	},
+	{
+		2,
+		"a",
+		"b",
+		0,
+		0,
+		0,
+		nil,
+		"",
+		error2.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		"b",
+		0,
+		0,
+		0,
+		nil,
+		"",
+		error2.ErrAtoi,
+	},
+	{
+		4,
+		"1",
+		"2",
+		0,
+		0,
+		0,
+		sql2.ErrConnDone,
+		"",
+		error2.ErrPostgres,
+	},
+	{
+		5,
+		"1",
+		"2",
+		5,
+		4,
+		7,
+		nil,
+		"7",
+		nil,
+	},
+	{
+		6,
+		"1",
+		"2",
+		5,
+		4,
+		0,
+		nil,
+		"",
+		nil,
+	},
+	{
+		7,
+		"1",
+		"2",
+		5,
+		5,
+		0,
+		nil,
+		"",
+		nil,
+	},
+}
+
+func TestUnvisit(t *testing.T) {
+	for _, test := range unvisitTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		eventIdInt, errEvent := strconv.Atoi(test.eventId)
+		_, errUser := strconv.Atoi(test.userId)
+		if errEvent == nil && errUser == nil {
+			userIdInt, _ := strconv.Atoi(test.userId)
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockEventQuery).
+				WithArgs(eventIdInt).
+				WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(test.capacity)).
+				WillReturnError(test.postgresErr)
+			if test.postgresErr == nil {
+				mock.ExpectExec(unwaitQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(unvisitQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				if test.capacity > 0 {
+					mock.ExpectQuery(countVisitorsQuery).
+						WithArgs(eventIdInt).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(test.visitors))
+				}
+				if test.capacity > 0 && test.visitors < test.capacity {
+					if test.nextUserId == 0 {
+						mock.ExpectQuery(nextWaitlistedQuery).
+							WithArgs(eventIdInt).
+							WillReturnError(sql2.ErrNoRows)
+					} else {
+						mock.ExpectQuery(nextWaitlistedQuery).
+							WithArgs(eventIdInt).
+							WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(test.nextUserId))
+						mock.ExpectExec(visitQuery).
+							WithArgs(eventIdInt, test.nextUserId).
+							WillReturnResult(sqlmock.NewResult(1, 1))
+					}
+				}
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+		}
+		actual, actualErr := repositoryTest.Unvisit(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var isVisitedTests = []struct {
+	id          int
+	eventId     string
+	userId      string
+	count       int
+	position    int
+	result      bool
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"2",
+		10,
+		0,
+		true,
+		nil,
+		nil,
+	},
+	{
+		1,
+		"1",
+		"2",
+		0,
+		0,
+		false,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"a",
+		"b",
+		0,
+		0,
+		false,
+		nil,
+		error2.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		"b",
+		0,
+		0,
+		false,
+		nil,
+		error2.ErrAtoi,
+	},
+	{
+		4,
+		"1",
+		"2",
+		0,
+		0,
+		false,
+		sql2.ErrConnDone,
+		error2.ErrPostgres,
+	},
+	{
+		5,
+		"1",
+		"2",
+		0,
+		2,
+		false,
+		nil,
+		nil,
+	},
+}
+
+func TestIsVisited(t *testing.T) {
+	for _, test := range isVisitedTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		eventIdInt, errEvent := strconv.Atoi(test.eventId)
+		userIdInt, errUser := strconv.Atoi(test.userId)
+		if errEvent == nil && errUser == nil {
+			rows := sqlmock.NewRows([]string{"count(*)"}).AddRow(test.count)
+			mock.ExpectQuery(isVisitedQuery).
+				WithArgs(eventIdInt, userIdInt).
+				WillReturnRows(rows).
+				WillReturnError(test.postgresErr)
+		}
+		if test.count == 0 && test.postgresErr == nil && test.outputErr == nil {
+			if test.position == 0 {
+				mock.ExpectQuery(waitlistPositionQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnError(sql2.ErrNoRows)
+			} else {
+				mock.ExpectQuery(waitlistPositionQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(test.position))
+			}
+		}
+		out, position, actualErr := repositoryTest.IsVisited(test.eventId, test.userId)
 		require.Equal(t, test.outputErr, actualErr)
 		require.Equal(t, test.result, out)
+		require.Equal(t, test.position, position)
+		require.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
 	}
 }
 
+var setRSVPTests = []struct {
+	id          int
+	eventId     string
+	userId      string
+	status      string
+	capacity    int
+	visitors    int
+	nextUserId  int
+	postgresErr error
+	output      string
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"2",
+		"maybe",
+		0,
+		0,
+		0,
+		nil,
+		"",
+		nil,
+	},
+	{
+		2,
+		"a",
+		"2",
+		"maybe",
+		0,
+		0,
+		0,
+		nil,
+		"",
+		error2.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		"2",
+		"declined",
+		0,
+		0,
+		0,
+		sql2.ErrConnDone,
+		"",
+		error2.ErrPostgres,
+	},
+	{
+		4,
+		"1",
+		"2",
+		"declined",
+		5,
+		4,
+		7,
+		nil,
+		"7",
+		nil,
+	},
+}
+
+func TestSetRSVP(t *testing.T) {
+	for _, test := range setRSVPTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		eventIdInt, errEvent := strconv.Atoi(test.eventId)
+		userIdInt, errUser := strconv.Atoi(test.userId)
+		if errEvent == nil && errUser == nil {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockEventQuery).
+				WithArgs(eventIdInt).
+				WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(test.capacity)).
+				WillReturnError(test.postgresErr)
+			if test.postgresErr == nil {
+				mock.ExpectExec(unwaitQuery).
+					WithArgs(eventIdInt, userIdInt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(setRSVPQuery).
+					WithArgs(eventIdInt, userIdInt, test.status).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				if test.capacity > 0 {
+					mock.ExpectQuery(countVisitorsQuery).
+						WithArgs(eventIdInt).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(test.visitors))
+					mock.ExpectQuery(nextWaitlistedQuery).
+						WithArgs(eventIdInt).
+						WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(test.nextUserId))
+					mock.ExpectExec(visitQuery).
+						WithArgs(eventIdInt, test.nextUserId).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				}
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+		}
+		actual, actualErr := repositoryTest.SetRSVP(test.eventId, test.userId, test.status)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var getRSVPTests = []struct {
+	id          int
+	eventId     string
+	userId      string
+	status      string
+	position    int
+	postgresErr error
+	output      *models.RSVP
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"2",
+		"going",
+		0,
+		nil,
+		&models.RSVP{Status: models.RSVPGoing},
+		nil,
+	},
+	{
+		2,
+		"1",
+		"2",
+		"maybe",
+		0,
+		nil,
+		&models.RSVP{Status: models.RSVPMaybe},
+		nil,
+	},
+	{
+		3,
+		"1",
+		"2",
+		"maybe",
+		2,
+		nil,
+		&models.RSVP{Status: models.RSVPGoing, WaitlistPosition: 2},
+		nil,
+	},
+	{
+		4,
+		"1",
+		"2",
+		"",
+		0,
+		nil,
+		&models.RSVP{},
+		nil,
+	},
+	{
+		5,
+		"1",
+		"b",
+		"",
+		0,
+		nil,
+		nil,
+		error2.ErrAtoi,
+	},
+	{
+		6,
+		"1",
+		"2",
+		"",
+		0,
+		sql2.ErrConnDone,
+		nil,
+		error2.ErrPostgres,
+	},
+}
+
+func TestGetRSVP(t *testing.T) {
+	for _, test := range getRSVPTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		eventIdInt, errEvent := strconv.Atoi(test.eventId)
+		userIdInt, errUser := strconv.Atoi(test.userId)
+		if errEvent == nil && errUser == nil {
+			statusQuery := mock.ExpectQuery(getRSVPQuery).WithArgs(eventIdInt, userIdInt)
+			switch {
+			case test.postgresErr != nil:
+				statusQuery.WillReturnError(test.postgresErr)
+			case test.status == "":
+				statusQuery.WillReturnError(sql2.ErrNoRows)
+			default:
+				statusQuery.WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(test.status))
+			}
+			if test.postgresErr == nil && test.status != models.RSVPGoing {
+				positionQuery := mock.ExpectQuery(waitlistPositionQuery).WithArgs(eventIdInt, userIdInt)
+				if test.position == 0 {
+					positionQuery.WillReturnError(sql2.ErrNoRows)
+				} else {
+					positionQuery.WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(test.position))
+				}
+			}
+		}
+		actual, actualErr := repositoryTest.GetRSVP(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var createInvitationsTests = []struct {
+	id          int
+	eventId     string
+	inviterId   string
+	receiverIds []string
+	duplicate   bool
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"2",
+		[]string{"3", "4"},
+		false,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"1",
+		"2",
+		[]string{"3", "a"},
+		false,
+		nil,
+		error2.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		"2",
+		[]string{"3", "4"},
+		true,
+		nil,
+		error2.ErrInvitationExists,
+	},
+	{
+		4,
+		"1",
+		"2",
+		[]string{"3"},
+		false,
+		sql2.ErrConnDone,
+		error2.ErrPostgres,
+	},
+}
+
+func TestCreateInvitations(t *testing.T) {
+	for _, test := range createInvitationsTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			eventIdInt, _ := strconv.Atoi(test.eventId)
+			inviterIdInt, _ := strconv.Atoi(test.inviterId)
+			mock.ExpectBegin()
+			for j, receiverId := range test.receiverIds {
+				receiverIdInt, _ := strconv.Atoi(receiverId)
+				query := mock.ExpectQuery(createInvitationQuery).WithArgs(eventIdInt, inviterIdInt, receiverIdInt)
+				last := j == len(test.receiverIds)-1
+				switch {
+				case last && test.duplicate:
+					query.WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				case last && test.postgresErr != nil:
+					query.WillReturnError(test.postgresErr)
+				default:
+					query.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(j + 1))
+				}
+			}
+			if test.outputErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+		}
+		actualErr := repositoryTest.CreateInvitations(test.eventId, test.inviterId, test.receiverIds)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+var respondInvitationTests = []struct {
+	id            int
+	invitationId  string
+	receiverId    string
+	status        string
+	updated       bool
+	currentStatus string
+	visitErr      error
+	output        *models.Invitation
+	position      int
+	outputErr     error
+}{
+	{
+		1,
+		"1",
+		"2",
+		models.InvitationAccepted,
+		true,
+		"accepted",
+		nil,
+		&models.Invitation{ID: "1", EventId: "3", InviterId: "4", ReceiverId: "2", Status: "accepted"},
+		2,
+		nil,
+	},
+	{
+		2,
+		"1",
+		"2",
+		models.InvitationAccepted,
+		false,
+		"expired",
+		nil,
+		nil,
+		0,
+		error2.ErrInvitationExpired,
+	},
+	{
+		3,
+		"1",
+		"2",
+		models.InvitationAccepted,
+		false,
+		"declined",
+		nil,
+		nil,
+		0,
+		error2.ErrInvitationAnswered,
+	},
+	{
+		4,
+		"1",
+		"2",
+		models.InvitationAccepted,
+		false,
+		"",
+		nil,
+		nil,
+		0,
+		error2.ErrNoRows,
+	},
+	{
+		5,
+		"a",
+		"2",
+		models.InvitationAccepted,
+		false,
+		"",
+		nil,
+		nil,
+		0,
+		error2.ErrAtoi,
+	},
+	{
+		6,
+		"1",
+		"2",
+		models.InvitationDeclined,
+		true,
+		"declined",
+		nil,
+		&models.Invitation{ID: "1", EventId: "3", InviterId: "4", ReceiverId: "2", Status: "declined"},
+		0,
+		nil,
+	},
+	{
+		7,
+		"1",
+		"2",
+		models.InvitationAccepted,
+		true,
+		"accepted",
+		sql2.ErrConnDone,
+		nil,
+		0,
+		error2.ErrPostgres,
+	},
+}
+
+func TestRespondInvitation(t *testing.T) {
+	columns := []string{"id", "event_id", "title", "inviter_id", "receiver_id", "status", "created_at"}
+	for _, test := range respondInvitationTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		if test.outputErr != error2.ErrAtoi {
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(respondInvitationQuery).WithArgs(1, 2, test.status)
+			if test.updated {
+				query.WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 3, "", 4, 2, test.currentStatus, time.Time{}))
+			} else {
+				query.WillReturnError(sql2.ErrNoRows)
+				getQuery := mock.ExpectQuery(getInvitationQuery).WithArgs(1, 2)
+				if test.currentStatus == "" {
+					getQuery.WillReturnError(sql2.ErrNoRows)
+				} else {
+					getQuery.WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 3, "", 4, 2, test.currentStatus, time.Time{}))
+				}
+			}
+			if test.updated && test.status == models.InvitationAccepted {
+				lock := mock.ExpectQuery(lockEventQuery).WithArgs(3)
+				if test.visitErr != nil {
+					lock.WillReturnError(test.visitErr)
+				} else {
+					lock.WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(1))
+					mock.ExpectQuery(isVisitedQuery).WithArgs(3, 2).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+					mock.ExpectQuery(countVisitorsQuery).WithArgs(3).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+					mock.ExpectExec(waitQuery).WithArgs(3, 2).WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectQuery(waitlistPositionQuery).WithArgs(3, 2).
+						WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(test.position))
+				}
+			}
+			if test.outputErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+		}
+		actual, position, actualErr := repositoryTest.RespondInvitation(test.invitationId, test.receiverId, test.status)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.Equal(t, test.output, actual, test.id)
+		require.Equal(t, test.position, position, test.id)
+		require.NoError(t, mock.ExpectationsWereMet(), test.id)
+		db.Close()
+	}
+}
+
+func TestGetUserInvitations(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	columns := []string{"id", "event_id", "title", "inviter_id", "receiver_id", "status", "created_at"}
+	mock.ExpectQuery(getUserInvitationsQuery).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 3, "test", 4, 2, "expired", time.Time{}))
+	out, err := repositoryTest.GetUserInvitations("2")
+	require.NoError(t, err)
+	require.Equal(t, []*models.Invitation{
+		{ID: "1", EventId: "3", EventTitle: "test", InviterId: "4", ReceiverId: "2", Status: models.InvitationExpired},
+	}, out)
+
+	mock.ExpectQuery(getEventInvitationsQuery).
+		WithArgs(3, 4).
+		WillReturnError(sql2.ErrConnDone)
+	out, err = repositoryTest.GetEventInvitations("3", "4")
+	require.Equal(t, error2.ErrPostgres, err)
+	require.Nil(t, out)
+}
+
+func TestGetCalendarEvents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	updatedAt := time.Date(2021, 11, 20, 10, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(calendarEventsQuery).
+		WithArgs(2, maxCalendarEvents).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "author_id", "updated_at"}).AddRow(1, "test", 2, updatedAt))
+	out, err := repositoryTest.GetCalendarEvents("2")
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, "test", out[0].Title)
+	require.Equal(t, updatedAt, out[0].UpdatedAt)
+
+	mock.ExpectQuery(calendarEventsQuery).
+		WithArgs(2, maxCalendarEvents).
+		WillReturnError(sql2.ErrConnDone)
+	out, err = repositoryTest.GetCalendarEvents("2")
+	require.Equal(t, error2.ErrPostgres, err)
+	require.Nil(t, out)
+
+	_, err = repositoryTest.GetCalendarEvents("test")
+	require.Equal(t, error2.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCalendarToken(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectQuery(getCalendarTokenQuery).
+		WithArgs(2).
+		WillReturnError(sql2.ErrNoRows)
+	token, err := repositoryTest.GetCalendarToken("2")
+	require.NoError(t, err)
+	require.Equal(t, "", token)
+
+	mock.ExpectExec(setCalendarTokenQuery).
+		WithArgs(2, "token").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err = repositoryTest.SetCalendarToken("2", "token")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(getCalendarTokenQuery).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow("token"))
+	token, err = repositoryTest.GetCalendarToken("2")
+	require.NoError(t, err)
+	require.Equal(t, "token", token)
+
+	mock.ExpectExec(setCalendarTokenQuery).
+		WithArgs(2, "token").
+		WillReturnError(sql2.ErrConnDone)
+	err = repositoryTest.SetCalendarToken("2", "token")
+	require.Equal(t, error2.ErrPostgres, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 var getCitiesTests = []struct {
 	id           int
 	postgresErr  error
@@ -2,21 +2,36 @@ package event
 
 import (
 	"backend/internal/models"
+	"time"
 )
 
 type UseCase interface {
 	CreateEvent(e *models.Event) (string, error)
 	UpdateEvent(e *models.Event, userId string) error
 	DeleteEvent(eventId string, userId string) error
+	UpdateSeries(e *models.Event, userId string) error
+	DeleteSeries(eventId string, userId string) error
 	//
 	GetEventById(eventId string) (*models.Event, error)
-	GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error)
-	GetCreatedEvents(authorId string) ([]*models.Event, error)
-	GetVisitedEvents(userId string) ([]*models.Event, error)
+	GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error)
+	GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error)
+	GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error)
+	GetEventsMap(bbox *models.BBox, zoom int) (*models.EventMap, error)
 	//
-	Visit(eventId string, userId string) error
-	Unvisit(eventId string, userId string) error
-	IsVisited(eventId string, userId string) (bool, error)
+	Visit(eventId string, userId string) (int, error)
+	Unvisit(eventId string, userId string) (string, error)
+	IsVisited(eventId string, userId string) (bool, int, error)
+	SetRSVP(eventId string, userId string, status string) (*models.RSVP, string, error)
+	GetRSVP(eventId string, userId string) (*models.RSVP, error)
+	//
+	Invite(eventId string, inviterId string, receiverIds []string) error
+	RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error)
+	GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error)
+	GetUserInvitations(receiverId string) ([]*models.Invitation, error)
+	//
+	GetCalendarToken(userId string) (string, error)
+	RegenerateCalendarToken(userId string) (string, error)
+	GetCalendarFeed(userId string, token string) ([]*models.Event, error)
 	//
 	GetCities() ([]string, error)
 	//
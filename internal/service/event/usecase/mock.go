@@ -3,6 +3,7 @@ package usecase
 import (
 	"backend/internal/models"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type UseCaseMock struct {
@@ -24,39 +25,99 @@ func (m *UseCaseMock) DeleteEvent(eventId string, userId string) error {
 	return args.Error(0)
 }
 
+func (m *UseCaseMock) UpdateSeries(e *models.Event, userId string) error {
+	args := m.Called(e, userId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) DeleteSeries(eventId string, userId string) error {
+	args := m.Called(eventId, userId)
+	return args.Error(0)
+}
+
 func (m *UseCaseMock) GetEventById(eventId string) (*models.Event, error) {
 	args := m.Called(eventId)
 	return args.Get(0).(*models.Event), args.Error(1)
 }
 
-func (m *UseCaseMock) GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
-	args := m.Called(userId, title, category, city, date, tags)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *UseCaseMock) GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(userId, title, category, city, from, to, tags, near, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
 }
 
-func (m *UseCaseMock) GetCreatedEvents(authorId string) ([]*models.Event, error) {
-	args := m.Called(authorId)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *UseCaseMock) GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(authorId, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
 }
 
-func (m *UseCaseMock) GetVisitedEvents(userId string) ([]*models.Event, error) {
-	args := m.Called(userId)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *UseCaseMock) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(userId, page)
+	return args.Get(0).([]*models.Event), args.Get(1).(string), args.Error(2)
+}
+
+func (m *UseCaseMock) GetEventsMap(bbox *models.BBox, zoom int) (*models.EventMap, error) {
+	args := m.Called(bbox, zoom)
+	return args.Get(0).(*models.EventMap), args.Error(1)
 }
 
-func (m *UseCaseMock) Visit(eventId string, userId string) error {
+func (m *UseCaseMock) Visit(eventId string, userId string) (int, error) {
 	args := m.Called(eventId, userId)
-	return args.Error(0)
+	return args.Get(0).(int), args.Error(1)
 }
 
-func (m *UseCaseMock) Unvisit(eventId string, userId string) error {
+func (m *UseCaseMock) Unvisit(eventId string, userId string) (string, error) {
 	args := m.Called(eventId, userId)
-	return args.Error(0)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *UseCaseMock) IsVisited(eventId string, userId string) (bool, int, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(bool), args.Get(1).(int), args.Error(2)
+}
+
+func (m *UseCaseMock) SetRSVP(eventId string, userId string, status string) (*models.RSVP, string, error) {
+	args := m.Called(eventId, userId, status)
+	return args.Get(0).(*models.RSVP), args.Get(1).(string), args.Error(2)
 }
 
-func (m *UseCaseMock) IsVisited(eventId string, userId string) (bool, error) {
+func (m *UseCaseMock) GetRSVP(eventId string, userId string) (*models.RSVP, error) {
 	args := m.Called(eventId, userId)
-	return args.Get(0).(bool), args.Error(1)
+	return args.Get(0).(*models.RSVP), args.Error(1)
+}
+
+func (m *UseCaseMock) Invite(eventId string, inviterId string, receiverIds []string) error {
+	args := m.Called(eventId, inviterId, receiverIds)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error) {
+	args := m.Called(invitationId, receiverId, status)
+	return args.Get(0).(*models.Invitation), args.Get(1).(int), args.Error(2)
+}
+
+func (m *UseCaseMock) GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error) {
+	args := m.Called(eventId, inviterId)
+	return args.Get(0).([]*models.Invitation), args.Error(1)
+}
+
+func (m *UseCaseMock) GetUserInvitations(receiverId string) ([]*models.Invitation, error) {
+	args := m.Called(receiverId)
+	return args.Get(0).([]*models.Invitation), args.Error(1)
+}
+
+func (m *UseCaseMock) GetCalendarToken(userId string) (string, error) {
+	args := m.Called(userId)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *UseCaseMock) RegenerateCalendarToken(userId string) (string, error) {
+	args := m.Called(userId)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *UseCaseMock) GetCalendarFeed(userId string, token string) ([]*models.Event, error) {
+	args := m.Called(userId, token)
+	return args.Get(0).([]*models.Event), args.Error(1)
 }
 
 func (m *UseCaseMock) GetCities() ([]string, error) {
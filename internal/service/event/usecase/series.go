@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"backend/internal/service/event"
+	error2 "backend/internal/service/event/error"
+	log "backend/pkg/logger"
+	"context"
+	"time"
+)
+
+// ExtendSeries adds the occurrences that have come within a year to the series without an end.
+// The latest occurrence is the template and the start of the rule, so edited occurrences are not copied.
+func ExtendSeries(repository event.SeriesRepository, now time.Time) error {
+	message := logMessage + "ExtendSeries:"
+	series, err := repository.GetOpenSeries()
+	if err != nil {
+		return err
+	}
+	for _, s := range series {
+		last, err := repository.GetLastOccurrence(s.ID)
+		if err == error2.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		last.RRule = s.RRule
+		last.ExDates = s.ExDates
+		last.Viewed = 0
+		occurrences, err := expandSeries(last, now)
+		if err != nil {
+			log.Error(message+"series = "+s.ID+" err = ", err)
+			continue
+		}
+		// The rule starts at the last occurrence, so its horizon is counted from now
+		horizon := now.AddDate(seriesHorizonYears, 0, 0)
+		var next []time.Time
+		for _, o := range occurrences {
+			if o.After(last.StartDate) && !o.After(horizon) {
+				next = append(next, o)
+			}
+		}
+		if len(next) == 0 {
+			continue
+		}
+		err = repository.ExtendSeries(last, next)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchSeries runs ExtendSeries every interval until ctx is done
+func WatchSeries(ctx context.Context, repository event.SeriesRepository, interval time.Duration) {
+	message := logMessage + "WatchSeries:"
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := ExtendSeries(repository, time.Now())
+		if err != nil {
+			log.Error(message+"err = ", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"backend/internal/models"
+	error2 "backend/internal/service/event/error"
+	"backend/internal/service/event/repository/mock"
+	"testing"
+	"time"
+
+	testifyMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendSeries(t *testing.T) {
+	repositoryMock := new(mock.RepositoryMock)
+	now := seriesStart.AddDate(0, 6, 0)
+	repositoryMock.On("GetOpenSeries").Return([]*models.Series{
+		{ID: "5", RRule: "FREQ=WEEKLY", ExDates: []time.Time{time.Date(2032, 11, 22, 0, 0, 0, 0, time.UTC)}},
+		{ID: "6", RRule: "FREQ=DAILY"},
+		{ID: "7", RRule: "FREQ=DAILY"},
+	}, nil)
+	// The series was expanded a year ahead when it was created
+	last := seriesStart.AddDate(0, 0, 52*7)
+	repositoryMock.On("GetLastOccurrence", "5").Return(&models.Event{
+		ID:        "60",
+		Title:     "test",
+		StartDate: last,
+		EndDate:   last.Add(2 * time.Hour),
+		TimeZone:  "UTC",
+		SeriesId:  "5",
+		Viewed:    10,
+	}, nil)
+	repositoryMock.On("GetLastOccurrence", "6").Return((*models.Event)(nil), error2.ErrNoRows)
+	// Already expanded up to the horizon
+	repositoryMock.On("GetLastOccurrence", "7").Return(&models.Event{
+		StartDate: now.AddDate(1, 0, 0),
+		TimeZone:  "UTC",
+		SeriesId:  "7",
+	}, nil)
+	repositoryMock.On("ExtendSeries", testifyMock.Anything, testifyMock.Anything).Return(nil)
+
+	err := ExtendSeries(repositoryMock, now)
+	require.NoError(t, err)
+	repositoryMock.AssertNumberOfCalls(t, "ExtendSeries", 1)
+	var call testifyMock.Call
+	for _, c := range repositoryMock.Calls {
+		if c.Method == "ExtendSeries" {
+			call = c
+		}
+	}
+	template := call.Arguments.Get(0).(*models.Event)
+	occurrences := call.Arguments.Get(1).([]time.Time)
+	require.Equal(t, "5", template.SeriesId)
+	require.Equal(t, "test", template.Title)
+	require.Equal(t, 0, template.Viewed)
+	// Six months ahead without the excluded date
+	require.Len(t, occurrences, 25)
+	require.Equal(t, last.AddDate(0, 0, 7), occurrences[0])
+	require.Equal(t, last.AddDate(0, 0, 2*7), occurrences[1])
+	for _, o := range occurrences {
+		require.NotEqual(t, "2032-11-22", o.Format("2006-01-02"))
+		require.False(t, o.After(now.AddDate(1, 0, 0)))
+	}
+}
+
+func TestExtendSeriesError(t *testing.T) {
+	repositoryMock := new(mock.RepositoryMock)
+	repositoryMock.On("GetOpenSeries").Return([]*models.Series(nil), error2.ErrPostgres)
+	err := ExtendSeries(repositoryMock, time.Now())
+	require.Equal(t, error2.ErrPostgres, err)
+}
@@ -6,119 +6,250 @@ import (
 	"backend/internal/service/event"
 	error2 "backend/internal/service/event/error"
 	log "backend/pkg/logger"
-	"encoding/json"
-	"errors"
-	"io/ioutil"
-	"net/http"
-	"os"
+	"backend/pkg/rrule"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
-const logMessage = "service:event:usecase:"
+const (
+	logMessage      = "service:event:usecase:"
+	defaultTimeZone = "Europe/Moscow"
+	//Starting from this zoom level map pins are not grouped into clusters
+	clusterMaxZoom = 14
+	maxZoom        = 22
+	//Size of a cluster cell relative to a map tile
+	clusterCellsPerTile = 8
+	maxRadiusKm         = 500
+	//Series without an end are expanded a year ahead, ExtendSeries adds the next occurrences later
+	seriesHorizonYears = 1
+	//Series with COUNT or UNTIL are expanded fully, so they must fit into the limits
+	maxSeriesYears     = 5
+	maxOccurrences     = 1000
+	calendarTokenBytes = 32
+)
 
 type UseCase struct {
 	repository event.Repository
+	geocoder   event.Geocoder
 }
 
-func NewUseCase(repository event.Repository) *UseCase {
+func NewUseCase(repository event.Repository, geocoder event.Geocoder) *UseCase {
 	return &UseCase{
 		repository: repository,
+		geocoder:   geocoder,
 	}
 }
 
-func cityAndAddrByCoordinates(latitude, longitude string) (string, string, error) {
-	url := "https://suggestions.dadata.ru/suggestions/api/4_1/rs/geolocate/address"
-	url += "?lat=" + latitude + "&lon=" + longitude
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return "", "", err
+func checkCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return error2.ErrGeo
 	}
-	req.Header.Set("Accept", "application/json")
-	Token := os.Getenv("MAPS_TOKEN")
-	req.Header.Set("Authorization", "Token "+Token)
+	return nil
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", "", err
+func checkBBox(bbox *models.BBox) error {
+	if bbox == nil {
+		return error2.ErrBBox
 	}
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", "", err
+	if checkCoordinates(bbox.MinLatitude, bbox.MinLongitude) != nil || checkCoordinates(bbox.MaxLatitude, bbox.MaxLongitude) != nil {
+		return error2.ErrBBox
 	}
-	type Data struct {
-		City string `json:"city,omitempty"`
+	// MinLongitude > MaxLongitude is a box that crosses the antimeridian
+	if bbox.MinLatitude > bbox.MaxLatitude {
+		return error2.ErrBBox
 	}
+	return nil
+}
 
-	type AddrInfo struct {
-		Value              string `json:"value,omitempty"`
-		Unrestricted_value string `json:"unrestricted_value,omitempty"`
-		Data               Data   `json:"data,omitempty"`
+// Cell size in degrees for grouping map pins, zero means no clustering
+func clusterCellSize(zoom int) float64 {
+	if zoom >= clusterMaxZoom {
+		return 0
 	}
+	return 360 / math.Pow(2, float64(zoom)) / clusterCellsPerTile
+}
 
-	type Suggest struct {
-		Suggestions []AddrInfo `json:"suggestions,omitempty"`
+func checkEventDates(e *models.Event) error {
+	if e.StartDate.IsZero() {
+		return error2.ErrStartDate
 	}
-	suggestions := Suggest{}
+	if e.TimeZone == "" {
+		e.TimeZone = defaultTimeZone
+	}
+	if _, err := time.LoadLocation(e.TimeZone); err != nil {
+		return error2.ErrTimeZone
+	}
+	if e.EndDate.IsZero() {
+		e.EndDate = e.StartDate
+	}
+	if e.EndDate.Before(e.StartDate) {
+		return error2.ErrDateRange
+	}
+	return nil
+}
 
-	err = json.Unmarshal(body, &suggestions)
+// Checks and fills the event fields that are common for creation and update
+func (a *UseCase) prepareEvent(e *models.Event, message string) error {
+	if err := checkEventDates(e); err != nil {
+		return err
+	}
+	if e.Capacity < 0 {
+		return error2.ErrCapacity
+	}
+	if err := checkCoordinates(e.Latitude, e.Longitude); err != nil {
+		return err
+	}
+	city, address, err := a.geocoder.Geocode(e.Latitude, e.Longitude)
 	if err != nil {
-		return "", "", err
+		log.Error(message+"err = ", err)
+	} else {
+		e.City = city
+		e.Address = address
 	}
-
-	if len(suggestions.Suggestions) == 0 {
-		return "", "", errors.New("can't get city and address from coordinates")
+	for i, tag := range e.Tag {
+		e.Tag[i] = strings.ToLower(tag)
 	}
+	return nil
+}
 
-	addr := suggestions.Suggestions[0].Value
-	city := suggestions.Suggestions[0].Data.City
+// Occurrences are expanded in the time zone of the event. Series with COUNT or UNTIL are expanded fully
+// and must end within maxSeriesYears, series without an end are expanded a year ahead of now
+func expandSeries(e *models.Event, now time.Time) ([]time.Time, error) {
+	rule, err := rrule.Parse(e.RRule)
+	if err != nil || e.StartDate.IsZero() {
+		return nil, error2.ErrRRule
+	}
+	location, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return nil, error2.ErrTimeZone
+	}
+	start := e.StartDate.In(location)
+	horizon := start
+	if now.After(horizon) {
+		horizon = now
+	}
+	horizon = horizon.AddDate(seriesHorizonYears, 0, 0)
+	if rule.Bounded() {
+		horizon = start.AddDate(maxSeriesYears, 0, 0)
+		if rule.Count > maxOccurrences || rule.Until.After(horizon) {
+			return nil, error2.ErrSeriesTooLong
+		}
+		// Excluded dates use up the counted occurrences too, so they are not needed to check the limits
+		all := rule.Occurrences(start, nil, horizon, maxOccurrences+1)
+		if len(all) > maxOccurrences || len(all) < rule.Count {
+			return nil, error2.ErrSeriesTooLong
+		}
+	}
+	occurrences := rule.Occurrences(start, e.ExDates, horizon, maxOccurrences)
+	if len(occurrences) == 0 {
+		return nil, error2.ErrRRule
+	}
+	e.RRule = rule.String()
+	return occurrences, nil
+}
 
-	return city, addr, nil
+// anchorSeries moves the edited occurrence to the first day of the series. The time of day and
+// the duration that were sent for the occurrence are kept, they apply to the whole series
+func anchorSeries(e *models.Event, start time.Time) {
+	location, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return
+	}
+	day := start.In(location)
+	clock := e.StartDate.In(location)
+	duration := e.EndDate.Sub(e.StartDate)
+	e.StartDate = time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, location)
+	e.EndDate = e.StartDate.Add(duration)
 }
 
-func parseCoordinates(coords string) (string, string) {
-	coordsArr := strings.Split(coords, " ")
-	lat := coordsArr[0][1 : len(coordsArr[0])-1]
-	lng := coordsArr[1][:len(coordsArr[1])-1]
-	return lat, lng
+func mergeDates(a []time.Time, b []time.Time) []time.Time {
+	seen := make(map[string]bool)
+	var result []time.Time
+	for _, d := range append(a, b...) {
+		key := d.Format("2006-01-02")
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, d)
+		}
+	}
+	return result
 }
 
 func (a *UseCase) CreateEvent(e *models.Event) (string, error) {
 	if e == nil || e.AuthorId == "" {
 		return "", error2.ErrEmptyData
 	}
-	lat, lng := parseCoordinates(e.Geo)
-	city, address, err := cityAndAddrByCoordinates(lat, lng)
-	if err != nil {
-		log.Error(logMessage+"CreateEvent:err = ", err)
-	} else {
-		e.City = city
-		e.Address = address
+	if err := a.prepareEvent(e, logMessage+"CreateEvent:"); err != nil {
+		return "", err
 	}
-	for i, tag := range e.Tag {
-		e.Tag[i] = strings.ToLower(tag)
+	if e.RRule == "" {
+		return a.repository.CreateEvent(e)
 	}
-	return a.repository.CreateEvent(e)
+	occurrences, err := expandSeries(e, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return a.repository.CreateSeries(e, occurrences)
 }
 
+// Updates a single occurrence, which is detached from its series after that
 func (a *UseCase) UpdateEvent(e *models.Event, userId string) error {
 	if e == nil || userId == "" || e.ID == "" {
 		return error2.ErrEmptyData
 	}
-	lat, lng := parseCoordinates(e.Geo)
-	city, address, err := cityAndAddrByCoordinates(lat, lng)
+	if err := a.prepareEvent(e, logMessage+"UpdateEvent:"); err != nil {
+		return err
+	}
+	return a.repository.UpdateEvent(e, userId)
+}
+
+// Updates all future occurrences of the series the event belongs to. The stored rule is kept
+// if the new one is empty, excluded dates are added to the stored ones. The rule is expanded from
+// the start of the series, so COUNT and INTERVAL keep counting from the first occurrence
+func (a *UseCase) UpdateSeries(e *models.Event, userId string) error {
+	if e == nil || userId == "" || e.ID == "" {
+		return error2.ErrEmptyData
+	}
+	if err := a.prepareEvent(e, logMessage+"UpdateSeries:"); err != nil {
+		return err
+	}
+	series, err := a.repository.GetSeries(e.ID)
 	if err != nil {
-		log.Error(logMessage+"CreateEvent:err = ", err)
-	} else {
-		e.City = city
-		e.Address = address
+		return err
 	}
-	for i, tag := range e.Tag {
-		e.Tag[i] = strings.ToLower(tag)
+	e.SeriesId = series.ID
+	if !series.Start.IsZero() {
+		anchorSeries(e, series.Start)
 	}
-	return a.repository.UpdateEvent(e, userId)
+	if e.RRule == "" {
+		e.RRule = series.RRule
+	}
+	e.ExDates = mergeDates(series.ExDates, e.ExDates)
+	occurrences, err := expandSeries(e, time.Now())
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var future []time.Time
+	for _, o := range occurrences {
+		if o.After(now) {
+			future = append(future, o)
+		}
+	}
+	return a.repository.UpdateSeries(e, userId, future)
+}
+
+func (a *UseCase) DeleteSeries(eventId string, userId string) error {
+	if userId == "" || eventId == "" {
+		return error2.ErrEmptyData
+	}
+	return a.repository.DeleteSeries(eventId, userId)
 }
 
 func (a *UseCase) DeleteEvent(eventID string, userId string) error {
@@ -135,51 +266,194 @@ func (a *UseCase) GetEventById(eventId string) (*models.Event, error) {
 	return a.repository.GetEventById(eventId)
 }
 
-func (a *UseCase) GetEvents(userId string, title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
+func (a *UseCase) GetEvents(userId string, title string, category string, city string, from time.Time, to time.Time, tags []string, near *models.GeoCircle, page *models.Page) ([]*models.Event, string, error) {
+	if near != nil {
+		if err := checkCoordinates(near.Latitude, near.Longitude); err != nil {
+			return nil, "", err
+		}
+		if near.RadiusKm <= 0 || near.RadiusKm > maxRadiusKm {
+			return nil, "", error2.ErrGeo
+		}
+	}
 	if tags != nil && tags[0] == "" {
 		tags = nil
 	}
 	for i, tag := range tags {
 		tags[i] = strings.ToLower(tag)
 	}
-	return a.repository.GetEvents(userId, title, category, city, date, tags)
+	return a.repository.GetEvents(userId, title, category, city, from, to, tags, near, page)
 }
 
-func (a *UseCase) GetVisitedEvents(userId string) ([]*models.Event, error) {
+func (a *UseCase) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
 	if userId == "" {
-		return nil, error2.ErrEmptyData
+		return nil, "", error2.ErrEmptyData
 	}
-	return a.repository.GetVisitedEvents(userId)
+	return a.repository.GetVisitedEvents(userId, page)
 }
 
-func (a *UseCase) GetCreatedEvents(userId string) ([]*models.Event, error) {
+func (a *UseCase) GetCreatedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
 	if userId == "" {
-		return nil, error2.ErrEmptyData
+		return nil, "", error2.ErrEmptyData
 	}
-	return a.repository.GetCreatedEvents(userId)
+	return a.repository.GetCreatedEvents(userId, page)
 }
 
-func (a *UseCase) Visit(eventId string, userId string) error {
+func (a *UseCase) GetEventsMap(bbox *models.BBox, zoom int) (*models.EventMap, error) {
+	if err := checkBBox(bbox); err != nil {
+		return nil, err
+	}
+	if zoom < 0 || zoom > maxZoom {
+		return nil, error2.ErrBBox
+	}
+	return a.repository.GetEventsMap(bbox, clusterCellSize(zoom))
+}
+
+func (a *UseCase) Visit(eventId string, userId string) (int, error) {
 	if eventId == "" || userId == "" {
-		return error2.ErrEmptyData
+		return 0, error2.ErrEmptyData
 	}
 	return a.repository.Visit(eventId, userId)
 }
 
-func (a *UseCase) Unvisit(eventId string, userId string) error {
+func (a *UseCase) Unvisit(eventId string, userId string) (string, error) {
 	if eventId == "" || userId == "" {
-		return error2.ErrEmptyData
+		return "", error2.ErrEmptyData
 	}
 	return a.repository.Unvisit(eventId, userId)
 }
 
-func (a *UseCase) IsVisited(eventId string, userId string) (bool, error) {
+func (a *UseCase) IsVisited(eventId string, userId string) (bool, int, error) {
 	if eventId == "" || userId == "" {
-		return false, error2.ErrEmptyData
+		return false, 0, error2.ErrEmptyData
 	}
 	return a.repository.IsVisited(eventId, userId)
 }
 
+// "going" goes through the capacity check, so the user may get to the waitlist instead.
+// Returns the id of the user promoted from the waitlist, if a seat was freed
+func (a *UseCase) SetRSVP(eventId string, userId string, status string) (*models.RSVP, string, error) {
+	if eventId == "" || userId == "" || status == "" {
+		return nil, "", error2.ErrEmptyData
+	}
+	switch status {
+	case models.RSVPGoing:
+		position, err := a.repository.Visit(eventId, userId)
+		if err != nil {
+			return nil, "", err
+		}
+		return &models.RSVP{Status: status, WaitlistPosition: position}, "", nil
+	case models.RSVPMaybe, models.RSVPDeclined:
+		promotedUserId, err := a.repository.SetRSVP(eventId, userId, status)
+		if err != nil {
+			return nil, "", err
+		}
+		return &models.RSVP{Status: status}, promotedUserId, nil
+	default:
+		return nil, "", error2.ErrRSVPStatus
+	}
+}
+
+func (a *UseCase) GetRSVP(eventId string, userId string) (*models.RSVP, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	return a.repository.GetRSVP(eventId, userId)
+}
+
+func (a *UseCase) Invite(eventId string, inviterId string, receiverIds []string) error {
+	if eventId == "" || inviterId == "" || len(receiverIds) == 0 {
+		return error2.ErrEmptyData
+	}
+	for _, receiverId := range receiverIds {
+		if receiverId == inviterId {
+			return error2.ErrNotAllowed
+		}
+	}
+	return a.repository.CreateInvitations(eventId, inviterId, receiverIds)
+}
+
+// Accepted invitation makes the receiver a visitor, so the waitlist position is returned too
+func (a *UseCase) RespondInvitation(invitationId string, receiverId string, status string) (*models.Invitation, int, error) {
+	if invitationId == "" || receiverId == "" {
+		return nil, 0, error2.ErrEmptyData
+	}
+	if status != models.InvitationAccepted && status != models.InvitationDeclined {
+		return nil, 0, error2.ErrInvitationStatus
+	}
+	invitation, position, err := a.repository.RespondInvitation(invitationId, receiverId, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return invitation, position, nil
+}
+
+func (a *UseCase) GetEventInvitations(eventId string, inviterId string) ([]*models.Invitation, error) {
+	if eventId == "" || inviterId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	return a.repository.GetEventInvitations(eventId, inviterId)
+}
+
+func (a *UseCase) GetUserInvitations(receiverId string) ([]*models.Invitation, error) {
+	if receiverId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	return a.repository.GetUserInvitations(receiverId)
+}
+
+func newCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Creates the token if the user has not got one yet
+func (a *UseCase) GetCalendarToken(userId string) (string, error) {
+	if userId == "" {
+		return "", error2.ErrEmptyData
+	}
+	token, err := a.repository.GetCalendarToken(userId)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		return token, nil
+	}
+	return a.RegenerateCalendarToken(userId)
+}
+
+// New token revokes the feed url with the previous one
+func (a *UseCase) RegenerateCalendarToken(userId string) (string, error) {
+	if userId == "" {
+		return "", error2.ErrEmptyData
+	}
+	token, err := newCalendarToken()
+	if err != nil {
+		return "", err
+	}
+	err = a.repository.SetCalendarToken(userId, token)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (a *UseCase) GetCalendarFeed(userId string, token string) ([]*models.Event, error) {
+	if userId == "" || token == "" {
+		return nil, error2.ErrEmptyData
+	}
+	storedToken, err := a.repository.GetCalendarToken(userId)
+	if err != nil {
+		return nil, err
+	}
+	if storedToken == "" || subtle.ConstantTimeCompare([]byte(storedToken), []byte(token)) != 1 {
+		return nil, error2.ErrCalendarToken
+	}
+	return a.repository.GetCalendarEvents(userId)
+}
+
 func (a *UseCase) GetCities() ([]string, error) {
 	return a.repository.GetCities()
 }
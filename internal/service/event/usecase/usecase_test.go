@@ -3,15 +3,24 @@ package usecase
 import (
 	"backend/internal/models"
 	error2 "backend/internal/service/event/error"
+	"backend/internal/service/event/geocoder/stub"
 	"backend/internal/service/event/repository/mock"
 	"errors"
+	testifyMock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
+	"time"
 )
 
 const logTestMessage = "service:event:usecase:"
 
+var geocoderStub = stub.NewGeocoder([]stub.Location{
+	{Latitude: 1.2, Longitude: 4.3, City: "test_city", Address: "test_address"},
+})
+
+var eventStart = time.Date(2021, 12, 1, 18, 0, 0, 0, time.UTC)
+
 var createEventTests = []struct {
 	id            int
 	event         *models.Event
@@ -20,9 +29,11 @@ var createEventTests = []struct {
 }{
 	{1,
 		&models.Event{
-			AuthorId: "test",
-			Geo:      "(1.23232323, 4.3223232323)",
-			Tag:      []string{"test"},
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
+			Tag:       []string{"test"},
 		},
 		nil,
 		"",
@@ -34,22 +45,80 @@ var createEventTests = []struct {
 	},
 	{3,
 		&models.Event{
-			AuthorId: "test",
-			Geo:      "(1.23232323, 4.3223232323)",
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
 		},
 		errors.New("test_err"),
 		"",
 	},
+	{4,
+		&models.Event{
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		error2.ErrDateRange,
+		"",
+	},
+	{5,
+		&models.Event{
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
+			TimeZone:  "Mars/Olympus",
+		},
+		error2.ErrTimeZone,
+		"",
+	},
+	{6,
+		&models.Event{
+			AuthorId:  "test",
+			Latitude:  91,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
+		},
+		error2.ErrGeo,
+		"",
+	},
+	{7,
+		&models.Event{
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
+			Capacity:  -1,
+		},
+		error2.ErrCapacity,
+		"",
+	},
+	{8,
+		&models.Event{
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+		},
+		error2.ErrStartDate,
+		"",
+	},
 }
 
 func TestCreateEvent(t *testing.T) {
 	for _, test := range createEventTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
 		repositoryMock.On("CreateEvent", test.event).Return("", test.outputErr)
 		actualEventId, actualErr := useCaseTest.CreateEvent(test.event)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputEventId, actualEventId, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if test.outputErr == nil {
+			require.Equal(t, "test_city", test.event.City, logTestMessage+" "+strconv.Itoa(test.id))
+			require.Equal(t, "test_address", test.event.Address, logTestMessage+" "+strconv.Itoa(test.id))
+		}
 	}
 }
 
@@ -61,10 +130,12 @@ var updateEventTests = []struct {
 }{
 	{1,
 		&models.Event{
-			ID:       "test",
-			AuthorId: "test",
-			Geo:      "(1.23232323, 4.3223232323)",
-			Tag:      []string{"test"},
+			ID:        "test",
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
+			Tag:       []string{"test"},
 		},
 		"test",
 		nil,
@@ -76,19 +147,31 @@ var updateEventTests = []struct {
 	},
 	{3,
 		&models.Event{
-			ID:       "test",
-			AuthorId: "test",
-			Geo:      "(1.23232323, 4.3223232323)",
+			ID:        "test",
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+			StartDate: eventStart,
 		},
 		"test",
 		errors.New("test_err"),
 	},
+	{4,
+		&models.Event{
+			ID:        "test",
+			AuthorId:  "test",
+			Latitude:  1.23232323,
+			Longitude: 4.3223232323,
+		},
+		"test",
+		error2.ErrStartDate,
+	},
 }
 
 func TestUpdateEvent(t *testing.T) {
 	for _, test := range updateEventTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
 		repositoryMock.On("UpdateEvent", test.event, test.userId).Return(test.outputErr)
 		actualErr := useCaseTest.UpdateEvent(test.event, test.userId)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
@@ -121,13 +204,261 @@ var deleteEventTests = []struct {
 func TestDeleteEvent(t *testing.T) {
 	for _, test := range deleteEventTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
 		repositoryMock.On("DeleteEvent", test.eventId, test.userId).Return(test.outputErr)
 		actualErr := useCaseTest.DeleteEvent(test.eventId, test.userId)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
 
+var seriesStart = time.Date(2031, 11, 3, 18, 0, 0, 0, time.UTC)
+
+var createSeriesTests = []struct {
+	id          int
+	event       *models.Event
+	occurrences []time.Time
+	postgresErr error
+	outputErr   error
+	outputRRule string
+}{
+	{1,
+		&models.Event{
+			AuthorId:  "1",
+			StartDate: seriesStart,
+			EndDate:   seriesStart.Add(2 * time.Hour),
+			TimeZone:  "UTC",
+			RRule:     "RRULE:FREQ=WEEKLY;COUNT=3",
+		},
+		[]time.Time{seriesStart, seriesStart.AddDate(0, 0, 7), seriesStart.AddDate(0, 0, 14)},
+		nil,
+		nil,
+		"FREQ=WEEKLY;COUNT=3",
+	},
+	{2,
+		&models.Event{
+			AuthorId:  "1",
+			StartDate: seriesStart,
+			TimeZone:  "UTC",
+			RRule:     "FREQ=YEARLY",
+		},
+		nil,
+		nil,
+		error2.ErrRRule,
+		"FREQ=YEARLY",
+	},
+	{3,
+		&models.Event{
+			AuthorId: "1",
+			RRule:    "FREQ=DAILY",
+		},
+		nil,
+		nil,
+		error2.ErrStartDate,
+		"FREQ=DAILY",
+	},
+	{4,
+		&models.Event{
+			AuthorId:  "1",
+			StartDate: seriesStart,
+			TimeZone:  "UTC",
+			RRule:     "FREQ=DAILY;COUNT=1",
+		},
+		[]time.Time{seriesStart},
+		error2.ErrPostgres,
+		error2.ErrPostgres,
+		"FREQ=DAILY;COUNT=1",
+	},
+}
+
+func TestCreateSeries(t *testing.T) {
+	for _, test := range createSeriesTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("CreateSeries", test.event, test.occurrences).Return("1", test.postgresErr)
+		_, actualErr := useCaseTest.CreateEvent(test.event)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRRule, test.event.RRule, logTestMessage+" "+strconv.Itoa(test.id))
+		if test.occurrences != nil {
+			repositoryMock.AssertCalled(t, "CreateSeries", test.event, test.occurrences)
+		}
+	}
+}
+
+func TestExpandSeries(t *testing.T) {
+	now := seriesStart.AddDate(0, -1, 0)
+	tests := []struct {
+		rrule     string
+		count     int
+		last      time.Time
+		outputErr error
+	}{
+		// Series with an end are expanded fully, even beyond a year
+		{"FREQ=DAILY;COUNT=400", 400, seriesStart.AddDate(0, 0, 399), nil},
+		{"FREQ=DAILY;UNTIL=20331103", 732, seriesStart.AddDate(2, 0, 0), nil},
+		{"FREQ=WEEKLY;UNTIL=20331103T170000Z", 105, seriesStart.AddDate(0, 0, 104*7), nil},
+		// Series without an end are expanded a year ahead
+		{"FREQ=DAILY", 367, seriesStart.AddDate(1, 0, 0), nil},
+		{"FREQ=DAILY;COUNT=1001", 0, time.Time{}, error2.ErrSeriesTooLong},
+		{"FREQ=DAILY;UNTIL=20371104", 0, time.Time{}, error2.ErrSeriesTooLong},
+		{"FREQ=MONTHLY;COUNT=100", 0, time.Time{}, error2.ErrSeriesTooLong},
+	}
+	for _, test := range tests {
+		e := &models.Event{StartDate: seriesStart, TimeZone: "UTC", RRule: test.rrule}
+		occurrences, err := expandSeries(e, now)
+		require.Equal(t, test.outputErr, err, test.rrule)
+		require.Len(t, occurrences, test.count, test.rrule)
+		if test.count != 0 {
+			require.Equal(t, seriesStart, occurrences[0], test.rrule)
+			require.Equal(t, test.last, occurrences[len(occurrences)-1], test.rrule)
+		}
+	}
+}
+
+var updateSeriesTests = []struct {
+	id          int
+	event       *models.Event
+	userId      string
+	series      *models.Series
+	seriesErr   error
+	occurrences []time.Time
+	outputErr   error
+}{
+	{1,
+		&models.Event{
+			ID:        "2",
+			StartDate: seriesStart,
+			TimeZone:  "UTC",
+		},
+		"1",
+		&models.Series{
+			ID:       "5",
+			AuthorId: "1",
+			RRule:    "FREQ=DAILY;COUNT=3",
+			ExDates:  []time.Time{time.Date(2031, 11, 4, 0, 0, 0, 0, time.UTC)},
+		},
+		nil,
+		[]time.Time{seriesStart, seriesStart.AddDate(0, 0, 2)},
+		nil,
+	},
+	{2,
+		&models.Event{
+			ID:        "2",
+			StartDate: seriesStart,
+			TimeZone:  "UTC",
+		},
+		"3",
+		&models.Series{
+			ID:       "5",
+			AuthorId: "1",
+			RRule:    "FREQ=DAILY;COUNT=3",
+		},
+		nil,
+		[]time.Time{seriesStart, seriesStart.AddDate(0, 0, 1), seriesStart.AddDate(0, 0, 2)},
+		error2.ErrNotAllowed,
+	},
+	{3,
+		&models.Event{
+			ID:        "2",
+			StartDate: seriesStart,
+			TimeZone:  "UTC",
+		},
+		"1",
+		&models.Series{},
+		error2.ErrNotSeries,
+		nil,
+		error2.ErrNotSeries,
+	},
+	{4,
+		&models.Event{},
+		"1",
+		&models.Series{},
+		nil,
+		nil,
+		error2.ErrEmptyData,
+	},
+}
+
+func TestUpdateSeries(t *testing.T) {
+	for _, test := range updateSeriesTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("GetSeries", test.event.ID).Return(test.series, test.seriesErr)
+		// The repository checks the author, so moderators may update any series
+		repositoryMock.On("UpdateSeries", test.event, test.userId, test.occurrences).Return(test.outputErr)
+		actualErr := useCaseTest.UpdateSeries(test.event, test.userId)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if test.outputErr == nil {
+			require.Equal(t, test.series.ID, test.event.SeriesId, logTestMessage+" "+strconv.Itoa(test.id))
+			require.Equal(t, test.series.RRule, test.event.RRule, logTestMessage+" "+strconv.Itoa(test.id))
+			repositoryMock.AssertCalled(t, "UpdateSeries", test.event, test.userId, test.occurrences)
+		}
+	}
+}
+
+func TestUpdateSeriesFromStart(t *testing.T) {
+	// The series started 4 days ago, its next occurrence is the 6th one and is made 2 hours long
+	start := time.Now().UTC().Truncate(time.Second).Add(-4*24*time.Hour - time.Hour)
+	edited := start.AddDate(0, 0, 5)
+	e := &models.Event{
+		ID:        "2",
+		StartDate: edited,
+		EndDate:   edited.Add(2 * time.Hour),
+		TimeZone:  "UTC",
+	}
+	series := &models.Series{
+		ID:       "5",
+		AuthorId: "1",
+		RRule:    "FREQ=DAILY;COUNT=10",
+		Start:    start,
+	}
+	var occurrences []time.Time
+	for i := 5; i < 10; i++ {
+		occurrences = append(occurrences, start.AddDate(0, 0, i))
+	}
+	repositoryMock := new(mock.RepositoryMock)
+	useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+	repositoryMock.On("GetSeries", e.ID).Return(series, nil)
+	repositoryMock.On("UpdateSeries", e, "1", occurrences).Return(nil)
+
+	require.NoError(t, useCaseTest.UpdateSeries(e, "1"), logTestMessage)
+	require.Equal(t, start, e.StartDate, logTestMessage)
+	require.Equal(t, 2*time.Hour, e.EndDate.Sub(e.StartDate), logTestMessage)
+	repositoryMock.AssertCalled(t, "UpdateSeries", e, "1", occurrences)
+}
+
+var deleteSeriesTests = []struct {
+	id        int
+	eventId   string
+	userId    string
+	outputErr error
+}{
+	{1,
+		"test",
+		"test",
+		nil,
+	},
+	{2,
+		"",
+		"test",
+		error2.ErrEmptyData,
+	},
+	{3,
+		"test",
+		"test",
+		error2.ErrNotSeries,
+	},
+}
+
+func TestDeleteSeries(t *testing.T) {
+	for _, test := range deleteSeriesTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("DeleteSeries", test.eventId, test.userId).Return(test.outputErr)
+		actualErr := useCaseTest.DeleteSeries(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+	}
+}
+
 var getEventByIdTests = []struct {
 	id        int
 	eventId   string
@@ -154,7 +485,7 @@ var getEventByIdTests = []struct {
 func TestGetEventById(t *testing.T) {
 	for _, test := range getEventByIdTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
 		repositoryMock.On("GetEventById", test.eventId).Return(test.outputRes, test.outputErr)
 		actualRes, actualErr := useCaseTest.GetEventById(test.eventId)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
@@ -168,8 +499,10 @@ var getEventsTests = []struct {
 	title     string
 	category  string
 	city      string
-	date      string
+	from      time.Time
+	to        time.Time
 	tags      []string
+	near      *models.GeoCircle
 	outputErr error
 	outputRes []*models.Event
 }{
@@ -178,8 +511,10 @@ var getEventsTests = []struct {
 		"test",
 		"test",
 		"test",
-		"test",
+		time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Time{},
 		[]string{"test"},
+		&models.GeoCircle{Latitude: 55.75, Longitude: 37.61, RadiusKm: 10},
 		nil,
 		[]*models.Event{},
 	},
@@ -188,24 +523,102 @@ var getEventsTests = []struct {
 		"test",
 		"test",
 		"test",
-		"test",
+		time.Time{},
+		time.Time{},
+		nil,
 		nil,
 		errors.New("test_err"),
 		nil,
 	},
+	{3,
+		"",
+		"test",
+		"test",
+		"test",
+		time.Time{},
+		time.Time{},
+		nil,
+		&models.GeoCircle{Latitude: 55.75, Longitude: 37.61, RadiusKm: 0},
+		error2.ErrGeo,
+		nil,
+	},
 }
 
 func TestGetEvents(t *testing.T) {
 	for _, test := range getEventsTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("GetEvents", test.authorId, test.title, test.category, test.city, test.date, test.tags).Return(test.outputRes, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetEvents(test.authorId, test.title, test.category, test.city, test.date, test.tags)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		page := &models.Page{}
+		repositoryMock.On("GetEvents", test.authorId, test.title, test.category, test.city, test.from, test.to, test.tags, test.near, page).Return(test.outputRes, "", test.outputErr)
+		actualRes, _, actualErr := useCaseTest.GetEvents(test.authorId, test.title, test.category, test.city, test.from, test.to, test.tags, test.near, page)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
 	}
 }
 
+var getEventsMapTests = []struct {
+	id        int
+	bbox      *models.BBox
+	zoom      int
+	cellSize  float64
+	outputErr error
+	outputRes *models.EventMap
+}{
+	{1,
+		&models.BBox{MinLatitude: 50, MinLongitude: 30, MaxLatitude: 60, MaxLongitude: 40},
+		3,
+		5.625,
+		nil,
+		&models.EventMap{},
+	},
+	{2,
+		&models.BBox{MinLatitude: 50, MinLongitude: 30, MaxLatitude: 60, MaxLongitude: 40},
+		15,
+		0,
+		nil,
+		&models.EventMap{},
+	},
+	{3,
+		&models.BBox{MinLatitude: 60, MinLongitude: 30, MaxLatitude: 50, MaxLongitude: 40},
+		15,
+		0,
+		error2.ErrBBox,
+		nil,
+	},
+	{4,
+		&models.BBox{MinLatitude: 50, MinLongitude: 30, MaxLatitude: 60, MaxLongitude: 40},
+		30,
+		0,
+		error2.ErrBBox,
+		nil,
+	},
+	{5,
+		&models.BBox{MinLatitude: 50, MinLongitude: 170, MaxLatitude: 60, MaxLongitude: -170},
+		15,
+		0,
+		nil,
+		&models.EventMap{},
+	},
+	{6,
+		&models.BBox{MinLatitude: 50, MinLongitude: 170, MaxLatitude: 60, MaxLongitude: 181},
+		15,
+		0,
+		error2.ErrBBox,
+		nil,
+	},
+}
+
+func TestGetEventsMap(t *testing.T) {
+	for _, test := range getEventsMapTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("GetEventsMap", test.bbox, test.cellSize).Return(test.outputRes, test.outputErr)
+		actualRes, actualErr := useCaseTest.GetEventsMap(test.bbox, test.zoom)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id))
+	}
+}
+
 var getVisitedEventsTests = []struct {
 	id        int
 	userId    string
@@ -234,9 +647,10 @@ var getVisitedEventsTests = []struct {
 func TestGetVisitedEvents(t *testing.T) {
 	for _, test := range getVisitedEventsTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("GetVisitedEvents", test.userId).Return(test.outputRes, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetVisitedEvents(test.userId)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		page := &models.Page{}
+		repositoryMock.On("GetVisitedEvents", test.userId, page).Return(test.outputRes, "", test.outputErr)
+		actualRes, _, actualErr := useCaseTest.GetVisitedEvents(test.userId, page)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
 	}
@@ -270,9 +684,10 @@ var getCreatedEventsTests = []struct {
 func TestGetCreatedEvents(t *testing.T) {
 	for _, test := range getCreatedEventsTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("GetCreatedEvents", test.userId).Return(test.outputRes, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetCreatedEvents(test.userId)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		page := &models.Page{}
+		repositoryMock.On("GetCreatedEvents", test.userId, page).Return(test.outputRes, "", test.outputErr)
+		actualRes, _, actualErr := useCaseTest.GetCreatedEvents(test.userId, page)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
 	}
@@ -283,31 +698,42 @@ var visitTests = []struct {
 	userId    string
 	eventId   string
 	outputErr error
+	outputRes int
 }{
 	{1,
 		"test",
 		"test",
 		nil,
+		0,
 	},
 	{2,
 		"",
 		"",
 		error2.ErrEmptyData,
+		0,
 	},
 	{3,
 		"test",
 		"test",
 		errors.New("test_err"),
+		0,
+	},
+	{4,
+		"test",
+		"test",
+		nil,
+		3,
 	},
 }
 
 func TestVisit(t *testing.T) {
 	for _, test := range visitTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("Visit", test.eventId, test.userId).Return(test.outputErr)
-		actualErr := useCaseTest.Visit(test.eventId, test.userId)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("Visit", test.eventId, test.userId).Return(test.outputRes, test.outputErr)
+		actualRes, actualErr := useCaseTest.Visit(test.eventId, test.userId)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
 
@@ -316,69 +742,399 @@ var unvisitTests = []struct {
 	userId    string
 	eventId   string
 	outputErr error
+	outputRes string
 }{
 	{1,
 		"test",
 		"test",
 		nil,
+		"",
 	},
 	{2,
 		"",
 		"",
 		error2.ErrEmptyData,
+		"",
 	},
 	{3,
 		"test",
 		"test",
 		errors.New("test_err"),
+		"",
+	},
+	{4,
+		"test",
+		"test",
+		nil,
+		"7",
 	},
 }
 
 func TestUnvisit(t *testing.T) {
 	for _, test := range unvisitTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("Unvisit", test.eventId, test.userId).Return(test.outputErr)
-		actualErr := useCaseTest.Unvisit(test.eventId, test.userId)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("Unvisit", test.eventId, test.userId).Return(test.outputRes, test.outputErr)
+		actualRes, actualErr := useCaseTest.Unvisit(test.eventId, test.userId)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
 
 var isVisitedTests = []struct {
-	id        int
-	userId    string
-	eventId   string
-	outputErr error
-	outputRes bool
+	id             int
+	userId         string
+	eventId        string
+	outputErr      error
+	outputRes      bool
+	outputPosition int
 }{
 	{1,
 		"test",
 		"test",
 		nil,
 		true,
+		0,
 	},
 	{2,
 		"",
 		"",
 		error2.ErrEmptyData,
 		false,
+		0,
 	},
 	{3,
 		"test",
 		"test",
 		errors.New("test_err"),
 		false,
+		0,
+	},
+	{4,
+		"test",
+		"test",
+		nil,
+		false,
+		2,
 	},
 }
 
 func TestIsVisited(t *testing.T) {
 	for _, test := range isVisitedTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("IsVisited", test.eventId, test.userId).Return(test.outputRes, test.outputErr)
-		actualRes, actualErr := useCaseTest.IsVisited(test.eventId, test.userId)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("IsVisited", test.eventId, test.userId).Return(test.outputRes, test.outputPosition, test.outputErr)
+		actualRes, actualPosition, actualErr := useCaseTest.IsVisited(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputPosition, actualPosition, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+	}
+}
+
+var setRSVPTests = []struct {
+	id                   int
+	userId               string
+	eventId              string
+	status               string
+	waitlistPosition     int
+	repoErr              error
+	outputErr            error
+	outputRes            *models.RSVP
+	outputPromotedUserId string
+}{
+	{1,
+		"test",
+		"test",
+		"going",
+		0,
+		nil,
+		nil,
+		&models.RSVP{Status: models.RSVPGoing},
+		"",
+	},
+	{2,
+		"test",
+		"test",
+		"going",
+		3,
+		nil,
+		nil,
+		&models.RSVP{Status: models.RSVPGoing, WaitlistPosition: 3},
+		"",
+	},
+	{3,
+		"test",
+		"test",
+		"maybe",
+		0,
+		nil,
+		nil,
+		&models.RSVP{Status: models.RSVPMaybe},
+		"7",
+	},
+	{4,
+		"test",
+		"test",
+		"declined",
+		0,
+		errors.New("test_err"),
+		errors.New("test_err"),
+		nil,
+		"",
+	},
+	{5,
+		"test",
+		"test",
+		"interested",
+		0,
+		nil,
+		error2.ErrRSVPStatus,
+		nil,
+		"",
+	},
+	{6,
+		"",
+		"test",
+		"going",
+		0,
+		nil,
+		error2.ErrEmptyData,
+		nil,
+		"",
+	},
+}
+
+func TestSetRSVP(t *testing.T) {
+	for _, test := range setRSVPTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("Visit", test.eventId, test.userId).Return(test.waitlistPosition, test.repoErr)
+		repositoryMock.On("SetRSVP", test.eventId, test.userId, test.status).Return(test.outputPromotedUserId, test.repoErr)
+		actualRes, actualPromotedUserId, actualErr := useCaseTest.SetRSVP(test.eventId, test.userId, test.status)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputPromotedUserId, actualPromotedUserId, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+	}
+}
+
+var getRSVPTests = []struct {
+	id        int
+	userId    string
+	eventId   string
+	outputErr error
+	outputRes *models.RSVP
+}{
+	{1,
+		"test",
+		"test",
+		nil,
+		&models.RSVP{Status: models.RSVPMaybe},
+	},
+	{2,
+		"",
+		"",
+		error2.ErrEmptyData,
+		nil,
+	},
+	{3,
+		"test",
+		"test",
+		errors.New("test_err"),
+		nil,
+	},
+}
+
+func TestGetRSVP(t *testing.T) {
+	for _, test := range getRSVPTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("GetRSVP", test.eventId, test.userId).Return(test.outputRes, test.outputErr)
+		actualRes, actualErr := useCaseTest.GetRSVP(test.eventId, test.userId)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+	}
+}
+
+var inviteTests = []struct {
+	id          int
+	eventId     string
+	inviterId   string
+	receiverIds []string
+	outputErr   error
+}{
+	{1,
+		"1",
+		"2",
+		[]string{"3", "4"},
+		nil,
+	},
+	{2,
+		"1",
+		"2",
+		[]string{},
+		error2.ErrEmptyData,
+	},
+	{3,
+		"1",
+		"2",
+		[]string{"3", "2"},
+		error2.ErrNotAllowed,
+	},
+	{4,
+		"1",
+		"2",
+		[]string{"3"},
+		error2.ErrInvitationExists,
+	},
+}
+
+func TestInvite(t *testing.T) {
+	for _, test := range inviteTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("CreateInvitations", test.eventId, test.inviterId, test.receiverIds).Return(test.outputErr)
+		actualErr := useCaseTest.Invite(test.eventId, test.inviterId, test.receiverIds)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+	}
+}
+
+var respondInvitationTests = []struct {
+	id               int
+	invitationId     string
+	receiverId       string
+	status           string
+	repoErr          error
+	waitlistPosition int
+	called           bool
+	outputErr        error
+}{
+	{1,
+		"1",
+		"2",
+		"accepted",
+		nil,
+		3,
+		true,
+		nil,
+	},
+	{2,
+		"1",
+		"2",
+		"declined",
+		nil,
+		0,
+		true,
+		nil,
+	},
+	{3,
+		"1",
+		"2",
+		"pending",
+		nil,
+		0,
+		false,
+		error2.ErrInvitationStatus,
+	},
+	{4,
+		"1",
+		"2",
+		"accepted",
+		error2.ErrInvitationExpired,
+		0,
+		true,
+		error2.ErrInvitationExpired,
+	},
+}
+
+func TestRespondInvitation(t *testing.T) {
+	for _, test := range respondInvitationTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		invitation := &models.Invitation{ID: test.invitationId, EventId: "5", ReceiverId: test.receiverId, Status: test.status}
+		repositoryMock.On("RespondInvitation", test.invitationId, test.receiverId, test.status).Return(invitation, test.waitlistPosition, test.repoErr)
+		actualRes, actualPosition, actualErr := useCaseTest.RespondInvitation(test.invitationId, test.receiverId, test.status)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		require.Equal(t, test.waitlistPosition, actualPosition, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if test.outputErr == nil {
+			require.Equal(t, invitation, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		}
+		if test.called {
+			repositoryMock.AssertCalled(t, "RespondInvitation", test.invitationId, test.receiverId, test.status)
+		} else {
+			repositoryMock.AssertNotCalled(t, "RespondInvitation", test.invitationId, test.receiverId, test.status)
+		}
+		repositoryMock.AssertNotCalled(t, "Visit", "5", test.receiverId)
+	}
+}
+
+var getCalendarTokenTests = []struct {
+	id          int
+	userId      string
+	storedToken string
+	outputErr   error
+	regenerated bool
+}{
+	{1, "1", "token", nil, false},
+	{2, "1", "", nil, true},
+	{3, "", "", error2.ErrEmptyData, false},
+}
+
+func TestGetCalendarToken(t *testing.T) {
+	for _, test := range getCalendarTokenTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("GetCalendarToken", test.userId).Return(test.storedToken, nil)
+		repositoryMock.On("SetCalendarToken", test.userId, testifyMock.AnythingOfType("string")).Return(nil)
+		actualToken, actualErr := useCaseTest.GetCalendarToken(test.userId)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if test.regenerated {
+			require.Len(t, actualToken, 2*calendarTokenBytes, logTestMessage+" "+strconv.Itoa(test.id))
+			repositoryMock.AssertCalled(t, "SetCalendarToken", test.userId, actualToken)
+		} else {
+			require.Equal(t, test.storedToken, actualToken, logTestMessage+" "+strconv.Itoa(test.id))
+			repositoryMock.AssertNotCalled(t, "SetCalendarToken", test.userId, testifyMock.Anything)
+		}
+	}
+}
+
+func TestRegenerateCalendarToken(t *testing.T) {
+	repositoryMock := new(mock.RepositoryMock)
+	useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+	repositoryMock.On("SetCalendarToken", "1", testifyMock.AnythingOfType("string")).Return(nil)
+	first, err := useCaseTest.RegenerateCalendarToken("1")
+	require.NoError(t, err, logTestMessage)
+	second, err := useCaseTest.RegenerateCalendarToken("1")
+	require.NoError(t, err, logTestMessage)
+	require.NotEqual(t, first, second, logTestMessage)
+}
+
+var getCalendarFeedTests = []struct {
+	id          int
+	userId      string
+	token       string
+	storedToken string
+	outputErr   error
+}{
+	{1, "1", "token", "token", nil},
+	{2, "1", "old", "token", error2.ErrCalendarToken},
+	{3, "1", "token", "", error2.ErrCalendarToken},
+	{4, "1", "", "token", error2.ErrEmptyData},
+}
+
+func TestGetCalendarFeed(t *testing.T) {
+	events := []*models.Event{{ID: "1"}}
+	for _, test := range getCalendarFeedTests {
+		repositoryMock := new(mock.RepositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
+		repositoryMock.On("GetCalendarToken", test.userId).Return(test.storedToken, nil)
+		repositoryMock.On("GetCalendarEvents", test.userId).Return(events, nil)
+		actualEvents, actualErr := useCaseTest.GetCalendarFeed(test.userId, test.token)
+		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if test.outputErr == nil {
+			require.Equal(t, events, actualEvents, logTestMessage+" "+strconv.Itoa(test.id))
+		} else {
+			repositoryMock.AssertNotCalled(t, "GetCalendarEvents", test.userId)
+		}
 	}
 }
 
@@ -400,7 +1156,7 @@ var getCitiesTests = []struct {
 func TestGetCities(t *testing.T) {
 	for _, test := range getCitiesTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, geocoderStub)
 		repositoryMock.On("GetCities").Return(test.outputRes, test.outputErr)
 		actualRes, actualErr := useCaseTest.GetCities()
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
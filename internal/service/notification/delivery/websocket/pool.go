@@ -6,8 +6,11 @@ import (
 	"github.com/gorilla/websocket"
 	"net/http"
 	"sync"
+	"time"
 )
 
+const closeTimeout = time.Second
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -62,6 +65,24 @@ func (p *Pool) PingConnections() int {
 	return res
 }
 
+// Close sends the close frame to every client, so that they reconnect to another instance
+func (p *Pool) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server is shutting down")
+	for userId, conn := range p.Connections {
+		if conn == nil {
+			continue
+		}
+		err := conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(closeTimeout))
+		if err != nil {
+			log.Error("pool:Close: err = ", err)
+		}
+		_ = conn.Close()
+		p.Connections[userId] = nil
+	}
+}
+
 func (p *Pool) WebsocketHandler(w http.ResponseWriter, r *http.Request) {
 	_, ok := w.(http.Hijacker)
 	if !ok {
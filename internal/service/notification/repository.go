@@ -13,4 +13,7 @@ type Repository interface {
 	GetAllNotifications(userId string) ([]*models.Notification, error)
 	GetNewNotifications(userId string) ([]*models.Notification, error)
 	CreateTomorrowEventNotification(receiverId string, invitor *models.User, event *models.Event) error
+	CreateWaitlistNotification(receiverId string, author *models.User, event *models.Event) error
+	CreateInviteAcceptedNotification(receiverId string, invited *models.User, event *models.Event) error
+	CreateInviteDeclinedNotification(receiverId string, invited *models.User, event *models.Event) error
 }
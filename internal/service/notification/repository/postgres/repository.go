@@ -23,10 +23,13 @@ func NewRepository(db *sql.DB) *Repository {
 }
 
 const (
-	newSubscriberType = "0"
-	invitationType    = "1"
-	newEventType      = "2"
-	eventTomorrowType = "3"
+	newSubscriberType  = "0"
+	invitationType     = "1"
+	newEventType       = "2"
+	eventTomorrowType  = "3"
+	waitlistType       = "4"
+	inviteAcceptedType = "5"
+	inviteDeclinedType = "6"
 )
 
 func (s *Repository) CreateSubscribeNotification(receiverId string, user *models.User, event *models.Event) error {
@@ -170,3 +173,51 @@ func (s *Repository) CreateTomorrowEventNotification(receiverId string, user *mo
 	log.Debug(message + "ended")
 	return nil
 }
+
+func (s *Repository) CreateWaitlistNotification(receiverId string, user *models.User, event *models.Event) error {
+	message := logMessage + "CreateWaitlistNotification:"
+	log.Debug(message + "started")
+	query := `insert into "notification" (type, receiver_id, user_id, user_name, user_surname, user_img_url, event_id, event_title) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	rows, err := s.db.Query(query, waitlistType, receiverId, user.ID, user.Name, user.Surname, user.ImgUrl, event.ID, event.Title)
+	if err != nil {
+		if !strings.Contains(err.Error(), "duplicate key") {
+			log.Error(message+"err = ", err)
+		}
+		return error2.ErrPostgres
+	}
+	defer rows.Close()
+	log.Debug(message + "ended")
+	return nil
+}
+
+func (s *Repository) CreateInviteAcceptedNotification(receiverId string, user *models.User, event *models.Event) error {
+	message := logMessage + "CreateInviteAcceptedNotification:"
+	log.Debug(message + "started")
+	query := `insert into "notification" (type, receiver_id, user_id, user_name, user_surname, user_img_url, event_id, event_title) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	rows, err := s.db.Query(query, inviteAcceptedType, receiverId, user.ID, user.Name, user.Surname, user.ImgUrl, event.ID, event.Title)
+	if err != nil {
+		if !strings.Contains(err.Error(), "duplicate key") {
+			log.Error(message+"err = ", err)
+		}
+		return error2.ErrPostgres
+	}
+	defer rows.Close()
+	log.Debug(message + "ended")
+	return nil
+}
+
+func (s *Repository) CreateInviteDeclinedNotification(receiverId string, user *models.User, event *models.Event) error {
+	message := logMessage + "CreateInviteDeclinedNotification:"
+	log.Debug(message + "started")
+	query := `insert into "notification" (type, receiver_id, user_id, user_name, user_surname, user_img_url, event_id, event_title) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	rows, err := s.db.Query(query, inviteDeclinedType, receiverId, user.ID, user.Name, user.Surname, user.ImgUrl, event.ID, event.Title)
+	if err != nil {
+		if !strings.Contains(err.Error(), "duplicate key") {
+			log.Error(message+"err = ", err)
+		}
+		return error2.ErrPostgres
+	}
+	defer rows.Close()
+	log.Debug(message + "ended")
+	return nil
+}
@@ -2,6 +2,8 @@ package http
 
 import (
 	response "backend/internal/response"
+	"backend/internal/service/auth"
+	error2 "backend/internal/service/auth/error"
 	"backend/internal/service/user"
 	"backend/internal/utils"
 	log "backend/pkg/logger"
@@ -16,12 +18,14 @@ const logMessage = "service:user:delivery:http:"
 
 type Delivery struct {
 	useCase     user.UseCase
+	authUseCase auth.UseCase
 	notificator notificator.NotificationManager
 }
 
-func NewDelivery(useCase user.UseCase, notificator notificator.NotificationManager) *Delivery {
+func NewDelivery(useCase user.UseCase, authUseCase auth.UseCase, notificator notificator.NotificationManager) *Delivery {
 	return &Delivery{
 		useCase:     useCase,
+		authUseCase: authUseCase,
 		notificator: notificator,
 	}
 }
@@ -34,7 +38,15 @@ func (h *Delivery) GetUser(w http.ResponseWriter, r *http.Request) {
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	CSRFToken, err := utils.GenerateCsrfToken(userId)
+	// The page is loaded with a fresh token of the current session
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	CSRFToken, err := h.authUseCase.CreateToken(cookie.Value)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
@@ -85,15 +97,40 @@ func (h *Delivery) UpdateUserInfo(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "ended")
 }
 
+// The password is changed by the auth service, which logs the user out of the other sessions at the same time
 func (h *Delivery) UpdateUserPassword(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "UpdateUserPassword:"
 	log.Debug(message + "started")
-	userId := r.Context().Value(response.CtxString("userId")).(string)
 	u, err := response.GetUserFromRequest(r.Body)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	err = h.useCase.UpdateUserPassword(userId, u.Password)
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.authUseCase.ChangePassword(cookie.Value, u.Password)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "UpdateUserRole:"
+	log.Debug(message + "started")
+	adminId := r.Context().Value(response.CtxString("userId")).(string)
+	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	userId := vars["id"]
+	role, err := response.GetUserRoleFromRequest(r.Body)
+	if !response.CheckIfNoError(&w, err, message) {
+		return
+	}
+	err = h.useCase.UpdateUserRole(adminId, userId, role)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
@@ -147,12 +184,13 @@ func (h *Delivery) GetFriends(w http.ResponseWriter, r *http.Request) {
 func (h *Delivery) GetVisitors(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "GetVisitors:"
 	log.Debug(message + "started")
-	eventId := r.Context().Value(response.CtxString("eventId")).(string)
-	userList, err := h.useCase.GetVisitors(eventId)
+	vars := r.Context().Value(response.CtxString("vars")).(map[string]string)
+	eventId := vars["id"]
+	visitors, err := h.useCase.GetVisitors(eventId)
 	if !response.CheckIfNoError(&w, err, message) {
 		return
 	}
-	response.SendResponse(w, response.UserListResponse(userList))
+	response.SendResponse(w, response.VisitorsResponse(visitors))
 	log.Debug(message + "ended")
 }
 
@@ -203,29 +241,6 @@ func (h *Delivery) IsSubscribed(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "ended")
 }
 
-func (h *Delivery) Invite(w http.ResponseWriter, r *http.Request) {
-	message := logMessage + "Invite:"
-	log.Debug(message + "started")
-	q := r.URL.Query()
-	var eventId string
-	if len(q["eventId"]) > 0 {
-		eventId = q["eventId"][0]
-	}
-	userId := r.Context().Value(response.CtxString("userId")).(string)
-	receiversId, err := response.GetUsersIdFromRequest(r.Body)
-	if !response.CheckIfNoError(&w, err, message) {
-		return
-	}
-	for _, receiverId := range receiversId {
-		err = h.notificator.InvitationNotification(receiverId, userId, eventId)
-		if !response.CheckIfNoError(&w, err, message) {
-			return
-		}
-	}
-	response.SendResponse(w, response.OkResponse())
-	log.Debug(message + "ended")
-}
-
 func (h *Delivery) GetAllNotifications(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "GetAllNotifications:"
 	log.Debug(message + "started")
@@ -1,8 +1,10 @@
 package http
 
 import (
+	"backend/internal/errcode"
 	models "backend/internal/models"
 	response "backend/internal/response"
+	authUseCase "backend/internal/service/auth/usecase"
 	error2 "backend/internal/service/user/error"
 	"backend/internal/service/user/usecase"
 	"backend/pkg/notificator"
@@ -38,25 +40,36 @@ var getUserTests = []struct {
 		"1",
 		nil,
 		error2.ErrUserNotFound,
-		response.StatusResponse(http.StatusNotFound)},
+		response.ErrorResponse(http.StatusNotFound, errcode.CodeUserNotFound)},
 }
 
 func TestGetUser(t *testing.T) {
 	for _, test := range getUserTests {
 		useCaseMock := new(usecase.UseCaseMock)
+		authUseCaseMock := new(authUseCase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, authUseCaseMock, notificatorMock)
 
 		userId := test.input
 		useCaseMock.On("GetUserById", userId).Return(test.user, test.useCaseErr)
+		authUseCaseMock.On("CreateToken", "session").Return("csrf", nil)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/user", deliveryTest.GetUser).Methods("GET")
 		w := httptest.NewRecorder()
 		req, err := http.NewRequest("GET", "/user", bytes.NewBuffer(nil))
 		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
 		userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), userId)
 		r.ServeHTTP(w, req.WithContext(userIdContext))
+
+		b, err := json.Marshal(test.output)
+		require.NoError(t, err)
+		require.JSONEq(t, string(b), w.Body.String())
+		if test.useCaseErr == nil {
+			// The token is bound to the session of the request
+			require.Equal(t, "csrf", w.Header().Get("X-CSRF-Token"))
+		}
 	}
 }
 
@@ -80,14 +93,14 @@ var getUserByIdTests = []struct {
 		"1",
 		nil,
 		error2.ErrUserNotFound,
-		response.StatusResponse(http.StatusNotFound)},
+		response.ErrorResponse(http.StatusNotFound, errcode.CodeUserNotFound)},
 }
 
 func TestGetUserById(t *testing.T) {
 	for _, test := range getUserByIdTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		userId := test.input
 
@@ -126,7 +139,7 @@ var updateUserInfoTests = []struct {
 			About:   "testAbout",
 		},
 		error2.ErrUserNotFound,
-		response.StatusResponse(http.StatusNotFound)},
+		response.ErrorResponse(http.StatusNotFound, errcode.CodeUserNotFound)},
 	{3,
 		"1",
 		nil,
@@ -138,7 +151,7 @@ func TestUpdateUserInfo(t *testing.T) {
 	for _, test := range updateUserInfoTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		userId := test.input
 
@@ -192,7 +205,7 @@ var updateUserPasswordTests = []struct {
 			Password: "testPassword",
 		},
 		error2.ErrUserNotFound,
-		response.StatusResponse(http.StatusNotFound)},
+		response.ErrorResponse(http.StatusNotFound, errcode.CodeUserNotFound)},
 	{3,
 		"1",
 		nil,
@@ -203,8 +216,9 @@ var updateUserPasswordTests = []struct {
 func TestUpdateUserPassword(t *testing.T) {
 	for _, test := range updateUserPasswordTests {
 		useCaseMock := new(usecase.UseCaseMock)
+		authUseCaseMock := new(authUseCase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, authUseCaseMock, notificatorMock)
 
 		userId := test.input
 
@@ -213,9 +227,7 @@ func TestUpdateUserPassword(t *testing.T) {
 			userModel.Password = test.user.Password
 		}
 
-		useCaseMock.On("UpdateUserPassword",
-			userId,
-			userModel.Password).Return(test.useCaseErr)
+		authUseCaseMock.On("ChangePassword", "session", userModel.Password).Return(test.useCaseErr)
 
 		bodyUserJSON, err := json.Marshal(test.user)
 		require.NoError(t, err, logTestMessage+"err =", err)
@@ -228,10 +240,71 @@ func TestUpdateUserPassword(t *testing.T) {
 		r.HandleFunc("/user/password", deliveryTest.UpdateUserPassword).Methods("POST")
 		req, err := http.NewRequest("POST", "/user/password", bytes.NewBuffer(bodyUserJSON))
 		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
 
 		w := httptest.NewRecorder()
 		userIdContext := context.WithValue(context.Background(), response.CtxString("userId"), userId)
 		r.ServeHTTP(w, req.WithContext(userIdContext))
+		if test.user != nil {
+			authUseCaseMock.AssertCalled(t, "ChangePassword", "session", userModel.Password)
+		} else {
+			authUseCaseMock.AssertNotCalled(t, "ChangePassword", "session", userModel.Password)
+		}
+	}
+}
+
+var updateUserRoleTests = []struct {
+	id         int
+	body       string
+	role       string
+	useCaseErr error
+	output     *response.Response
+}{
+	{
+		1,
+		`{"role":"moderator"}`,
+		models.RoleModerator,
+		nil,
+		response.OkResponse(),
+	},
+	{
+		2,
+		`{"role":"owner"}`,
+		"owner",
+		error2.ErrUnknownRole,
+		response.ErrorResponse(http.StatusBadRequest, errcode.CodeRole),
+	},
+	{
+		3,
+		`{"role":"admin"}`,
+		models.RoleAdmin,
+		error2.ErrNotAllowed,
+		response.ErrorResponse(http.StatusForbidden, errcode.CodeNotAllowed),
+	},
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	for _, test := range updateUserRoleTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		notificatorMock := new(notificator.NotificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
+
+		useCaseMock.On("UpdateUserRole", "1", "2", test.role).Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/user/2/role", deliveryTest.UpdateUserRole).Methods("POST")
+		req, err := http.NewRequest("POST", "/user/2/role", bytes.NewBufferString(test.body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		ctx := context.WithValue(context.Background(), response.CtxString("userId"), "1")
+		ctx = context.WithValue(ctx, response.CtxString("vars"), map[string]string{"id": "2"})
+		r.ServeHTTP(w, req.WithContext(ctx))
+
+		b, err := json.Marshal(test.output)
+		require.NoError(t, err)
+		require.JSONEq(t, string(b), w.Body.String(), test.id)
+		useCaseMock.AssertExpectations(t)
 	}
 }
 
@@ -256,7 +329,7 @@ func TestGetSubscribers(t *testing.T) {
 	for _, test := range getSubscribersTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		useCaseMock.On("GetSubscribers", test.userId).Return([]*models.User{}, test.useCaseErr)
 
@@ -290,7 +363,7 @@ func TestGetSubscribes(t *testing.T) {
 	for _, test := range getSubscribesTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		useCaseMock.On("GetSubscribes", test.userId).Return([]*models.User{}, test.useCaseErr)
 
@@ -325,7 +398,7 @@ func TestGetFriends(t *testing.T) {
 	for _, test := range getFriendsTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		useCaseMock.On("GetFriends", test.userId).Return([]*models.User{}, test.useCaseErr)
 
@@ -361,17 +434,17 @@ func TestGetVisitors(t *testing.T) {
 	for _, test := range getVisitorsTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
-		useCaseMock.On("GetVisitors", test.eventId).Return([]*models.User{}, test.useCaseErr)
+		useCaseMock.On("GetVisitors", test.eventId).Return(&models.Visitors{}, test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/test", deliveryTest.GetVisitors).Methods("GET")
 		req, err := http.NewRequest("GET", "/test", nil)
 		require.NoError(t, err, logTestMessage+"NewRequest error")
 		w := httptest.NewRecorder()
-		eventIdCtx := context.WithValue(context.Background(), response.CtxString("eventId"), test.eventId)
-		r.ServeHTTP(w, req.WithContext(eventIdCtx))
+		varsCtx := context.WithValue(context.Background(), response.CtxString("vars"), map[string]string{"id": test.eventId})
+		r.ServeHTTP(w, req.WithContext(varsCtx))
 	}
 }
 
@@ -403,7 +476,7 @@ func TestSubscribe(t *testing.T) {
 	for _, test := range subscribeTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		var eId string
 		var uId string
@@ -461,7 +534,7 @@ func TestUnsubscribe(t *testing.T) {
 	for _, test := range unsubscribeTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		var eId string
 		var uId string
@@ -519,7 +592,7 @@ func TestIsSubscribed(t *testing.T) {
 	for _, test := range isSubscribedTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
+		deliveryTest := NewDelivery(useCaseMock, nil, notificatorMock)
 
 		var eId string
 		var uId string
@@ -547,58 +620,3 @@ func TestIsSubscribed(t *testing.T) {
 		r.ServeHTTP(w, req)
 	}
 }
-
-/*
-var inviteTests = []struct {
-	id         int
-	vars       map[string]string
-	userId     string
-	eventId    string
-	useCaseErr error
-}{
-	{
-		1,
-		map[string]string{
-			"id": "1",
-		},
-		"1",
-		"1",
-		nil,
-	},
-	{
-		2,
-		map[string]string{
-			"id": "123",
-		},
-		"1",
-		"1",
-		errors.New("test_err"),
-	},
-}
-
-func TestInvite(t *testing.T) {
-	for _, test := range inviteTests {
-		useCaseMock := new(usecase.UseCaseMock)
-		notificatorMock := new(notificator.NotificatorMock)
-		deliveryTest := NewDelivery(useCaseMock, notificatorMock)
-
-		vars := test.vars
-		receiverId := vars["id"]
-		userId := test.userId
-
-		useCaseMock.On("InvitationNotification", receiverId, userId, test.eventId).Return(test.useCaseErr)
-
-		r := mux.NewRouter()
-		r.HandleFunc("/test", deliveryTest.Invite).Methods("GET")
-		req, err := http.NewRequest("GET", "/test?eventId="+test.eventId, nil)
-		require.NoError(t, err, logTestMessage+"NewRequest error")
-
-		ctxVars := context.WithValue(context.Background(), response.CtxString("vars"), test.vars)
-		ctxUserId := context.WithValue(ctxVars, "userId", test.userId)
-		req = req.WithContext(ctxUserId)
-
-		w := httptest.NewRecorder()
-		r.ServeHTTP(w, req)
-	}
-}
-*/
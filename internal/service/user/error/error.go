@@ -7,4 +7,6 @@ var (
 	ErrEmptyData    = errors.New("required data is empty")
 	ErrPostgres     = errors.New("internal DB server error")
 	ErrAtoi         = errors.New("cant cast string to int")
+	ErrNotAllowed   = errors.New("user is not allowed to do this")
+	ErrUnknownRole  = errors.New("unknown user role")
 )
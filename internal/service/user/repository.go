@@ -9,11 +9,12 @@ type Repository interface {
 	///////
 	UpdateUserInfo(user *models.User) error
 	UpdateUserPassword(userId string, password string) error
+	UpdateUserRole(userId string, role string) error
 	///////
 	GetSubscribers(userId string) ([]*models.User, error)
 	GetSubscribes(userId string) ([]*models.User, error)
 	GetFriends(userId string, eventId string) ([]*models.User, error)
-	GetVisitors(eventId string) ([]*models.User, error)
+	GetVisitors(eventId string) (*models.Visitors, error)
 	///////
 	Subscribe(subscribedId string, subscriberId string) error
 	Unsubscribe(subscribedId string, subscriberId string) error
@@ -25,6 +25,8 @@ func MakeProtoUser(u *models.User) *proto.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
 
@@ -40,6 +42,8 @@ func MakeModelUser(u *proto.User) *models.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
 
@@ -70,6 +74,15 @@ func (a *Repository) UpdateUserPassword(userId string, password string) error {
 	return err
 }
 
+func (a *Repository) UpdateUserRole(userId string, role string) error {
+	in := &proto.User{
+		ID:   userId,
+		Role: role,
+	}
+	_, err := a.client.UpdateUserRole(context.Background(), in)
+	return err
+}
+
 func (a *Repository) GetSubscribers(userId string) ([]*models.User, error) {
 	in := &proto.UserId{
 		ID: userId,
@@ -116,7 +129,15 @@ func (a *Repository) GetFriends(userId string, eventId string) ([]*models.User,
 	return result, err
 }
 
-func (a *Repository) GetVisitors(eventId string) ([]*models.User, error) {
+func makeModelUsers(protoUsers []*proto.User) []*models.User {
+	result := make([]*models.User, len(protoUsers))
+	for i, protoUser := range protoUsers {
+		result[i] = MakeModelUser(protoUser)
+	}
+	return result
+}
+
+func (a *Repository) GetVisitors(eventId string) (*models.Visitors, error) {
 	in := &proto.EventId{
 		ID: eventId,
 	}
@@ -124,9 +145,10 @@ func (a *Repository) GetVisitors(eventId string) ([]*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*models.User, len(out.Users))
-	for i, protoUser := range out.Users {
-		result[i] = MakeModelUser(protoUser)
+	result := &models.Visitors{
+		Going:    makeModelUsers(out.Going),
+		Maybe:    makeModelUsers(out.Maybe),
+		Declined: makeModelUsers(out.Declined),
 	}
 	return result, err
 }
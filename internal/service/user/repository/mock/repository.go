@@ -24,6 +24,11 @@ func (m *RepositoryMock) UpdateUserPassword(userId string, password string) erro
 	return args.Error(0)
 }
 
+func (m *RepositoryMock) UpdateUserRole(userId string, role string) error {
+	args := m.Called(userId, role)
+	return args.Error(0)
+}
+
 func (m *RepositoryMock) GetSubscribers(userId string) ([]*models.User, error) {
 	args := m.Called(userId)
 	return args.Get(0).([]*models.User), args.Error(1)
@@ -39,9 +44,9 @@ func (m *RepositoryMock) GetFriends(userId string, eventId string) ([]*models.Us
 	return args.Get(0).([]*models.User), args.Error(1)
 }
 
-func (m *RepositoryMock) GetVisitors(eventId string) ([]*models.User, error) {
+func (m *RepositoryMock) GetVisitors(eventId string) (*models.Visitors, error) {
 	args := m.Called(eventId)
-	return args.Get(0).([]*models.User), args.Error(1)
+	return args.Get(0).(*models.Visitors), args.Error(1)
 }
 
 func (m *RepositoryMock) Subscribe(subscribedId string, subscriberId string) error {
@@ -16,6 +16,7 @@ const (
 	updateUserInfoQueryWithoutImgUrl = `update "user" set name = $1, surname = $2, about = $3 where id = $4`
 	updateUserInfoQuery              = `update "user" set name = $1, surname = $2, about = $3, img_url = $4 where id = $5`
 	updateUserPasswordQuery          = `update "user" set password = $1 where id = $2`
+	updateUserRoleQuery              = `update "user" set role = $1 where id = $2`
 	getSubscribersQuery              = `select u.* from "user" as u join subscribe s on s.subscriber_id = u.id where s.subscribed_id = $1`
 	getSubscribesQuery               = `select u.* from "user" as u join subscribe s on s.subscribed_id = u.id where s.subscriber_id = $1`
 	getFriendsQuery                  = `select * from "user" as u where u.id in (select u.id as u_id from "user" as u
@@ -32,8 +33,8 @@ const (
         where u_id not in (
             select author_id from "event" where id = $2
             union
-            select receiver_id::int from notification as n where n.event_id = $2::varchar and type = '1'))`
-	getVisitorsQuery  = `select u.* from "user" as u join visitor v on u.id = v.user_id where v.event_id = $1`
+            select receiver_id from "invitation" where event_id = $2))`
+	getVisitorsQuery  = `select u.*, v.status from "user" as u join visitor v on u.id = v.user_id where v.event_id = $1 order by v.id`
 	subscribeQuery    = `insert into "subscribe" (subscribed_id, subscriber_id) values ($1, $2)`
 	unsubscribeQuery  = `delete from subscribe where subscribed_id = $1 and subscriber_id = $2`
 	isSubscribedQuery = `select count(*) from subscribe where subscribed_id = $1 and subscriber_id = $2`
@@ -118,6 +119,31 @@ func (s *Repository) UpdateUserPassword(userId string, password string) error {
 	return nil
 }
 
+func (s *Repository) UpdateUserRole(userId string, role string) error {
+	message := logMessage + "UpdateUserRole:"
+	log.Debug(message + "started")
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrAtoi
+	}
+	query := updateUserRoleQuery
+	result, err := s.db.Exec(query, role, userIdInt)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	if updated == 0 {
+		return error2.ErrUserNotFound
+	}
+	log.Debug(message + "ended")
+	return nil
+}
+
 func (s *Repository) GetSubscribers(userId string) ([]*models.User, error) {
 	message := logMessage + "GetSubscribers:"
 	log.Debug(message + "started")
@@ -221,7 +247,8 @@ func (s *Repository) GetFriends(userId string, eventId string) ([]*models.User,
 	return resultUsers, nil
 }
 
-func (s *Repository) GetVisitors(eventId string) ([]*models.User, error) {
+// Visitors are grouped by their RSVP status
+func (s *Repository) GetVisitors(eventId string) (*models.Visitors, error) {
 	message := logMessage + "GetVisitors:"
 	log.Debug(message + "started")
 	eventIdInt, err := strconv.Atoi(eventId)
@@ -235,19 +262,26 @@ func (s *Repository) GetVisitors(eventId string) ([]*models.User, error) {
 		return nil, error2.ErrPostgres
 	}
 	defer rows.Close()
-	var resultUsers []*models.User
+	resultVisitors := &models.Visitors{}
 	for rows.Next() {
-		var u User
-		err := rows.StructScan(&u)
+		var v Visitor
+		err := rows.StructScan(&v)
 		if err != nil {
 			log.Error(message+"err = ", err)
 			return nil, error2.ErrPostgres
 		}
-		modelUser := toModelUser(&u)
-		resultUsers = append(resultUsers, modelUser)
+		modelUser := toModelUser(&v.User)
+		switch v.Status {
+		case models.RSVPMaybe:
+			resultVisitors.Maybe = append(resultVisitors.Maybe, modelUser)
+		case models.RSVPDeclined:
+			resultVisitors.Declined = append(resultVisitors.Declined, modelUser)
+		default:
+			resultVisitors.Going = append(resultVisitors.Going, modelUser)
+		}
 	}
 	log.Debug(message + "ended")
-	return resultUsers, nil
+	return resultVisitors, nil
 }
 
 func (s *Repository) Subscribe(subscribedId string, subscriberId string) error {
@@ -222,6 +222,69 @@ func TestUpdateUserPassword(t *testing.T) {
 	}
 }
 
+var updateUserRoleTests = []struct {
+	id          int
+	userId      string
+	role        string
+	updated     int64
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		models.RoleModerator,
+		1,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"a",
+		models.RoleModerator,
+		0,
+		nil,
+		error3.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		models.RoleModerator,
+		0,
+		nil,
+		error3.ErrUserNotFound,
+	},
+	{
+		4,
+		"1",
+		models.RoleModerator,
+		0,
+		sql2.ErrConnDone,
+		error3.ErrPostgres,
+	},
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	for _, test := range updateUserRoleTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
+		userIdInt, err := strconv.Atoi(test.userId)
+		if err == nil {
+			mock.ExpectExec(updateUserRoleQuery).
+				WithArgs(test.role, userIdInt).
+				WillReturnResult(sqlmock.NewResult(0, test.updated)).
+				WillReturnError(test.postgresErr)
+		}
+		actualErr := repositoryTest.UpdateUserRole(test.userId, test.role)
+		require.Equal(t, test.outputErr, actualErr, test.id)
+		require.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
+
 var getSubscribersTests = []struct {
 	id          int
 	userId      string
@@ -347,61 +410,83 @@ func TestGetSubscribes(t *testing.T) {
 var getVisitorsTests = []struct {
 	id          int
 	eventId     string
+	statuses    []string
 	postgresErr error
 	outputErr   error
-	outputRes   []*models.User
+	outputRes   *models.Visitors
 }{
 	{
 		1,
 		"1",
+		[]string{"going"},
 		nil,
 		nil,
-		[]*models.User{
-			&models.User{ID: "1"},
+		&models.Visitors{
+			Going: []*models.User{
+				&models.User{ID: "1"},
+			},
 		},
 	},
 	{
 		2,
 		"a",
 		nil,
+		nil,
 		error3.ErrAtoi,
-		[]*models.User{},
+		nil,
 	},
 	{
 		3,
 		"1",
+		nil,
 		sql2.ErrConnDone,
 		error3.ErrPostgres,
-		[]*models.User{},
+		nil,
+	},
+	{
+		4,
+		"1",
+		[]string{"going", "maybe", "declined", "maybe"},
+		nil,
+		nil,
+		&models.Visitors{
+			Going: []*models.User{
+				&models.User{ID: "1"},
+			},
+			Maybe: []*models.User{
+				&models.User{ID: "2"},
+				&models.User{ID: "4"},
+			},
+			Declined: []*models.User{
+				&models.User{ID: "3"},
+			},
+		},
 	},
 }
 
 func TestGetVisitors(t *testing.T) {
-	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-	require.NoError(t, err, logMessage, err)
-	defer db.Close()
-	sqlxDB := sqlx.NewDb(db, "sqlmock")
-	repositoryTest := NewRepository(sqlxDB)
-
 	for _, test := range getVisitorsTests {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err, logMessage, err)
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+		repositoryTest := NewRepository(sqlxDB)
+
 		eventIdInt, err := strconv.Atoi(test.eventId)
-		if err != nil {
-			eventIdInt = 0
+		if err == nil {
+			rows := sqlmock.NewRows([]string{"id", "status"})
+			for i, status := range test.statuses {
+				rows.AddRow(i+1, status)
+			}
+			mock.ExpectQuery(getVisitorsQuery).
+				WithArgs(eventIdInt).
+				WillReturnRows(rows).
+				WillReturnError(test.postgresErr)
 		}
-
-		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
-
-		mock.ExpectQuery(getVisitorsQuery).
-			WithArgs(eventIdInt).
-			WillReturnRows(rows).
-			WillReturnError(test.postgresErr)
 		out, actualErr := repositoryTest.GetVisitors(test.eventId)
 		require.Equal(t, test.outputErr, actualErr)
-		if test.outputErr != nil {
-			require.Equal(t, []*models.User(nil), out)
-		} else {
-			require.Equal(t, test.outputRes, out)
-		}
+		require.Equal(t, test.outputRes, out)
+		require.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
 	}
 }
 
@@ -14,6 +14,8 @@ type User struct {
 	Password string `db:"password"`
 	About    string `db:"about"`
 	ImgUrl   string `db:"img_url"`
+	Verified bool   `db:"verified"`
+	Role     string `db:"role"`
 }
 
 func toPostgresUser(u *models.User) (*User, error) {
@@ -47,5 +49,12 @@ func toModelUser(u *User) *models.User {
 		Password: u.Password,
 		About:    u.About,
 		ImgUrl:   u.ImgUrl,
+		Verified: u.Verified,
+		Role:     u.Role,
 	}
 }
+
+type Visitor struct {
+	User
+	Status string `db:"status"`
+}
@@ -8,12 +8,13 @@ type UseCase interface {
 	GetUserById(userId string) (*models.User, error)
 	///////
 	UpdateUserInfo(user *models.User) error
-	UpdateUserPassword(userId string, password string) error
+	// UpdateUserRole is done by adminId, who cannot change the own role
+	UpdateUserRole(adminId string, userId string, role string) error
 	///////
 	GetSubscribers(userId string) ([]*models.User, error)
 	GetSubscribes(userId string) ([]*models.User, error)
 	GetFriends(userId string, eventId string) ([]*models.User, error)
-	GetVisitors(eventId string) ([]*models.User, error)
+	GetVisitors(eventId string) (*models.Visitors, error)
 	///////
 	Subscribe(subscribedId string, subscriberId string) error
 	Unsubscribe(subscribedId string, subscriberId string) error
@@ -19,8 +19,8 @@ func (m *UseCaseMock) UpdateUserInfo(user *models.User) error {
 	return args.Error(0)
 }
 
-func (m *UseCaseMock) UpdateUserPassword(userId string, password string) error {
-	args := m.Called(userId, password)
+func (m *UseCaseMock) UpdateUserRole(adminId string, userId string, role string) error {
+	args := m.Called(adminId, userId, role)
 	return args.Error(0)
 }
 
@@ -39,9 +39,9 @@ func (m *UseCaseMock) GetFriends(userId string, eventId string) ([]*models.User,
 	return args.Get(0).([]*models.User), args.Error(1)
 }
 
-func (m *UseCaseMock) GetVisitors(eventId string) ([]*models.User, error) {
+func (m *UseCaseMock) GetVisitors(eventId string) (*models.Visitors, error) {
 	args := m.Called(eventId)
-	return args.Get(0).([]*models.User), args.Error(1)
+	return args.Get(0).(*models.Visitors), args.Error(1)
 }
 
 func (m *UseCaseMock) Subscribe(subscribedId string, subscriberId string) error {
@@ -4,7 +4,6 @@ import (
 	"backend/internal/models"
 	"backend/internal/service/user"
 	error2 "backend/internal/service/user/error"
-	"backend/internal/utils"
 )
 
 type UseCase struct {
@@ -36,12 +35,18 @@ func (a *UseCase) UpdateUserInfo(u *models.User) error {
 	return a.repository.UpdateUserInfo(u)
 }
 
-func (a *UseCase) UpdateUserPassword(userId string, password string) error {
-	if userId == "" || password == "" {
+func (a *UseCase) UpdateUserRole(adminId string, userId string, role string) error {
+	if adminId == "" || userId == "" || role == "" {
 		return error2.ErrEmptyData
 	}
-	hashedPassword := utils.CreatePasswordHash(password)
-	return a.repository.UpdateUserPassword(userId, hashedPassword)
+	if !models.IsRole(role) {
+		return error2.ErrUnknownRole
+	}
+	// Otherwise the last admin could leave the service without one
+	if adminId == userId {
+		return error2.ErrNotAllowed
+	}
+	return a.repository.UpdateUserRole(userId, role)
 }
 
 func (a *UseCase) GetSubscribers(userId string) ([]*models.User, error) {
@@ -86,18 +91,20 @@ func (a *UseCase) GetFriends(userId string, eventId string) ([]*models.User, err
 	return resultUsers, nil
 }
 
-func (a *UseCase) GetVisitors(eventId string) ([]*models.User, error) {
+func (a *UseCase) GetVisitors(eventId string) (*models.Visitors, error) {
 	if eventId == "" {
 		return nil, error2.ErrEmptyData
 	}
-	resultUsers, err := a.repository.GetVisitors(eventId)
+	resultVisitors, err := a.repository.GetVisitors(eventId)
 	if err != nil {
 		return nil, err
 	}
-	for i := range resultUsers {
-		resultUsers[i].Password = ""
+	for _, group := range [][]*models.User{resultVisitors.Going, resultVisitors.Maybe, resultVisitors.Declined} {
+		for i := range group {
+			group[i].Password = ""
+		}
 	}
-	return resultUsers, nil
+	return resultVisitors, nil
 }
 
 func (a *UseCase) Subscribe(subscribedId string, subscriberId string) error {
@@ -4,7 +4,6 @@ import (
 	"backend/internal/models"
 	error2 "backend/internal/service/user/error"
 	"backend/internal/service/user/repository/mock"
-	"backend/internal/utils"
 	"errors"
 
 	"github.com/stretchr/testify/require"
@@ -88,39 +87,63 @@ func TestUpdateUserInfo(t *testing.T) {
 	}
 }
 
-var updateUserPasswordTests = []struct {
+var updateUserRoleTests = []struct {
 	id        int
+	adminId   string
 	userId    string
-	password  string
+	role      string
+	repoErr   error
 	outputErr error
 }{
 	{
 		1,
-		"test",
-		"test",
+		"1",
+		"2",
+		models.RoleModerator,
+		nil,
 		nil,
 	},
 	{
 		2,
+		"1",
 		"",
-		"",
+		models.RoleModerator,
+		nil,
 		error2.ErrEmptyData,
 	},
 	{
 		3,
-		"test",
-		"test",
+		"1",
+		"2",
+		"owner",
+		nil,
+		error2.ErrUnknownRole,
+	},
+	{
+		4,
+		"1",
+		"1",
+		models.RoleUser,
+		nil,
+		error2.ErrNotAllowed,
+	},
+	{
+		5,
+		"1",
+		"2",
+		models.RoleAdmin,
+		errors.New("test_err"),
 		errors.New("test_err"),
 	},
 }
 
-func TestUpdateUserPassword(t *testing.T) {
-	for _, test := range updateUserPasswordTests {
+func TestUpdateUserRole(t *testing.T) {
+	for _, test := range updateUserRoleTests {
 		repositoryMock := new(mock.RepositoryMock)
 		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("UpdateUserPassword", test.userId, utils.CreatePasswordHash(test.password)).Return(test.outputErr)
-		actualErr := useCaseTest.UpdateUserPassword(test.userId, test.password)
-		require.Equal(t, test.outputErr, actualErr)
+		repositoryMock.On("UpdateUserRole", test.userId, test.role).Return(test.repoErr)
+		actualErr := useCaseTest.UpdateUserRole(test.adminId, test.userId, test.role)
+		require.Equal(t, test.outputErr, actualErr, test.id)
 	}
 }
 
@@ -201,24 +224,34 @@ func TestGetSubscribes(t *testing.T) {
 var getVisitorsTests = []struct {
 	id        int
 	eventId   string
+	repoRes   *models.Visitors
 	outputErr error
-	outputRes []*models.User
+	outputRes *models.Visitors
 }{
 	{
 		1,
 		"test",
+		&models.Visitors{
+			Going: []*models.User{{ID: "1", Password: "test"}},
+			Maybe: []*models.User{{ID: "2", Password: "test"}},
+		},
 		nil,
-		[]*models.User{},
+		&models.Visitors{
+			Going: []*models.User{{ID: "1"}},
+			Maybe: []*models.User{{ID: "2"}},
+		},
 	},
 	{
 		2,
 		"",
+		&models.Visitors{},
 		error2.ErrEmptyData,
 		nil,
 	},
 	{
 		3,
 		"test",
+		&models.Visitors{},
 		errors.New("test_err"),
 		nil,
 	},
@@ -228,7 +261,7 @@ func TestGetVisitors(t *testing.T) {
 	for _, test := range getVisitorsTests {
 		repositoryMock := new(mock.RepositoryMock)
 		useCaseTest := NewUseCase(repositoryMock)
-		repositoryMock.On("GetVisitors", test.eventId).Return([]*models.User{}, test.outputErr)
+		repositoryMock.On("GetVisitors", test.eventId).Return(test.repoRes, test.outputErr)
 		actualRes, actualErr := useCaseTest.GetVisitors(test.eventId)
 		require.Equal(t, test.outputErr, actualErr)
 		require.Equal(t, test.outputRes, actualRes)
@@ -2,7 +2,6 @@ package utils
 
 import (
 	log "backend/pkg/logger"
-	"crypto/sha256"
 	"errors"
 	"fmt"
 	"image/jpeg"
@@ -12,9 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 
-	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/go-redis/redis"
 	"github.com/jmoiron/sqlx"
 	uuid "github.com/satori/go.uuid"
@@ -31,12 +28,6 @@ var (
 	ErrFileDec = errors.New("file decoding error")
 )
 
-func CreatePasswordHash(password string) string {
-	hash := sha256.New()
-	hash.Write([]byte(password))
-	return fmt.Sprintf("%x", hash.Sum(nil))
-}
-
 func InitPostgresDB() (*sqlx.DB, error) {
 	message := logMessage + "InitPostgresDB:"
 
@@ -157,18 +148,3 @@ func SaveImageFromRequest(r *http.Request, key string) (string, error) {
 	}
 	return "https://bmstusa.ru/images/" + fileName, nil
 }
-
-func GenerateCsrfToken(userId string) (string, error) {
-	message := logMessage + "GenerateCsrfToken:"
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
-		ID:        userId,
-		ExpiresAt: jwt.At(time.Now().Add(time.Hour * 7 * 24)), //Week  P.S. Maybe Frontend should ask us
-	})
-	secretWord := os.Getenv("CSRFSECRET")
-	csrfToken, err := jwtToken.SignedString([]byte(secretWord))
-	if err != nil {
-		log.Error(message+"err = ", err)
-		return "", err
-	}
-	return csrfToken, err
-}
@@ -4,10 +4,6 @@ import (
 	"testing"
 )
 
-func TestCreatePasswordHash(t *testing.T) {
-	_ = CreatePasswordHash("test")
-}
-
 func TestInitPostgresDB(t *testing.T) {
 	_, _ = InitPostgresDB()
 }
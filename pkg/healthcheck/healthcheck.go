@@ -0,0 +1,145 @@
+package healthcheck
+
+import (
+	log "backend/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const logMessage = "pkg:healthcheck:"
+
+var ErrNotServing = errors.New("service is not serving")
+
+// Check returns an error when the dependency does not work
+type Check func(ctx context.Context) error
+
+type Checker struct {
+	mutex   sync.Mutex
+	names   []string
+	checks  map[string]Check
+	timeout time.Duration
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		checks:  make(map[string]Check),
+		timeout: timeout,
+	}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// Run runs all checks at once and returns the errors of the failed ones by name
+func (c *Checker) Run(ctx context.Context) map[string]error {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	c.mutex.Lock()
+	names := append([]string(nil), c.names...)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = c.checks[name]
+	}
+	c.mutex.Unlock()
+
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = checks[i](ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[names[i]] = err
+		}
+	}
+	return failed
+}
+
+func Postgres(db *sqlx.DB) Check {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+func Redis(client *redis.Client) Check {
+	return func(ctx context.Context) error {
+		return client.WithContext(ctx).Ping().Err()
+	}
+}
+
+// GRPC asks the standard health service of the microservice, so its own dependencies are checked too
+func GRPC(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			return fmt.Errorf("%w: %s", ErrNotServing, resp.GetStatus())
+		}
+		return nil
+	}
+}
+
+// Watch keeps the status of the gRPC health service up to date until ctx is done
+func Watch(ctx context.Context, server *health.Server, checker *Checker, interval time.Duration) {
+	message := logMessage + "Watch:"
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		for name, err := range checker.Run(ctx) {
+			log.Error(message+name+" err = ", err)
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		server.SetServingStatus("", status)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// StopGRPC waits for the running calls until the timeout and then closes the connections
+func StopGRPC(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		server.Stop()
+	}
+}
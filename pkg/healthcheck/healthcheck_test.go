@@ -0,0 +1,97 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("ok", func(ctx context.Context) error {
+		return nil
+	})
+	checker.Add("failed", func(ctx context.Context) error {
+		return errors.New("test_err")
+	})
+	checker.Add("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	checker.timeout = 10 * time.Millisecond
+
+	failed := checker.Run(context.Background())
+	require.Len(t, failed, 2)
+	require.EqualError(t, failed["failed"], "test_err")
+	require.ErrorIs(t, failed["slow"], context.DeadlineExceeded)
+}
+
+func newHealthServer(t *testing.T) (*grpc.Server, *health.Server, *grpc.ClientConn) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+	return server, healthServer, conn
+}
+
+func TestGRPC(t *testing.T) {
+	_, healthServer, conn := newHealthServer(t)
+	check := GRPC(conn)
+
+	require.NoError(t, check(context.Background()))
+
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	require.ErrorIs(t, check(context.Background()), ErrNotServing)
+}
+
+func TestWatch(t *testing.T) {
+	_, healthServer, conn := newHealthServer(t)
+	checker := NewChecker(time.Second)
+	checker.Add("test", func(ctx context.Context) error {
+		return errors.New("test_err")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Watch(ctx, healthServer, checker, time.Hour)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return GRPC(conn)(context.Background()) != nil
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestStopGRPC(t *testing.T) {
+	server, _, conn := newHealthServer(t)
+	require.NoError(t, GRPC(conn)(context.Background()))
+
+	StopGRPC(server, time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, GRPC(conn)(ctx))
+}
@@ -0,0 +1,112 @@
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	productId = "-//BMSTUSA//Events//RU"
+	// Content lines longer than 75 octets are folded, RFC 5545 3.1
+	maxLineLength = 75
+	timeLayout    = "20060102T150405Z"
+)
+
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Categories   []string
+	Start        time.Time
+	End          time.Time
+	LastModified time.Time
+	Latitude     float64
+	Longitude    float64
+}
+
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// Splits the line so that no part is longer than maxLineLength octets, multibyte characters are not split
+func writeLine(b *bytes.Buffer, line string) {
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			// Leading space of the continuation line is counted too
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	b.WriteString("\r\n")
+}
+
+func writeEvent(b *bytes.Buffer, e *Event, stamp time.Time) {
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+escapeText(e.UID))
+	if !e.LastModified.IsZero() {
+		stamp = e.LastModified
+		writeLine(b, "LAST-MODIFIED:"+formatTime(e.LastModified))
+		// Calendar apps replace the event only if the sequence grows
+		writeLine(b, "SEQUENCE:"+strconv.FormatInt(e.LastModified.Unix(), 10))
+	}
+	writeLine(b, "DTSTAMP:"+formatTime(stamp))
+	writeLine(b, "DTSTART:"+formatTime(e.Start))
+	if !e.End.IsZero() && e.End.After(e.Start) {
+		writeLine(b, "DTEND:"+formatTime(e.End))
+	}
+	writeLine(b, "SUMMARY:"+escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(b, "LOCATION:"+escapeText(e.Location))
+	}
+	if e.Latitude != 0 || e.Longitude != 0 {
+		writeLine(b, "GEO:"+strconv.FormatFloat(e.Latitude, 'f', -1, 64)+";"+strconv.FormatFloat(e.Longitude, 'f', -1, 64))
+	}
+	if len(e.Categories) != 0 {
+		categories := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			categories[i] = escapeText(c)
+		}
+		writeLine(b, "CATEGORIES:"+strings.Join(categories, ","))
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+// Marshal encodes the calendar, events without LastModified are stamped with the current time
+func (c *Calendar) Marshal() []byte {
+	var b bytes.Buffer
+	now := time.Now()
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+productId)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+	for i := range c.Events {
+		writeEvent(&b, &c.Events[i], now)
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return b.Bytes()
+}
@@ -0,0 +1,74 @@
+package ical
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshal(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	c := &Calendar{
+		Name: "Мои мероприятия",
+		Events: []Event{
+			{
+				UID:          "event-1@bmstusa.ru",
+				Summary:      "Концерт; часть 1, вечер",
+				Description:  "Первая строка\nвторая строка",
+				Location:     "Москва",
+				Categories:   []string{"музыка", "рок"},
+				Start:        time.Date(2021, 12, 1, 19, 0, 0, 0, moscow),
+				End:          time.Date(2021, 12, 1, 22, 0, 0, 0, moscow),
+				LastModified: time.Date(2021, 11, 20, 10, 0, 0, 0, time.UTC),
+				Latitude:     55.75,
+				Longitude:    37.61,
+			},
+		},
+	}
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//BMSTUSA//Events//RU",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Мои мероприятия",
+		"BEGIN:VEVENT",
+		"UID:event-1@bmstusa.ru",
+		"LAST-MODIFIED:20211120T100000Z",
+		"SEQUENCE:1637402400",
+		"DTSTAMP:20211120T100000Z",
+		"DTSTART:20211201T160000Z",
+		"DTEND:20211201T190000Z",
+		`SUMMARY:Концерт\; часть 1\, вечер`,
+		`DESCRIPTION:Первая строка\nвторая строка`,
+		"LOCATION:Москва",
+		"GEO:55.75;37.61",
+		"CATEGORIES:музыка,рок",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	require.Equal(t, expected, string(c.Marshal()))
+}
+
+func TestWriteLineFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"ascii", strings.Repeat("a", 200)},
+		{"cyrillic", strings.Repeat("я", 100)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &Calendar{Events: []Event{{Description: test.input}}}
+			for _, line := range strings.Split(string(c.Marshal()), "\r\n") {
+				require.LessOrEqual(t, len(line), maxLineLength, test.name)
+			}
+			unfolded := strings.ReplaceAll(string(c.Marshal()), "\r\n ", "")
+			require.Contains(t, unfolded, "DESCRIPTION:"+test.input, test.name)
+		})
+	}
+}
@@ -11,5 +11,7 @@ type NotificationManager interface {
 	GetAllNotifications(receiverId string) ([]*models.Notification, error)
 	GetNewNotifications(receiverId string) ([]*models.Notification, error)
 	EventTomorrowNotification() error
+	WaitlistNotification(receiverId string, eventId string) error
+	InvitationResponseNotification(receiverId string, userId string, eventId string, accepted bool) error
 	PingConnections() int
 }
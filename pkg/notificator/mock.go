@@ -44,6 +44,16 @@ func (m *NotificatorMock) GetNewNotifications(receiverId string) ([]*models.Noti
 	return args.Get(0).([]*models.Notification), args.Error(1)
 }
 
+func (m *NotificatorMock) WaitlistNotification(receiverId string, eventId string) error {
+	args := m.Called(receiverId, eventId)
+	return args.Error(0)
+}
+
+func (m *NotificatorMock) InvitationResponseNotification(receiverId string, userId string, eventId string, accepted bool) error {
+	args := m.Called(receiverId, userId, eventId, accepted)
+	return args.Error(0)
+}
+
 func (m *NotificatorMock) EventTomorrowNotification() error {
 	args := m.Called()
 	return args.Error(0)
@@ -111,6 +111,36 @@ func (n *Notificator) InvitationNotification(receiverId string, userId string, e
 	return nil
 }
 
+// Notifies the inviter that the user accepted or declined the invitation
+func (n *Notificator) InvitationResponseNotification(receiverId string, userId string, eventId string, accepted bool) error {
+	u, err := n.uRepository.GetUserById(userId)
+	if err != nil {
+		return err
+	}
+	e, err := n.eRepository.GetEventById(eventId)
+	if err != nil {
+		return err
+	}
+	m := &NotificationBody{
+		Type:        "6",
+		Seen:        false,
+		UserId:      u.ID,
+		UserName:    u.Name,
+		UserSurname: u.Surname,
+		EventId:     e.ID,
+		EventTitle:  e.Title,
+	}
+	repoFunc := n.nRepository.CreateInviteDeclinedNotification
+	if accepted {
+		m.Type = "5"
+		repoFunc = n.nRepository.CreateInviteAcceptedNotification
+	}
+	if u.ImgUrl != "" {
+		m.UserImgUrl = u.ImgUrl
+	}
+	return n.createAndSendNotification(m, receiverId, u, e, repoFunc)
+}
+
 func (n *Notificator) NewEventNotification(userId string, eventId string) error {
 	author, err := n.uRepository.GetUserById(userId)
 	if err != nil {
@@ -145,6 +175,31 @@ func (n *Notificator) NewEventNotification(userId string, eventId string) error
 	return nil
 }
 
+// Notifies the user that a seat was freed and the user was moved from the waitlist to the visitors
+func (n *Notificator) WaitlistNotification(receiverId string, eventId string) error {
+	e, err := n.eRepository.GetEventById(eventId)
+	if err != nil {
+		return err
+	}
+	author, err := n.uRepository.GetUserById(e.AuthorId)
+	if err != nil {
+		return err
+	}
+	m := &NotificationBody{
+		Type:        "4",
+		Seen:        false,
+		UserId:      author.ID,
+		UserName:    author.Name,
+		UserSurname: author.Surname,
+		EventId:     e.ID,
+		EventTitle:  e.Title,
+	}
+	if author.ImgUrl != "" {
+		m.UserImgUrl = author.ImgUrl
+	}
+	return n.createAndSendNotification(m, receiverId, author, e, n.nRepository.CreateWaitlistNotification)
+}
+
 func (n *Notificator) UpdateNotificationsStatus(receiverId string) error {
 	return n.nRepository.UpdateNotificationsStatus(receiverId)
 }
@@ -157,44 +212,62 @@ func (n *Notificator) GetNewNotifications(receiverId string) ([]*models.Notifica
 	return n.nRepository.GetNewNotifications(receiverId)
 }
 
-func (n *Notificator) EventTomorrowNotification() error {
-	currentTime := time.Now().Add(time.Hour * 24)
-	currentDate := currentTime.Format("02.01.2006")
-	events, err := n.eRepository.GetEvents("", "", "", "", currentDate, nil)
+func (n *Notificator) eventTomorrowNotification(e *models.Event) error {
+	visitors, err := n.uRepository.GetVisitors(e.ID)
 	if err != nil {
-		if err != error2.ErrNoRows {
-			return err
-		}
+		return err
 	}
-	for _, e := range events {
-		visitors, err := n.uRepository.GetVisitors(e.ID)
+	author, err := n.uRepository.GetUserById(e.AuthorId)
+	if err != nil {
+		return err
+	}
+	m := &NotificationBody{
+		Type:        "2",
+		Seen:        false,
+		UserId:      author.ID,
+		UserName:    author.Name,
+		UserSurname: author.Surname,
+		EventId:     e.ID,
+		EventTitle:  e.Title,
+	}
+	if author.ImgUrl != "" {
+		m.UserImgUrl = author.ImgUrl
+	}
+	for _, v := range visitors.Going {
+		err := n.createAndSendNotification(m, v.ID, author, e, n.nRepository.CreateTomorrowEventNotification)
 		if err != nil {
 			return err
 		}
-		author, err := n.uRepository.GetUserById(e.AuthorId)
+	}
+	return nil
+}
+
+func (n *Notificator) EventTomorrowNotification() error {
+	from := time.Now()
+	to := from.Add(time.Hour * 24)
+	page := &models.Page{}
+	for {
+		events, nextCursor, err := n.eRepository.GetEvents("", "", "", "", from, to, nil, nil, page)
 		if err != nil {
-			return err
-		}
-		m := &NotificationBody{
-			Type:        "2",
-			Seen:        false,
-			UserId:      author.ID,
-			UserName:    author.Name,
-			UserSurname: author.Surname,
-			EventId:     e.ID,
-			EventTitle:  e.Title,
-		}
-		if author.ImgUrl != "" {
-			m.UserImgUrl = author.ImgUrl
+			if err != error2.ErrNoRows {
+				return err
+			}
 		}
-		for _, v := range visitors {
-			err := n.createAndSendNotification(m, v.ID, author, e, n.nRepository.CreateTomorrowEventNotification)
+		for _, e := range events {
+			//Events that have already started are also returned, they were reminded of before
+			if e.StartDate.Before(from) {
+				continue
+			}
+			err := n.eventTomorrowNotification(e)
 			if err != nil {
 				return err
 			}
 		}
+		if nextCursor == "" {
+			return nil
+		}
+		page.Cursor = nextCursor
 	}
-	return nil
 }
 
 func (n *Notificator) PingConnections() int {
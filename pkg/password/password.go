@@ -0,0 +1,68 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cost of new bcrypt hashes, hashes with a lower cost are upgraded on the next login
+var Cost = bcrypt.DefaultCost
+
+// Hashes created before bcrypt: unsalted SHA-256 in hex
+const legacyHashLength = sha256.Size * 2
+
+var (
+	dummyOnce sync.Once
+	dummyHash []byte
+)
+
+func Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func isLegacy(hash string) bool {
+	if len(hash) != legacyHashLength {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+func legacyHash(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks the password against a bcrypt or a legacy SHA-256 hash
+func Verify(hash, password string) bool {
+	if isLegacy(hash) {
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(legacyHash(password))) == 1
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NeedsRehash reports whether a verified hash should be replaced with Hash(password)
+func NeedsRehash(hash string) bool {
+	if isLegacy(hash) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < Cost
+}
+
+// VerifyDummy takes as long as Verify with a bcrypt hash and always fails,
+// so that an unknown login can't be told from a wrong password by the response time
+func VerifyDummy(password string) {
+	dummyOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), Cost)
+	})
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+}
@@ -0,0 +1,57 @@
+package password
+
+import (
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	first, err := Hash("12345678")
+	require.NoError(t, err)
+	second, err := Hash("12345678")
+	require.NoError(t, err)
+	// Every hash has its own salt
+	require.NotEqual(t, first, second)
+	require.True(t, Verify(first, "12345678"))
+	require.True(t, Verify(second, "12345678"))
+	require.False(t, NeedsRehash(first))
+}
+
+func TestVerifyDummy(t *testing.T) {
+	VerifyDummy("12345678")
+	cost, err := bcrypt.Cost(dummyHash)
+	require.NoError(t, err)
+	require.Equal(t, Cost, cost)
+	require.False(t, Verify(string(dummyHash), "12345678"))
+}
+
+func TestVerify(t *testing.T) {
+	hash, err := Hash("12345678")
+	require.NoError(t, err)
+	lowCost, err := bcrypt.GenerateFromPassword([]byte("12345678"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		valid    bool
+		rehash   bool
+	}{
+		{"Bcrypt", hash, "12345678", true, false},
+		{"Bcrypt wrong password", hash, "87654321", false, false},
+		{"Bcrypt low cost", string(lowCost), "12345678", true, true},
+		{"Legacy", "ef797c8118f02dfb649607dd5d3f8c7623048c9c063d532cc95c5ed7a898a64f", "12345678", true, true},
+		{"Legacy wrong password", "ef797c8118f02dfb649607dd5d3f8c7623048c9c063d532cc95c5ed7a898a64f", "87654321", false, true},
+		{"Empty hash", "", "12345678", false, true},
+		{"Garbage", "not a hash", "12345678", false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.valid, Verify(test.hash, test.password), test.name)
+			require.Equal(t, test.rehash, NeedsRehash(test.hash), test.name)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package prometheus
 
 import (
+	"bufio"
+	"errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -15,6 +18,26 @@ type metricsMiddleware struct {
 	requestDuration *prometheus.HistogramVec
 }
 
+// statusWriter remembers the status of the response for the metrics
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Hijack is needed for the websocket connections
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
 func NewMetricsMiddleware() *metricsMiddleware {
 
 	opsProcessed := promauto.NewCounterVec(prometheus.CounterOpts{
@@ -58,7 +81,8 @@ func (mm *metricsMiddleware) Metrics(next http.Handler) http.Handler {
 			}).Inc()
 		}
 		start := time.Now()
-		next.ServeHTTP(w, r)
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
 		elapsed := time.Since(start)
 		if r.URL.Path != "/metrics" {
 			mm.requestDuration.With(prometheus.Labels{
@@ -74,7 +98,7 @@ func (mm *metricsMiddleware) Metrics(next http.Handler) http.Handler {
 			mm.opsProcessed.With(prometheus.Labels{
 				"method": r.Method,
 				"path":   resultPath,
-				"status": "200",
+				"status": strconv.Itoa(sw.status),
 			}).Inc()
 		}
 	})
@@ -0,0 +1,227 @@
+package rrule
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+)
+
+const dateLayout = "2006-01-02"
+
+var ErrRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is a subset of RFC 5545 RRULE: FREQ (DAILY, WEEKLY, MONTHLY),
+// INTERVAL, COUNT, UNTIL and BYDAY for weekly rules.
+type Rule struct {
+	Freq     string
+	Interval int
+	Count    int
+	Until    time.Time
+	ByDay    []time.Weekday
+}
+
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, ErrRule
+	}
+	r := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, ErrRule
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		var err error
+		switch key {
+		case "FREQ":
+			if value != Daily && value != Weekly && value != Monthly {
+				return nil, ErrRule
+			}
+			r.Freq = value
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval <= 0 {
+				return nil, ErrRule
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count <= 0 {
+				return nil, ErrRule
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+			if err != nil {
+				return nil, ErrRule
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wd, ok := weekdays[day]
+				if !ok {
+					return nil, ErrRule
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		default:
+			return nil, ErrRule
+		}
+	}
+	if r.Freq == "" || (r.Count != 0 && !r.Until.IsZero()) {
+		return nil, ErrRule
+	}
+	if len(r.ByDay) != 0 && r.Freq != Weekly {
+		return nil, ErrRule
+	}
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// A date-only UNTIL includes the whole day
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// Bounded reports if the rule ends by itself, with COUNT or UNTIL
+func (r *Rule) Bounded() bool {
+	return r.Count > 0 || !r.Until.IsZero()
+}
+
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) != 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			for name, d := range weekdays {
+				if d == wd {
+					days = append(days, name)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands the rule from start, keeping its wall clock time in
+// start's location. COUNT is applied before exdates are removed, so an
+// excluded occurrence still uses up one of the counted slots. Expansion
+// stops at horizon or after limit occurrences, whichever comes first.
+func (r *Rule) Occurrences(start time.Time, exdates []time.Time, horizon time.Time, limit int) []time.Time {
+	excluded := make(map[string]bool, len(exdates))
+	for _, d := range exdates {
+		excluded[d.In(start.Location()).Format(dateLayout)] = true
+	}
+	var result []time.Time
+	counted := 0
+	done := func(t time.Time) bool {
+		if t.After(horizon) || (!r.Until.IsZero() && t.After(r.Until)) {
+			return true
+		}
+		if r.Count > 0 && counted >= r.Count {
+			return true
+		}
+		return len(result) >= limit
+	}
+	emit := func(t time.Time) {
+		counted++
+		if !excluded[t.Format(dateLayout)] {
+			result = append(result, t)
+		}
+	}
+
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	loc := start.Location()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hh, mm, ss, start.Nanosecond(), loc)
+	}
+
+	switch r.Freq {
+	case Daily:
+		for i := 0; ; i += r.Interval {
+			t := at(y, m, d+i)
+			if done(t) {
+				break
+			}
+			emit(t)
+		}
+	case Weekly:
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		// Weeks start on Monday
+		offset := (int(start.Weekday()) + 6) % 7
+		weekStart := at(y, m, d-offset)
+	weeks:
+		for w := 0; ; w += r.Interval {
+			for i := 0; i < 7; i++ {
+				t := weekStart.AddDate(0, 0, w*7+i)
+				if t.Before(start) || !containsWeekday(days, t.Weekday()) {
+					continue
+				}
+				if done(t) {
+					break weeks
+				}
+				emit(t)
+			}
+		}
+	case Monthly:
+		for i := 0; ; i += r.Interval {
+			t := at(y, m+time.Month(i), d)
+			if t.Day() != d {
+				// The month is too short for this day, skip it
+				if at(y, m+time.Month(i), 1).After(horizon) {
+					break
+				}
+				continue
+			}
+			if done(t) {
+				break
+			}
+			emit(t)
+		}
+	}
+	return result
+}
+
+func containsWeekday(days []time.Weekday, wd time.Weekday) bool {
+	for _, d := range days {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
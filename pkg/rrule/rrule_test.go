@@ -0,0 +1,151 @@
+package rrule
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func dates(ts []time.Time) []string {
+	result := make([]string, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, t.Format("2006-01-02 15:04"))
+	}
+	return result
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		output *Rule
+		err    error
+	}{
+		{
+			name:   "Daily",
+			input:  "FREQ=DAILY",
+			output: &Rule{Freq: Daily, Interval: 1},
+		},
+		{
+			name:   "Weekly with prefix",
+			input:  "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4",
+			output: &Rule{Freq: Weekly, Interval: 2, Count: 4, ByDay: []time.Weekday{time.Monday, time.Friday}},
+		},
+		{
+			name:   "Until date",
+			input:  "FREQ=MONTHLY;UNTIL=20211231",
+			output: &Rule{Freq: Monthly, Interval: 1, Until: time.Date(2021, 12, 31, 23, 59, 59, 0, time.UTC)},
+		},
+		{
+			name:  "Empty",
+			input: "",
+			err:   ErrRule,
+		},
+		{
+			name:  "Yearly",
+			input: "FREQ=YEARLY",
+			err:   ErrRule,
+		},
+		{
+			name:  "Count and until",
+			input: "FREQ=DAILY;COUNT=2;UNTIL=20211231",
+			err:   ErrRule,
+		},
+		{
+			name:  "Zero interval",
+			input: "FREQ=DAILY;INTERVAL=0",
+			err:   ErrRule,
+		},
+		{
+			name:  "Byday for daily",
+			input: "FREQ=DAILY;BYDAY=MO",
+			err:   ErrRule,
+		},
+		{
+			name:  "Unknown part",
+			input: "FREQ=DAILY;BYHOUR=10",
+			err:   ErrRule,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse(test.input)
+			require.Equal(t, test.err, err, test.name)
+			require.Equal(t, test.output, r, test.name)
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	start := time.Date(2021, 11, 1, 18, 30, 0, 0, time.UTC) // Monday
+	horizon := start.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name    string
+		rule    string
+		start   time.Time
+		exdates []time.Time
+		limit   int
+		output  []string
+	}{
+		{
+			name:   "Daily count",
+			rule:   "FREQ=DAILY;COUNT=3",
+			start:  start,
+			limit:  10,
+			output: []string{"2021-11-01 18:30", "2021-11-02 18:30", "2021-11-03 18:30"},
+		},
+		{
+			name:    "Count before exdates",
+			rule:    "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			start:   start,
+			exdates: []time.Time{time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)},
+			limit:   10,
+			output:  []string{"2021-11-01 18:30", "2021-11-05 18:30"},
+		},
+		{
+			name:   "Weekly byday until",
+			rule:   "FREQ=WEEKLY;BYDAY=WE,MO;UNTIL=20211110",
+			start:  start,
+			limit:  10,
+			output: []string{"2021-11-01 18:30", "2021-11-03 18:30", "2021-11-08 18:30", "2021-11-10 18:30"},
+		},
+		{
+			name:   "Weekly starts mid week",
+			rule:   "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=2",
+			start:  start.AddDate(0, 0, 1),
+			limit:  10,
+			output: []string{"2021-11-03 18:30", "2021-11-08 18:30"},
+		},
+		{
+			name:   "Monthly skips short months",
+			rule:   "FREQ=MONTHLY;COUNT=3",
+			start:  time.Date(2021, 12, 31, 10, 0, 0, 0, time.UTC),
+			limit:  10,
+			output: []string{"2021-12-31 10:00", "2022-01-31 10:00", "2022-03-31 10:00"},
+		},
+		{
+			name:   "Limit",
+			rule:   "FREQ=DAILY",
+			start:  start,
+			limit:  2,
+			output: []string{"2021-11-01 18:30", "2021-11-02 18:30"},
+		},
+		{
+			name:   "Horizon",
+			rule:   "FREQ=MONTHLY;INTERVAL=5",
+			start:  start,
+			limit:  10,
+			output: []string{"2021-11-01 18:30", "2022-04-01 18:30", "2022-09-01 18:30"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse(test.rule)
+			require.NoError(t, err, test.name)
+			require.Equal(t, test.output, dates(r.Occurrences(test.start, test.exdates, horizon, test.limit)), test.name)
+		})
+	}
+}
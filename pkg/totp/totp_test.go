@@ -0,0 +1,67 @@
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Secret "12345678901234567890" of the RFC 6238 test vectors
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+var codeTests = []struct {
+	time int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+}
+
+func TestCode(t *testing.T) {
+	for _, test := range codeTests {
+		res, err := Code(rfcSecret, time.Unix(test.time, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.code, res, test.time)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step, ok := Validate(rfcSecret, "050471", now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	previous, err := Code(rfcSecret, now.Add(-Period*time.Second))
+	require.NoError(t, err)
+	step, ok = Validate(rfcSecret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now)-1, step)
+
+	old, err := Code(rfcSecret, now.Add(-3*Period*time.Second))
+	require.NoError(t, err)
+	_, ok = Validate(rfcSecret, old, now)
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "50471", now)
+	assert.False(t, ok)
+	_, ok = Validate("not base32!", "050471", now)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+	_, err = Code(secret, time.Now())
+	assert.NoError(t, err)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI(rfcSecret, "BMSTUSA", "user@mail.ru")
+	assert.Equal(t, "otpauth://totp/BMSTUSA:user@mail.ru?algorithm=SHA1&digits=6&issuer=BMSTUSA&period=30&secret="+rfcSecret, uri)
+}
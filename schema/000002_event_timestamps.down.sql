@@ -0,0 +1,11 @@
+DROP INDEX event_start_date_idx;
+
+ALTER TABLE "event" ADD COLUMN date varchar(10);
+
+UPDATE "event" SET date = to_char(start_date at time zone timezone, 'DD.MM.YYYY');
+
+ALTER TABLE "event"
+    ALTER COLUMN date SET not null,
+    DROP COLUMN start_date,
+    DROP COLUMN end_date,
+    DROP COLUMN timezone;
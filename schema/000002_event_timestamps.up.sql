@@ -0,0 +1,16 @@
+ALTER TABLE "event"
+    ADD COLUMN start_date timestamptz,
+    ADD COLUMN end_date timestamptz,
+    ADD COLUMN timezone varchar(64) default 'Europe/Moscow' not null;
+
+UPDATE "event" SET
+    start_date = to_timestamp(date, 'DD.MM.YYYY')::timestamp at time zone timezone,
+    end_date = (to_timestamp(date, 'DD.MM.YYYY')::timestamp + interval '1 day') at time zone timezone;
+
+ALTER TABLE "event"
+    ALTER COLUMN start_date SET not null,
+    ALTER COLUMN end_date SET not null,
+    ADD CHECK ( end_date >= start_date ),
+    DROP COLUMN date;
+
+CREATE INDEX event_start_date_idx ON "event" (start_date);
@@ -0,0 +1,3 @@
+DROP INDEX event_search_idx;
+
+DROP FUNCTION event_search_vector(varchar, varchar, varchar, varchar[]);
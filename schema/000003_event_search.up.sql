@@ -0,0 +1,9 @@
+CREATE FUNCTION event_search_vector(title varchar, description varchar, text varchar, tag varchar[])
+    RETURNS tsvector AS $$
+SELECT setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+       setweight(to_tsvector('russian', coalesce(array_to_string(tag, ' '), '')), 'B') ||
+       setweight(to_tsvector('russian', coalesce(description, '')), 'C') ||
+       setweight(to_tsvector('russian', coalesce(text, '')), 'D')
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE INDEX event_search_idx ON "event" USING gin (event_search_vector(title, description, text, tag));
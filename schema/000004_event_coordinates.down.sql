@@ -0,0 +1,9 @@
+DROP INDEX event_coordinates_idx;
+
+ALTER TABLE "event" ADD COLUMN geo varchar(255) default '' not null;
+
+UPDATE "event" SET geo = '(' || latitude || ', ' || longitude || ')';
+
+ALTER TABLE "event"
+    DROP COLUMN latitude,
+    DROP COLUMN longitude;
@@ -0,0 +1,15 @@
+ALTER TABLE "event"
+    ADD COLUMN latitude double precision default 0 not null,
+    ADD COLUMN longitude double precision default 0 not null;
+
+UPDATE "event" SET
+    latitude = split_part(trim(both '()' from geo), ',', 1)::double precision,
+    longitude = split_part(trim(both '()' from geo), ',', 2)::double precision
+WHERE geo ~ '^\(\s*-?[0-9.]+\s*,\s*-?[0-9.]+\s*\)$';
+
+ALTER TABLE "event"
+    ADD CHECK ( latitude between -90 and 90 ),
+    ADD CHECK ( longitude between -180 and 180 ),
+    DROP COLUMN geo;
+
+CREATE INDEX event_coordinates_idx ON "event" (latitude, longitude);
@@ -0,0 +1,9 @@
+DELETE FROM "notification" WHERE type = '4';
+
+ALTER TABLE "notification"
+    DROP CONSTRAINT notification_type_check,
+    ADD CONSTRAINT notification_type_check CHECK (type in ('0', '1', '2', '3'));
+
+DROP TABLE "waitlist";
+
+ALTER TABLE "event" DROP COLUMN capacity;
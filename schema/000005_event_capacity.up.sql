@@ -0,0 +1,16 @@
+ALTER TABLE "event"
+    ADD COLUMN capacity int default 0 not null CHECK ( capacity >= 0 );
+
+CREATE TABLE "waitlist" (
+                        id serial not null unique,
+                        event_id int references "event" (id) on delete cascade not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        UNIQUE(event_id, user_id),
+                        created_at timestamptz default now() not null
+);
+
+CREATE INDEX waitlist_event_idx ON "waitlist" (event_id, id);
+
+ALTER TABLE "notification"
+    DROP CONSTRAINT notification_type_check,
+    ADD CONSTRAINT notification_type_check CHECK (type in ('0', '1', '2', '3', '4'));
@@ -0,0 +1,6 @@
+DROP INDEX visitor_event_status_idx;
+
+DELETE FROM "visitor" WHERE status <> 'going';
+
+ALTER TABLE "visitor"
+    DROP COLUMN status;
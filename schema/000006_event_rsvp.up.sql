@@ -0,0 +1,4 @@
+ALTER TABLE "visitor"
+    ADD COLUMN status varchar(8) default 'going' not null CHECK ( status in ('going', 'maybe', 'declined') );
+
+CREATE INDEX visitor_event_status_idx ON "visitor" (event_id, status);
@@ -0,0 +1,7 @@
+DELETE FROM "notification" WHERE type in ('5', '6');
+
+ALTER TABLE "notification"
+    DROP CONSTRAINT notification_type_check,
+    ADD CONSTRAINT notification_type_check CHECK (type in ('0', '1', '2', '3', '4'));
+
+DROP TABLE "invitation";
@@ -0,0 +1,29 @@
+CREATE TABLE "invitation" (
+                        id serial not null unique,
+                        event_id int references "event" (id) on delete cascade not null,
+                        inviter_id int references "user" (id) on delete cascade not null,
+                        receiver_id int references "user" (id) on delete cascade not null,
+                        status varchar(8) default 'pending' not null CHECK ( status in ('pending', 'accepted', 'declined') ),
+                        created_at timestamptz default now() not null,
+                        responded_at timestamptz,
+                        UNIQUE(event_id, receiver_id),
+                        CHECK ( inviter_id <> receiver_id )
+);
+
+CREATE INDEX invitation_receiver_idx ON "invitation" (receiver_id);
+
+-- Invitations used to exist only as notifications
+INSERT INTO "invitation" (event_id, inviter_id, receiver_id, status)
+SELECT DISTINCT ON (e.id, r.id) e.id, i.id, r.id,
+       CASE WHEN EXISTS (select 1 from "visitor" as v where v.event_id = e.id and v.user_id = r.id and v.status = 'going')
+           THEN 'accepted' ELSE 'pending' END
+FROM "notification" as n
+         JOIN "event" as e on e.id::varchar = n.event_id
+         JOIN "user" as i on i.id::varchar = n.user_id
+         JOIN "user" as r on r.id::varchar = n.receiver_id
+WHERE n.type = '1' AND i.id <> r.id
+ORDER BY e.id, r.id, n.id;
+
+ALTER TABLE "notification"
+    DROP CONSTRAINT notification_type_check,
+    ADD CONSTRAINT notification_type_check CHECK (type in ('0', '1', '2', '3', '4', '5', '6'));
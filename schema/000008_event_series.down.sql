@@ -0,0 +1,10 @@
+-- Only the first occurrence of a series is kept
+DELETE FROM "event" WHERE series_id IS NOT NULL AND id NOT IN (SELECT min(id) FROM "event" GROUP BY series_id);
+
+ALTER TABLE "event"
+    DROP CONSTRAINT event_series_occurrence_key,
+    DROP COLUMN detached,
+    DROP COLUMN occurrence_date,
+    DROP COLUMN series_id;
+
+DROP TABLE "series";
@@ -0,0 +1,14 @@
+CREATE TABLE "series" (
+                        id serial not null unique,
+                        author_id int references "user" (id) on delete cascade not null,
+                        rrule varchar(255) not null,
+                        exdates date[] default '{}' not null,
+                        created_at timestamptz default now() not null
+);
+
+-- Occurrences of a series are stored as events, detached occurrences were edited separately
+ALTER TABLE "event"
+    ADD COLUMN series_id int references "series" (id) on delete cascade,
+    ADD COLUMN occurrence_date date,
+    ADD COLUMN detached boolean default false not null,
+    ADD CONSTRAINT event_series_occurrence_key UNIQUE (series_id, occurrence_date);
@@ -0,0 +1,3 @@
+DROP TABLE "calendar_token";
+
+ALTER TABLE "event" DROP COLUMN updated_at;
@@ -0,0 +1,9 @@
+ALTER TABLE "event"
+    ADD COLUMN updated_at timestamptz default now() not null;
+
+-- Token of the calendar feed, regenerating it revokes the old feed url
+CREATE TABLE "calendar_token" (
+                        user_id int references "user" (id) on delete cascade primary key,
+                        token varchar(64) not null unique,
+                        created_at timestamptz default now() not null
+);
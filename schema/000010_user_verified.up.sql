@@ -0,0 +1,5 @@
+ALTER TABLE "user"
+    ADD COLUMN verified boolean default false not null;
+
+-- Accounts created before the verification was introduced stay active
+UPDATE "user" SET verified = true;
@@ -0,0 +1,13 @@
+-- Kept out of "user", so that select * of the user stays unchanged
+CREATE TABLE "two_factor" (
+                        user_id int references "user" (id) on delete cascade primary key,
+                        secret text not null,
+                        -- The secret is stored at the setup and enabled after the first correct code
+                        enabled boolean default false not null
+);
+
+CREATE TABLE "recovery_code" (
+                        user_id int references "two_factor" (user_id) on delete cascade not null,
+                        code_hash text not null,
+                        PRIMARY KEY (user_id, code_hash)
+);
@@ -0,0 +1,12 @@
+-- Accounts of the identity providers the user logs in with
+CREATE TABLE "oauth_identity" (
+                        provider text not null,
+                        -- The user id at the provider, it never changes unlike the mail
+                        subject text not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        mail text default '' not null,
+                        created_at timestamptz default now() not null,
+                        PRIMARY KEY (provider, subject),
+                        -- One account of each provider per user
+                        UNIQUE(user_id, provider)
+);
@@ -0,0 +1,4 @@
+-- The first admin is appointed by hand: UPDATE "user" SET role = 'admin' WHERE mail = '...';
+ALTER TABLE "user"
+    ADD COLUMN role varchar(16) default 'user' not null
+        check (role in ('user', 'moderator', 'admin'));
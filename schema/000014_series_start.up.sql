@@ -0,0 +1,10 @@
+-- DTSTART of the series, the rule is always expanded from it. For the existing series it is the first
+-- occurrence that is left, moved back to the first excluded date if that one is earlier
+ALTER TABLE "series"
+    ADD COLUMN dtstart timestamptz;
+
+UPDATE "series" AS s SET dtstart = e.start_date -
+    (e.occurrence_date - least(e.occurrence_date, (SELECT min(d) FROM unnest(s.exdates) AS d))) * interval '1 day'
+FROM (SELECT DISTINCT ON (series_id) series_id, start_date, occurrence_date FROM "event"
+      WHERE series_id IS NOT NULL ORDER BY series_id, start_date) AS e
+WHERE e.series_id = s.id;
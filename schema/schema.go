@@ -0,0 +1,8 @@
+package schema
+
+import "embed"
+
+// Migrations are built into the binaries, the postgres_public_*.sql dumps are test data and stay out
+//
+//go:embed 0*.sql
+var Migrations embed.FS